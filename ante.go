@@ -27,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"autocctp.dev/keeper"
 	"autocctp.dev/types"
 )
 
@@ -48,6 +49,7 @@ var _ sdk.AnteDecorator = SigVerificationDecorator{}
 
 // SigVerificationDecorator is a custom ante handler used to verify signerless registration messages for AutoCCTP accounts.
 type SigVerificationDecorator struct {
+	autocctp   *keeper.Keeper
 	ftf        types.FiatTokenfactoryKeeper
 	bank       types.BankKeeper
 	underlying sdk.AnteDecorator
@@ -57,16 +59,21 @@ type SigVerificationDecorator struct {
 // wraps another ante handler. The custom signature verification allows to broadcast a
 // signerless tx to create an AutoCCTP account.
 func NewSigVerificationDecorator(
+	k *keeper.Keeper,
 	fk types.FiatTokenfactoryKeeper,
 	bk types.BankKeeper,
 	ak ante.AccountKeeper,
 	underlying sdk.AnteDecorator,
 ) SigVerificationDecorator {
+	if k == nil {
+		panic("autocctp keeper cannot be nil")
+	}
 	if underlying == nil {
 		panic("underlying ante decorator cannot be nil")
 	}
 
 	return SigVerificationDecorator{
+		autocctp:   k,
 		ftf:        fk,
 		bank:       bk,
 		underlying: underlying,
@@ -92,8 +99,9 @@ func (d SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 		// attacks of AutoCCTP accounts.
 		mintToken := d.ftf.GetMintingDenom(ctx)
 		balance := d.bank.GetBalance(ctx, address, mintToken.Denom)
-		if balance.Amount.LT(types.GetMinimumTransferAmount()) {
-			return ctx, types.ErrInvalidAccountBalance.Wrapf("must have at least %s%s", types.GetMinimumTransferAmount().String(), mintToken.Denom)
+		minimumTransferAmount := d.autocctp.GetMinimumTransferAmount(ctx)
+		if balance.Amount.LT(minimumTransferAmount) {
+			return ctx, types.ErrInvalidAccountBalance.Wrapf("must have at least %s%s", minimumTransferAmount.String(), mintToken.Denom)
 		}
 
 		return next(ctx, tx, simulate)
@@ -10,18 +10,27 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
 var (
-	md_Account                    protoreflect.MessageDescriptor
-	fd_Account_base_account       protoreflect.FieldDescriptor
-	fd_Account_destination_domain protoreflect.FieldDescriptor
-	fd_Account_mint_recipient     protoreflect.FieldDescriptor
-	fd_Account_fallback_recipient protoreflect.FieldDescriptor
-	fd_Account_destination_caller protoreflect.FieldDescriptor
+	md_Account                      protoreflect.MessageDescriptor
+	fd_Account_base_account         protoreflect.FieldDescriptor
+	fd_Account_destination_domain   protoreflect.FieldDescriptor
+	fd_Account_mint_recipient       protoreflect.FieldDescriptor
+	fd_Account_fallback_recipient   protoreflect.FieldDescriptor
+	fd_Account_destination_caller   protoreflect.FieldDescriptor
+	fd_Account_fallback_policy      protoreflect.FieldDescriptor
+	fd_Account_forward_other_denoms protoreflect.FieldDescriptor
+	fd_Account_deregistered         protoreflect.FieldDescriptor
+	fd_Account_expiration_height    protoreflect.FieldDescriptor
+	fd_Account_expiration_time      protoreflect.FieldDescriptor
+	fd_Account_address_version      protoreflect.FieldDescriptor
+	fd_Account_salt                 protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -32,6 +41,13 @@ func init() {
 	fd_Account_mint_recipient = md_Account.Fields().ByName("mint_recipient")
 	fd_Account_fallback_recipient = md_Account.Fields().ByName("fallback_recipient")
 	fd_Account_destination_caller = md_Account.Fields().ByName("destination_caller")
+	fd_Account_fallback_policy = md_Account.Fields().ByName("fallback_policy")
+	fd_Account_forward_other_denoms = md_Account.Fields().ByName("forward_other_denoms")
+	fd_Account_deregistered = md_Account.Fields().ByName("deregistered")
+	fd_Account_expiration_height = md_Account.Fields().ByName("expiration_height")
+	fd_Account_expiration_time = md_Account.Fields().ByName("expiration_time")
+	fd_Account_address_version = md_Account.Fields().ByName("address_version")
+	fd_Account_salt = md_Account.Fields().ByName("salt")
 }
 
 var _ protoreflect.Message = (*fastReflection_Account)(nil)
@@ -129,6 +145,48 @@ func (x *fastReflection_Account) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if x.FallbackPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.FallbackPolicy.ProtoReflect())
+		if !f(fd_Account_fallback_policy, value) {
+			return
+		}
+	}
+	if x.ForwardOtherDenoms != false {
+		value := protoreflect.ValueOfBool(x.ForwardOtherDenoms)
+		if !f(fd_Account_forward_other_denoms, value) {
+			return
+		}
+	}
+	if x.Deregistered != false {
+		value := protoreflect.ValueOfBool(x.Deregistered)
+		if !f(fd_Account_deregistered, value) {
+			return
+		}
+	}
+	if x.ExpirationHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpirationHeight)
+		if !f(fd_Account_expiration_height, value) {
+			return
+		}
+	}
+	if x.ExpirationTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
+		if !f(fd_Account_expiration_time, value) {
+			return
+		}
+	}
+	if x.AddressVersion != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.AddressVersion)
+		if !f(fd_Account_address_version, value) {
+			return
+		}
+	}
+	if len(x.Salt) != 0 {
+		value := protoreflect.ValueOfBytes(x.Salt)
+		if !f(fd_Account_salt, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -154,6 +212,20 @@ func (x *fastReflection_Account) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.FallbackRecipient != ""
 	case "noble.autocctp.v1.Account.destination_caller":
 		return len(x.DestinationCaller) != 0
+	case "noble.autocctp.v1.Account.fallback_policy":
+		return x.FallbackPolicy != nil
+	case "noble.autocctp.v1.Account.forward_other_denoms":
+		return x.ForwardOtherDenoms != false
+	case "noble.autocctp.v1.Account.deregistered":
+		return x.Deregistered != false
+	case "noble.autocctp.v1.Account.expiration_height":
+		return x.ExpirationHeight != uint64(0)
+	case "noble.autocctp.v1.Account.expiration_time":
+		return x.ExpirationTime != nil
+	case "noble.autocctp.v1.Account.address_version":
+		return x.AddressVersion != uint32(0)
+	case "noble.autocctp.v1.Account.salt":
+		return len(x.Salt) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Account"))
@@ -180,6 +252,20 @@ func (x *fastReflection_Account) Clear(fd protoreflect.FieldDescriptor) {
 		x.FallbackRecipient = ""
 	case "noble.autocctp.v1.Account.destination_caller":
 		x.DestinationCaller = nil
+	case "noble.autocctp.v1.Account.fallback_policy":
+		x.FallbackPolicy = nil
+	case "noble.autocctp.v1.Account.forward_other_denoms":
+		x.ForwardOtherDenoms = false
+	case "noble.autocctp.v1.Account.deregistered":
+		x.Deregistered = false
+	case "noble.autocctp.v1.Account.expiration_height":
+		x.ExpirationHeight = uint64(0)
+	case "noble.autocctp.v1.Account.expiration_time":
+		x.ExpirationTime = nil
+	case "noble.autocctp.v1.Account.address_version":
+		x.AddressVersion = uint32(0)
+	case "noble.autocctp.v1.Account.salt":
+		x.Salt = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Account"))
@@ -211,6 +297,27 @@ func (x *fastReflection_Account) Get(descriptor protoreflect.FieldDescriptor) pr
 	case "noble.autocctp.v1.Account.destination_caller":
 		value := x.DestinationCaller
 		return protoreflect.ValueOfBytes(value)
+	case "noble.autocctp.v1.Account.fallback_policy":
+		value := x.FallbackPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.autocctp.v1.Account.forward_other_denoms":
+		value := x.ForwardOtherDenoms
+		return protoreflect.ValueOfBool(value)
+	case "noble.autocctp.v1.Account.deregistered":
+		value := x.Deregistered
+		return protoreflect.ValueOfBool(value)
+	case "noble.autocctp.v1.Account.expiration_height":
+		value := x.ExpirationHeight
+		return protoreflect.ValueOfUint64(value)
+	case "noble.autocctp.v1.Account.expiration_time":
+		value := x.ExpirationTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.autocctp.v1.Account.address_version":
+		value := x.AddressVersion
+		return protoreflect.ValueOfUint32(value)
+	case "noble.autocctp.v1.Account.salt":
+		value := x.Salt
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Account"))
@@ -241,6 +348,20 @@ func (x *fastReflection_Account) Set(fd protoreflect.FieldDescriptor, value prot
 		x.FallbackRecipient = value.Interface().(string)
 	case "noble.autocctp.v1.Account.destination_caller":
 		x.DestinationCaller = value.Bytes()
+	case "noble.autocctp.v1.Account.fallback_policy":
+		x.FallbackPolicy = value.Message().Interface().(*FallbackPolicy)
+	case "noble.autocctp.v1.Account.forward_other_denoms":
+		x.ForwardOtherDenoms = value.Bool()
+	case "noble.autocctp.v1.Account.deregistered":
+		x.Deregistered = value.Bool()
+	case "noble.autocctp.v1.Account.expiration_height":
+		x.ExpirationHeight = value.Uint()
+	case "noble.autocctp.v1.Account.expiration_time":
+		x.ExpirationTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "noble.autocctp.v1.Account.address_version":
+		x.AddressVersion = uint32(value.Uint())
+	case "noble.autocctp.v1.Account.salt":
+		x.Salt = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Account"))
@@ -266,6 +387,16 @@ func (x *fastReflection_Account) Mutable(fd protoreflect.FieldDescriptor) protor
 			x.BaseAccount = new(v1beta1.BaseAccount)
 		}
 		return protoreflect.ValueOfMessage(x.BaseAccount.ProtoReflect())
+	case "noble.autocctp.v1.Account.fallback_policy":
+		if x.FallbackPolicy == nil {
+			x.FallbackPolicy = new(FallbackPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.FallbackPolicy.ProtoReflect())
+	case "noble.autocctp.v1.Account.expiration_time":
+		if x.ExpirationTime == nil {
+			x.ExpirationTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpirationTime.ProtoReflect())
 	case "noble.autocctp.v1.Account.destination_domain":
 		panic(fmt.Errorf("field destination_domain of message noble.autocctp.v1.Account is not mutable"))
 	case "noble.autocctp.v1.Account.mint_recipient":
@@ -274,6 +405,16 @@ func (x *fastReflection_Account) Mutable(fd protoreflect.FieldDescriptor) protor
 		panic(fmt.Errorf("field fallback_recipient of message noble.autocctp.v1.Account is not mutable"))
 	case "noble.autocctp.v1.Account.destination_caller":
 		panic(fmt.Errorf("field destination_caller of message noble.autocctp.v1.Account is not mutable"))
+	case "noble.autocctp.v1.Account.forward_other_denoms":
+		panic(fmt.Errorf("field forward_other_denoms of message noble.autocctp.v1.Account is not mutable"))
+	case "noble.autocctp.v1.Account.deregistered":
+		panic(fmt.Errorf("field deregistered of message noble.autocctp.v1.Account is not mutable"))
+	case "noble.autocctp.v1.Account.expiration_height":
+		panic(fmt.Errorf("field expiration_height of message noble.autocctp.v1.Account is not mutable"))
+	case "noble.autocctp.v1.Account.address_version":
+		panic(fmt.Errorf("field address_version of message noble.autocctp.v1.Account is not mutable"))
+	case "noble.autocctp.v1.Account.salt":
+		panic(fmt.Errorf("field salt of message noble.autocctp.v1.Account is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Account"))
@@ -290,29 +431,955 @@ func (x *fastReflection_Account) NewField(fd protoreflect.FieldDescriptor) proto
 	case "noble.autocctp.v1.Account.base_account":
 		m := new(v1beta1.BaseAccount)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "noble.autocctp.v1.Account.destination_domain":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "noble.autocctp.v1.Account.mint_recipient":
-		return protoreflect.ValueOfBytes(nil)
-	case "noble.autocctp.v1.Account.fallback_recipient":
-		return protoreflect.ValueOfString("")
-	case "noble.autocctp.v1.Account.destination_caller":
-		return protoreflect.ValueOfBytes(nil)
+	case "noble.autocctp.v1.Account.destination_domain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.autocctp.v1.Account.mint_recipient":
+		return protoreflect.ValueOfBytes(nil)
+	case "noble.autocctp.v1.Account.fallback_recipient":
+		return protoreflect.ValueOfString("")
+	case "noble.autocctp.v1.Account.destination_caller":
+		return protoreflect.ValueOfBytes(nil)
+	case "noble.autocctp.v1.Account.fallback_policy":
+		m := new(FallbackPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.autocctp.v1.Account.forward_other_denoms":
+		return protoreflect.ValueOfBool(false)
+	case "noble.autocctp.v1.Account.deregistered":
+		return protoreflect.ValueOfBool(false)
+	case "noble.autocctp.v1.Account.expiration_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.autocctp.v1.Account.expiration_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.autocctp.v1.Account.address_version":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.autocctp.v1.Account.salt":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Account"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.Account does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Account) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.autocctp.v1.Account", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Account) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Account) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Account) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Account) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Account)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BaseAccount != nil {
+			l = options.Size(x.BaseAccount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DestinationDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestinationDomain))
+		}
+		l = len(x.MintRecipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FallbackRecipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestinationCaller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FallbackPolicy != nil {
+			l = options.Size(x.FallbackPolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ForwardOtherDenoms {
+			n += 2
+		}
+		if x.Deregistered {
+			n += 2
+		}
+		if x.ExpirationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpirationHeight))
+		}
+		if x.ExpirationTime != nil {
+			l = options.Size(x.ExpirationTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AddressVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.AddressVersion))
+		}
+		l = len(x.Salt)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Account)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Salt) > 0 {
+			i -= len(x.Salt)
+			copy(dAtA[i:], x.Salt)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Salt)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.AddressVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AddressVersion))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.ExpirationTime != nil {
+			encoded, err := options.Marshal(x.ExpirationTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.ExpirationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpirationHeight))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Deregistered {
+			i--
+			if x.Deregistered {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.ForwardOtherDenoms {
+			i--
+			if x.ForwardOtherDenoms {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.FallbackPolicy != nil {
+			encoded, err := options.Marshal(x.FallbackPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.DestinationCaller) > 0 {
+			i -= len(x.DestinationCaller)
+			copy(dAtA[i:], x.DestinationCaller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationCaller)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.FallbackRecipient) > 0 {
+			i -= len(x.FallbackRecipient)
+			copy(dAtA[i:], x.FallbackRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FallbackRecipient)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MintRecipient) > 0 {
+			i -= len(x.MintRecipient)
+			copy(dAtA[i:], x.MintRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintRecipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.DestinationDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationDomain))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.BaseAccount != nil {
+			encoded, err := options.Marshal(x.BaseAccount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Account)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Account: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BaseAccount == nil {
+					x.BaseAccount = &v1beta1.BaseAccount{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BaseAccount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+				}
+				x.DestinationDomain = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestinationDomain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintRecipient = append(x.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+				if x.MintRecipient == nil {
+					x.MintRecipient = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestinationCaller = append(x.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+				if x.DestinationCaller == nil {
+					x.DestinationCaller = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FallbackPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FallbackPolicy == nil {
+					x.FallbackPolicy = &FallbackPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FallbackPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForwardOtherDenoms", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ForwardOtherDenoms = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deregistered", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Deregistered = bool(v != 0)
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+				}
+				x.ExpirationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpirationHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpirationTime == nil {
+					x.ExpirationTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpirationTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+				}
+				x.AddressVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AddressVersion |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Salt = append(x.Salt[:0], dAtA[iNdEx:postIndex]...)
+				if x.Salt == nil {
+					x.Salt = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FallbackPolicy                protoreflect.MessageDescriptor
+	fd_FallbackPolicy_max_attempts   protoreflect.FieldDescriptor
+	fd_FallbackPolicy_timeout_blocks protoreflect.FieldDescriptor
+	fd_FallbackPolicy_timeout        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_autocctp_v1_account_proto_init()
+	md_FallbackPolicy = File_noble_autocctp_v1_account_proto.Messages().ByName("FallbackPolicy")
+	fd_FallbackPolicy_max_attempts = md_FallbackPolicy.Fields().ByName("max_attempts")
+	fd_FallbackPolicy_timeout_blocks = md_FallbackPolicy.Fields().ByName("timeout_blocks")
+	fd_FallbackPolicy_timeout = md_FallbackPolicy.Fields().ByName("timeout")
+}
+
+var _ protoreflect.Message = (*fastReflection_FallbackPolicy)(nil)
+
+type fastReflection_FallbackPolicy FallbackPolicy
+
+func (x *FallbackPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FallbackPolicy)(x)
+}
+
+func (x *FallbackPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_autocctp_v1_account_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FallbackPolicy_messageType fastReflection_FallbackPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_FallbackPolicy_messageType{}
+
+type fastReflection_FallbackPolicy_messageType struct{}
+
+func (x fastReflection_FallbackPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FallbackPolicy)(nil)
+}
+func (x fastReflection_FallbackPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_FallbackPolicy)
+}
+func (x fastReflection_FallbackPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FallbackPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FallbackPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_FallbackPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FallbackPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_FallbackPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FallbackPolicy) New() protoreflect.Message {
+	return new(fastReflection_FallbackPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FallbackPolicy) Interface() protoreflect.ProtoMessage {
+	return (*FallbackPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FallbackPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxAttempts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxAttempts)
+		if !f(fd_FallbackPolicy_max_attempts, value) {
+			return
+		}
+	}
+	if x.TimeoutBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TimeoutBlocks)
+		if !f(fd_FallbackPolicy_timeout_blocks, value) {
+			return
+		}
+	}
+	if x.Timeout != nil {
+		value := protoreflect.ValueOfMessage(x.Timeout.ProtoReflect())
+		if !f(fd_FallbackPolicy_timeout, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FallbackPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.FallbackPolicy.max_attempts":
+		return x.MaxAttempts != uint64(0)
+	case "noble.autocctp.v1.FallbackPolicy.timeout_blocks":
+		return x.TimeoutBlocks != uint64(0)
+	case "noble.autocctp.v1.FallbackPolicy.timeout":
+		return x.Timeout != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.FallbackPolicy"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.FallbackPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FallbackPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.FallbackPolicy.max_attempts":
+		x.MaxAttempts = uint64(0)
+	case "noble.autocctp.v1.FallbackPolicy.timeout_blocks":
+		x.TimeoutBlocks = uint64(0)
+	case "noble.autocctp.v1.FallbackPolicy.timeout":
+		x.Timeout = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.FallbackPolicy"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.FallbackPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FallbackPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.autocctp.v1.FallbackPolicy.max_attempts":
+		value := x.MaxAttempts
+		return protoreflect.ValueOfUint64(value)
+	case "noble.autocctp.v1.FallbackPolicy.timeout_blocks":
+		value := x.TimeoutBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "noble.autocctp.v1.FallbackPolicy.timeout":
+		value := x.Timeout
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.FallbackPolicy"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.FallbackPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FallbackPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.FallbackPolicy.max_attempts":
+		x.MaxAttempts = value.Uint()
+	case "noble.autocctp.v1.FallbackPolicy.timeout_blocks":
+		x.TimeoutBlocks = value.Uint()
+	case "noble.autocctp.v1.FallbackPolicy.timeout":
+		x.Timeout = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.FallbackPolicy"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.FallbackPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FallbackPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.FallbackPolicy.timeout":
+		if x.Timeout == nil {
+			x.Timeout = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Timeout.ProtoReflect())
+	case "noble.autocctp.v1.FallbackPolicy.max_attempts":
+		panic(fmt.Errorf("field max_attempts of message noble.autocctp.v1.FallbackPolicy is not mutable"))
+	case "noble.autocctp.v1.FallbackPolicy.timeout_blocks":
+		panic(fmt.Errorf("field timeout_blocks of message noble.autocctp.v1.FallbackPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.FallbackPolicy"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.FallbackPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FallbackPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.FallbackPolicy.max_attempts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.autocctp.v1.FallbackPolicy.timeout_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.autocctp.v1.FallbackPolicy.timeout":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Account"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.FallbackPolicy"))
 		}
-		panic(fmt.Errorf("message noble.autocctp.v1.Account does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.autocctp.v1.FallbackPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Account) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FallbackPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in noble.autocctp.v1.Account", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in noble.autocctp.v1.FallbackPolicy", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -320,7 +1387,7 @@ func (x *fastReflection_Account) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Account) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FallbackPolicy) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -331,7 +1398,7 @@ func (x *fastReflection_Account) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Account) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FallbackPolicy) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -343,7 +1410,7 @@ func (x *fastReflection_Account) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Account) IsValid() bool {
+func (x *fastReflection_FallbackPolicy) IsValid() bool {
 	return x != nil
 }
 
@@ -353,9 +1420,9 @@ func (x *fastReflection_Account) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Account) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FallbackPolicy) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Account)
+		x := input.Message.Interface().(*FallbackPolicy)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -367,23 +1434,14 @@ func (x *fastReflection_Account) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.BaseAccount != nil {
-			l = options.Size(x.BaseAccount)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.DestinationDomain != 0 {
-			n += 1 + runtime.Sov(uint64(x.DestinationDomain))
+		if x.MaxAttempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAttempts))
 		}
-		l = len(x.MintRecipient)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.FallbackRecipient)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.TimeoutBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeoutBlocks))
 		}
-		l = len(x.DestinationCaller)
-		if l > 0 {
+		if x.Timeout != nil {
+			l = options.Size(x.Timeout)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -396,7 +1454,7 @@ func (x *fastReflection_Account) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Account)
+		x := input.Message.Interface().(*FallbackPolicy)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -415,34 +1473,8 @@ func (x *fastReflection_Account) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.DestinationCaller) > 0 {
-			i -= len(x.DestinationCaller)
-			copy(dAtA[i:], x.DestinationCaller)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationCaller)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.FallbackRecipient) > 0 {
-			i -= len(x.FallbackRecipient)
-			copy(dAtA[i:], x.FallbackRecipient)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FallbackRecipient)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.MintRecipient) > 0 {
-			i -= len(x.MintRecipient)
-			copy(dAtA[i:], x.MintRecipient)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintRecipient)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.DestinationDomain != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationDomain))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.BaseAccount != nil {
-			encoded, err := options.Marshal(x.BaseAccount)
+		if x.Timeout != nil {
+			encoded, err := options.Marshal(x.Timeout)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -453,7 +1485,17 @@ func (x *fastReflection_Account) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+		if x.TimeoutBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeoutBlocks))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MaxAttempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAttempts))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -466,7 +1508,7 @@ func (x *fastReflection_Account) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Account)
+		x := input.Message.Interface().(*FallbackPolicy)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -498,17 +1540,17 @@ func (x *fastReflection_Account) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Account: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FallbackPolicy: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FallbackPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
 				}
-				var msglen int
+				x.MaxAttempts = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -518,33 +1560,16 @@ func (x *fastReflection_Account) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.MaxAttempts |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.BaseAccount == nil {
-					x.BaseAccount = &v1beta1.BaseAccount{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BaseAccount); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeoutBlocks", wireType)
 				}
-				x.DestinationDomain = 0
+				x.TimeoutBlocks = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -554,50 +1579,16 @@ func (x *fastReflection_Account) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DestinationDomain |= uint32(b&0x7F) << shift
+					x.TimeoutBlocks |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MintRecipient = append(x.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
-				if x.MintRecipient == nil {
-					x.MintRecipient = []byte{}
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -607,56 +1598,26 @@ func (x *fastReflection_Account) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FallbackRecipient = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if x.Timeout == nil {
+					x.Timeout = &durationpb.Duration{}
 				}
-				x.DestinationCaller = append(x.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
-				if x.DestinationCaller == nil {
-					x.DestinationCaller = []byte{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Timeout); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -714,7 +1675,7 @@ func (x *PubKey) ProtoReflect() protoreflect.Message {
 }
 
 func (x *PubKey) slowProtoReflect() protoreflect.Message {
-	mi := &file_noble_autocctp_v1_account_proto_msgTypes[1]
+	mi := &file_noble_autocctp_v1_account_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,6 +2101,25 @@ type Account struct {
 	MintRecipient     []byte               `protobuf:"bytes,3,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
 	FallbackRecipient string               `protobuf:"bytes,4,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
 	DestinationCaller []byte               `protobuf:"bytes,5,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	// An optional policy to automatically clear the account to the fallback recipient when
+	// the CCTP transfers keep failing.
+	FallbackPolicy *FallbackPolicy `protobuf:"bytes,6,opt,name=fallback_policy,json=fallbackPolicy,proto3" json:"fallback_policy,omitempty"`
+	// If true, the account accepts denoms other than the minting denom, which are forwarded
+	// to the fallback recipient at the end of the block.
+	ForwardOtherDenoms bool `protobuf:"varint,7,opt,name=forward_other_denoms,json=forwardOtherDenoms,proto3" json:"forward_other_denoms,omitempty"`
+	// If true, the account has been deregistered by the fallback recipient, or pruned after
+	// its expiration. A deregistered account is kept as an inert record that cannot receive
+	// funds nor be registered again.
+	Deregistered bool `protobuf:"varint,8,opt,name=deregistered,proto3" json:"deregistered,omitempty"`
+	// An optional block height from which the account expires. Zero means no expiration.
+	ExpirationHeight uint64 `protobuf:"varint,9,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// An optional time from which the account expires.
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	// The version of the scheme used to derive the account address.
+	AddressVersion uint32 `protobuf:"varint,11,opt,name=address_version,json=addressVersion,proto3" json:"address_version,omitempty"`
+	// An optional salt used to derive a different address for the same properties. Only
+	// supported from the address version 1.
+	Salt []byte `protobuf:"bytes,12,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
 func (x *Account) Reset() {
@@ -1197,6 +2177,111 @@ func (x *Account) GetDestinationCaller() []byte {
 	return nil
 }
 
+func (x *Account) GetFallbackPolicy() *FallbackPolicy {
+	if x != nil {
+		return x.FallbackPolicy
+	}
+	return nil
+}
+
+func (x *Account) GetForwardOtherDenoms() bool {
+	if x != nil {
+		return x.ForwardOtherDenoms
+	}
+	return false
+}
+
+func (x *Account) GetDeregistered() bool {
+	if x != nil {
+		return x.Deregistered
+	}
+	return false
+}
+
+func (x *Account) GetExpirationHeight() uint64 {
+	if x != nil {
+		return x.ExpirationHeight
+	}
+	return 0
+}
+
+func (x *Account) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *Account) GetAddressVersion() uint32 {
+	if x != nil {
+		return x.AddressVersion
+	}
+	return 0
+}
+
+func (x *Account) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+// FallbackPolicy defines when the funds of an AutoCCTP account with a failed transfer are
+// automatically sent to the fallback recipient. A zero value disables the condition.
+type FallbackPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of failed CCTP transfer attempts after which the account is cleared.
+	MaxAttempts uint64 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// The number of blocks since the first failed attempt after which the account is cleared.
+	TimeoutBlocks uint64 `protobuf:"varint,2,opt,name=timeout_blocks,json=timeoutBlocks,proto3" json:"timeout_blocks,omitempty"`
+	// The time elapsed since the first failed attempt after which the account is cleared.
+	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *FallbackPolicy) Reset() {
+	*x = FallbackPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_autocctp_v1_account_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FallbackPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FallbackPolicy) ProtoMessage() {}
+
+// Deprecated: Use FallbackPolicy.ProtoReflect.Descriptor instead.
+func (*FallbackPolicy) Descriptor() ([]byte, []int) {
+	return file_noble_autocctp_v1_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FallbackPolicy) GetMaxAttempts() uint64 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *FallbackPolicy) GetTimeoutBlocks() uint64 {
+	if x != nil {
+		return x.TimeoutBlocks
+	}
+	return 0
+}
+
+func (x *FallbackPolicy) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
 // PubKey is the custom AutoCCTP public key type used for custom AutoCCTP accounts.
 type PubKey struct {
 	state         protoimpl.MessageState
@@ -1209,7 +2294,7 @@ type PubKey struct {
 func (x *PubKey) Reset() {
 	*x = PubKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_autocctp_v1_account_proto_msgTypes[1]
+		mi := &file_noble_autocctp_v1_account_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1223,7 +2308,7 @@ func (*PubKey) ProtoMessage() {}
 
 // Deprecated: Use PubKey.ProtoReflect.Descriptor instead.
 func (*PubKey) Descriptor() ([]byte, []int) {
-	return file_noble_autocctp_v1_account_proto_rawDescGZIP(), []int{1}
+	return file_noble_autocctp_v1_account_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PubKey) GetKey() []byte {
@@ -1244,40 +2329,75 @@ var file_noble_autocctp_v1_account_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x49, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x61,
-	0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x04, 0xd0, 0xde, 0x1f, 0x01, 0x52,
-	0x0b, 0x62, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12,
-	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6d,
-	0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
-	0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
-	0x74, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72,
-	0x3a, 0x20, 0xca, 0xb4, 0x2d, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x22, 0x20, 0x0a, 0x06, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x3a, 0x04,
-	0x98, 0xa0, 0x1f, 0x00, 0x42, 0xba, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62,
-	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0c,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d,
-	0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2f,
-	0x76, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x4e, 0x41, 0x58, 0xaa, 0x02, 0x11, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f,
-	0x63, 0x63, 0x74, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c,
-	0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x4e, 0x6f,
-	0x62, 0x6c, 0x65, 0x5c, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x4e, 0x6f,
-	0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x05, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42,
+	0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x04, 0xd0, 0xde, 0x1f, 0x01,
+	0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a,
+	0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x4a, 0x0a, 0x0f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x66,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a,
+	0x14, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x49, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x3a, 0x20, 0xca, 0xb4, 0x2d, 0x1c, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x22, 0x99, 0x01, 0x0a, 0x0e, 0x46,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x3d, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x20, 0x0a, 0x06, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x3a, 0x04, 0x98, 0xa0, 0x1f, 0x00, 0x42, 0xba, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e,
+	0x76, 0x31, 0x42, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x2d, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x64, 0x65, 0x76,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x63,
+	0x63, 0x74, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x4e, 0x41, 0x58, 0xaa, 0x02, 0x11, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x4e, 0x6f,
+	0x62, 0x6c, 0x65, 0x5c, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1d, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74,
+	0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1292,19 +2412,25 @@ func file_noble_autocctp_v1_account_proto_rawDescGZIP() []byte {
 	return file_noble_autocctp_v1_account_proto_rawDescData
 }
 
-var file_noble_autocctp_v1_account_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_noble_autocctp_v1_account_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_noble_autocctp_v1_account_proto_goTypes = []interface{}{
-	(*Account)(nil),             // 0: noble.autocctp.v1.Account
-	(*PubKey)(nil),              // 1: noble.autocctp.v1.PubKey
-	(*v1beta1.BaseAccount)(nil), // 2: cosmos.auth.v1beta1.BaseAccount
+	(*Account)(nil),               // 0: noble.autocctp.v1.Account
+	(*FallbackPolicy)(nil),        // 1: noble.autocctp.v1.FallbackPolicy
+	(*PubKey)(nil),                // 2: noble.autocctp.v1.PubKey
+	(*v1beta1.BaseAccount)(nil),   // 3: cosmos.auth.v1beta1.BaseAccount
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 5: google.protobuf.Duration
 }
 var file_noble_autocctp_v1_account_proto_depIdxs = []int32{
-	2, // 0: noble.autocctp.v1.Account.base_account:type_name -> cosmos.auth.v1beta1.BaseAccount
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: noble.autocctp.v1.Account.base_account:type_name -> cosmos.auth.v1beta1.BaseAccount
+	1, // 1: noble.autocctp.v1.Account.fallback_policy:type_name -> noble.autocctp.v1.FallbackPolicy
+	4, // 2: noble.autocctp.v1.Account.expiration_time:type_name -> google.protobuf.Timestamp
+	5, // 3: noble.autocctp.v1.FallbackPolicy.timeout:type_name -> google.protobuf.Duration
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_noble_autocctp_v1_account_proto_init() }
@@ -1326,6 +2452,18 @@ func file_noble_autocctp_v1_account_proto_init() {
 			}
 		}
 		file_noble_autocctp_v1_account_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FallbackPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_autocctp_v1_account_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PubKey); i {
 			case 0:
 				return &v.state
@@ -1344,7 +2482,7 @@ func file_noble_autocctp_v1_account_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_autocctp_v1_account_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
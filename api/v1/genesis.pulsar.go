@@ -3,7 +3,9 @@ package autocctpv1
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -177,33 +179,232 @@ func (x *_GenesisState_2_map) IsValid() bool {
 	return x.m != nil
 }
 
-var _ protoreflect.Map = (*_GenesisState_3_map)(nil)
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
 
-type _GenesisState_3_map struct {
-	m *map[uint32]uint64
+type _GenesisState_5_list struct {
+	list *[]*DomainConfig
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DomainConfig)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DomainConfig)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(DomainConfig)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(DomainConfig)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*FailedTransfer
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FailedTransfer)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FailedTransfer)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(FailedTransfer)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(FailedTransfer)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*TransferRecord
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransferRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransferRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(TransferRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(TransferRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (x *_GenesisState_3_map) Len() int {
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]uint32
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint32((*x.list)[i])
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := (uint32)(valueUnwrapped)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field PausedDestinationDomains as it is not of Message kind"))
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := uint32(0)
+	return protoreflect.ValueOfUint32(v)
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_GenesisState_10_map)(nil)
+
+type _GenesisState_10_map struct {
+	m *map[uint32]string
+}
+
+func (x *_GenesisState_10_map) Len() int {
 	if x.m == nil {
 		return 0
 	}
 	return len(*x.m)
 }
 
-func (x *_GenesisState_3_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+func (x *_GenesisState_10_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
 	if x.m == nil {
 		return
 	}
 	for k, v := range *x.m {
 		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint32(k))
-		mapValue := protoreflect.ValueOfUint64(v)
+		mapValue := protoreflect.ValueOfString(v)
 		if !f(mapKey, mapValue) {
 			break
 		}
 	}
 }
 
-func (x *_GenesisState_3_map) Has(key protoreflect.MapKey) bool {
+func (x *_GenesisState_10_map) Has(key protoreflect.MapKey) bool {
 	if x.m == nil {
 		return false
 	}
@@ -213,7 +414,7 @@ func (x *_GenesisState_3_map) Has(key protoreflect.MapKey) bool {
 	return ok
 }
 
-func (x *_GenesisState_3_map) Clear(key protoreflect.MapKey) {
+func (x *_GenesisState_10_map) Clear(key protoreflect.MapKey) {
 	if x.m == nil {
 		return
 	}
@@ -222,7 +423,7 @@ func (x *_GenesisState_3_map) Clear(key protoreflect.MapKey) {
 	delete(*x.m, concreteKey)
 }
 
-func (x *_GenesisState_3_map) Get(key protoreflect.MapKey) protoreflect.Value {
+func (x *_GenesisState_10_map) Get(key protoreflect.MapKey) protoreflect.Value {
 	if x.m == nil {
 		return protoreflect.Value{}
 	}
@@ -232,213 +433,4547 @@ func (x *_GenesisState_3_map) Get(key protoreflect.MapKey) protoreflect.Value {
 	if !ok {
 		return protoreflect.Value{}
 	}
-	return protoreflect.ValueOfUint64(v)
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *_GenesisState_3_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+func (x *_GenesisState_10_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
 	if !key.IsValid() || !value.IsValid() {
 		panic("invalid key or value provided")
 	}
 	keyUnwrapped := key.Uint()
 	concreteKey := (uint32)(keyUnwrapped)
-	valueUnwrapped := value.Uint()
+	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	(*x.m)[concreteKey] = concreteValue
 }
 
-func (x *_GenesisState_3_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+func (x *_GenesisState_10_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
 	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
 }
 
-func (x *_GenesisState_3_map) NewValue() protoreflect.Value {
-	v := uint64(0)
-	return protoreflect.ValueOfUint64(v)
+func (x *_GenesisState_10_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *_GenesisState_3_map) IsValid() bool {
+func (x *_GenesisState_10_map) IsValid() bool {
 	return x.m != nil
 }
 
-var (
-	md_GenesisState                   protoreflect.MessageDescriptor
-	fd_GenesisState_num_of_accounts   protoreflect.FieldDescriptor
-	fd_GenesisState_num_of_transfers  protoreflect.FieldDescriptor
-	fd_GenesisState_total_transferred protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
 
-func init() {
-	file_noble_autocctp_v1_genesis_proto_init()
-	md_GenesisState = File_noble_autocctp_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_num_of_accounts = md_GenesisState.Fields().ByName("num_of_accounts")
-	fd_GenesisState_num_of_transfers = md_GenesisState.Fields().ByName("num_of_transfers")
-	fd_GenesisState_total_transferred = md_GenesisState.Fields().ByName("total_transferred")
+type _GenesisState_11_list struct {
+	list *[]*StatsBucket
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
-
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_noble_autocctp_v1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StatsBucket)
+	(*x.list)[i] = concreteValue
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StatsBucket)
+	*x.list = append(*x.list, concreteValue)
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(StatsBucket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(StatsBucket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+var _ protoreflect.Map = (*_GenesisState_12_map)(nil)
+
+type _GenesisState_12_map struct {
+	m *map[uint32]*OutcomeStats
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_12_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_12_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint32(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_12_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Uint()
+	concreteValue := (uint32)(keyUnwrapped)
+	_, ok := (*x.m)[concreteValue]
+	return ok
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.NumOfAccounts) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_1_map{m: &x.NumOfAccounts})
-		if !f(fd_GenesisState_num_of_accounts, value) {
-			return
-		}
+func (x *_GenesisState_12_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
 	}
-	if len(x.NumOfTransfers) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_2_map{m: &x.NumOfTransfers})
-		if !f(fd_GenesisState_num_of_transfers, value) {
-			return
-		}
+	keyUnwrapped := key.Uint()
+	concreteKey := (uint32)(keyUnwrapped)
+	delete(*x.m, concreteKey)
+}
+
+func (x *_GenesisState_12_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
 	}
-	if len(x.TotalTransferred) != 0 {
-		value := protoreflect.ValueOfMap(&_GenesisState_3_map{m: &x.TotalTransferred})
-		if !f(fd_GenesisState_total_transferred, value) {
-			return
-		}
+	keyUnwrapped := key.Uint()
+	concreteKey := (uint32)(keyUnwrapped)
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
 	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "noble.autocctp.v1.GenesisState.num_of_accounts":
-		return len(x.NumOfAccounts) != 0
-	case "noble.autocctp.v1.GenesisState.num_of_transfers":
-		return len(x.NumOfTransfers) != 0
-	case "noble.autocctp.v1.GenesisState.total_transferred":
-		return len(x.TotalTransferred) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.autocctp.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_12_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
 	}
+	keyUnwrapped := key.Uint()
+	concreteKey := (uint32)(keyUnwrapped)
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OutcomeStats)
+	(*x.m)[concreteKey] = concreteValue
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "noble.autocctp.v1.GenesisState.num_of_accounts":
-		x.NumOfAccounts = nil
-	case "noble.autocctp.v1.GenesisState.num_of_transfers":
-		x.NumOfTransfers = nil
-	case "noble.autocctp.v1.GenesisState.total_transferred":
-		x.TotalTransferred = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message noble.autocctp.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_12_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.Uint()
+	concreteKey := (uint32)(keyUnwrapped)
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
 	}
+	newValue := new(OutcomeStats)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
+func (x *_GenesisState_12_map) NewValue() protoreflect.Value {
+	v := new(OutcomeStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field AwaitingTransfers as it is not of Message kind"))
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_GenesisState_16_map)(nil)
+
+type _GenesisState_16_map struct {
+	m *map[uint32]*RateLimitUsage
+}
+
+func (x *_GenesisState_16_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_GenesisState_16_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint32(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_GenesisState_16_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Uint()
+	concreteValue := (uint32)(keyUnwrapped)
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_GenesisState_16_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := (uint32)(keyUnwrapped)
+	delete(*x.m, concreteKey)
+}
+
+func (x *_GenesisState_16_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := (uint32)(keyUnwrapped)
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := (uint32)(keyUnwrapped)
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimitUsage)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_GenesisState_16_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.Uint()
+	concreteKey := (uint32)(keyUnwrapped)
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(RateLimitUsage)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
+}
+
+func (x *_GenesisState_16_map) NewValue() protoreflect.Value {
+	v := new(RateLimitUsage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_map) IsValid() bool {
+	return x.m != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*RateLimitBucket
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimitBucket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RateLimitBucket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(RateLimitBucket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(RateLimitBucket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field RateLimitedTransfers as it is not of Message kind"))
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*QueuedTransfer
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedTransfer)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedTransfer)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(QueuedTransfer)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(QueuedTransfer)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_21_list)(nil)
+
+type _GenesisState_21_list struct {
+	list *[]*PruneRetry
+}
+
+func (x *_GenesisState_21_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PruneRetry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PruneRetry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_21_list) AppendMutable() protoreflect.Value {
+	v := new(PruneRetry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_21_list) NewElement() protoreflect.Value {
+	v := new(PruneRetry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_22_list)(nil)
+
+type _GenesisState_22_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_22_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_22_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field DirtyAccounts as it is not of Message kind"))
+}
+
+func (x *_GenesisState_22_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_22_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_22_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_24_list)(nil)
+
+type _GenesisState_24_list struct {
+	list *[]*FailedForward
+}
+
+func (x *_GenesisState_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FailedForward)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FailedForward)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_24_list) AppendMutable() protoreflect.Value {
+	v := new(FailedForward)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_24_list) NewElement() protoreflect.Value {
+	v := new(FailedForward)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_24_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_26_list)(nil)
+
+type _GenesisState_26_list struct {
+	list *[]*OwedFee
+}
+
+func (x *_GenesisState_26_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_26_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OwedFee)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_26_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OwedFee)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_26_list) AppendMutable() protoreflect.Value {
+	v := new(OwedFee)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_26_list) NewElement() protoreflect.Value {
+	v := new(OwedFee)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.Map = (*_GenesisState_27_map)(nil)
+
+type _GenesisState_27_map struct {
+	m *map[uint32]string
+}
+
+func (x *_GenesisState_27_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_GenesisState_27_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfUint32(k))
+		mapValue := protoreflect.ValueOfString(v)
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_GenesisState_27_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.Uint()
+	concreteValue := (uint32)(keyUnwrapped)
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_GenesisState_27_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := (uint32)(keyUnwrapped)
+	delete(*x.m, concreteKey)
+}
+
+func (x *_GenesisState_27_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := (uint32)(keyUnwrapped)
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_27_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.Uint()
+	concreteKey := (uint32)(keyUnwrapped)
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_GenesisState_27_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	panic("should not call Mutable on protoreflect.Map whose value is not of type protoreflect.Message")
+}
+
+func (x *_GenesisState_27_map) NewValue() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_27_map) IsValid() bool {
+	return x.m != nil
+}
+
+var (
+	md_GenesisState                            protoreflect.MessageDescriptor
+	fd_GenesisState_num_of_accounts            protoreflect.FieldDescriptor
+	fd_GenesisState_num_of_transfers           protoreflect.FieldDescriptor
+	fd_GenesisState_params                     protoreflect.FieldDescriptor
+	fd_GenesisState_domains                    protoreflect.FieldDescriptor
+	fd_GenesisState_failed_transfers           protoreflect.FieldDescriptor
+	fd_GenesisState_transfer_history           protoreflect.FieldDescriptor
+	fd_GenesisState_paused                     protoreflect.FieldDescriptor
+	fd_GenesisState_paused_destination_domains protoreflect.FieldDescriptor
+	fd_GenesisState_total_fees                 protoreflect.FieldDescriptor
+	fd_GenesisState_stats_history              protoreflect.FieldDescriptor
+	fd_GenesisState_outcome_stats              protoreflect.FieldDescriptor
+	fd_GenesisState_awaiting_transfers         protoreflect.FieldDescriptor
+	fd_GenesisState_resume_cursor              protoreflect.FieldDescriptor
+	fd_GenesisState_token_paused               protoreflect.FieldDescriptor
+	fd_GenesisState_rate_limit_usage           protoreflect.FieldDescriptor
+	fd_GenesisState_rate_limit_buckets         protoreflect.FieldDescriptor
+	fd_GenesisState_rate_limited_transfers     protoreflect.FieldDescriptor
+	fd_GenesisState_transfer_queue             protoreflect.FieldDescriptor
+	fd_GenesisState_transfer_queue_sequence    protoreflect.FieldDescriptor
+	fd_GenesisState_prune_retries              protoreflect.FieldDescriptor
+	fd_GenesisState_dirty_accounts             protoreflect.FieldDescriptor
+	fd_GenesisState_sweep_cursor               protoreflect.FieldDescriptor
+	fd_GenesisState_failed_forwards            protoreflect.FieldDescriptor
+	fd_GenesisState_fallback_sweep_cursor      protoreflect.FieldDescriptor
+	fd_GenesisState_owed_fees                  protoreflect.FieldDescriptor
+	fd_GenesisState_total_transferred_amount   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_autocctp_v1_genesis_proto_init()
+	md_GenesisState = File_noble_autocctp_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_num_of_accounts = md_GenesisState.Fields().ByName("num_of_accounts")
+	fd_GenesisState_num_of_transfers = md_GenesisState.Fields().ByName("num_of_transfers")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_domains = md_GenesisState.Fields().ByName("domains")
+	fd_GenesisState_failed_transfers = md_GenesisState.Fields().ByName("failed_transfers")
+	fd_GenesisState_transfer_history = md_GenesisState.Fields().ByName("transfer_history")
+	fd_GenesisState_paused = md_GenesisState.Fields().ByName("paused")
+	fd_GenesisState_paused_destination_domains = md_GenesisState.Fields().ByName("paused_destination_domains")
+	fd_GenesisState_total_fees = md_GenesisState.Fields().ByName("total_fees")
+	fd_GenesisState_stats_history = md_GenesisState.Fields().ByName("stats_history")
+	fd_GenesisState_outcome_stats = md_GenesisState.Fields().ByName("outcome_stats")
+	fd_GenesisState_awaiting_transfers = md_GenesisState.Fields().ByName("awaiting_transfers")
+	fd_GenesisState_resume_cursor = md_GenesisState.Fields().ByName("resume_cursor")
+	fd_GenesisState_token_paused = md_GenesisState.Fields().ByName("token_paused")
+	fd_GenesisState_rate_limit_usage = md_GenesisState.Fields().ByName("rate_limit_usage")
+	fd_GenesisState_rate_limit_buckets = md_GenesisState.Fields().ByName("rate_limit_buckets")
+	fd_GenesisState_rate_limited_transfers = md_GenesisState.Fields().ByName("rate_limited_transfers")
+	fd_GenesisState_transfer_queue = md_GenesisState.Fields().ByName("transfer_queue")
+	fd_GenesisState_transfer_queue_sequence = md_GenesisState.Fields().ByName("transfer_queue_sequence")
+	fd_GenesisState_prune_retries = md_GenesisState.Fields().ByName("prune_retries")
+	fd_GenesisState_dirty_accounts = md_GenesisState.Fields().ByName("dirty_accounts")
+	fd_GenesisState_sweep_cursor = md_GenesisState.Fields().ByName("sweep_cursor")
+	fd_GenesisState_failed_forwards = md_GenesisState.Fields().ByName("failed_forwards")
+	fd_GenesisState_fallback_sweep_cursor = md_GenesisState.Fields().ByName("fallback_sweep_cursor")
+	fd_GenesisState_owed_fees = md_GenesisState.Fields().ByName("owed_fees")
+	fd_GenesisState_total_transferred_amount = md_GenesisState.Fields().ByName("total_transferred_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_autocctp_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.NumOfAccounts) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_1_map{m: &x.NumOfAccounts})
+		if !f(fd_GenesisState_num_of_accounts, value) {
+			return
+		}
+	}
+	if len(x.NumOfTransfers) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_2_map{m: &x.NumOfTransfers})
+		if !f(fd_GenesisState_num_of_transfers, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.Domains) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Domains})
+		if !f(fd_GenesisState_domains, value) {
+			return
+		}
+	}
+	if len(x.FailedTransfers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.FailedTransfers})
+		if !f(fd_GenesisState_failed_transfers, value) {
+			return
+		}
+	}
+	if len(x.TransferHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.TransferHistory})
+		if !f(fd_GenesisState_transfer_history, value) {
+			return
+		}
+	}
+	if x.Paused != false {
+		value := protoreflect.ValueOfBool(x.Paused)
+		if !f(fd_GenesisState_paused, value) {
+			return
+		}
+	}
+	if len(x.PausedDestinationDomains) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.PausedDestinationDomains})
+		if !f(fd_GenesisState_paused_destination_domains, value) {
+			return
+		}
+	}
+	if len(x.TotalFees) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_10_map{m: &x.TotalFees})
+		if !f(fd_GenesisState_total_fees, value) {
+			return
+		}
+	}
+	if len(x.StatsHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.StatsHistory})
+		if !f(fd_GenesisState_stats_history, value) {
+			return
+		}
+	}
+	if len(x.OutcomeStats) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_12_map{m: &x.OutcomeStats})
+		if !f(fd_GenesisState_outcome_stats, value) {
+			return
+		}
+	}
+	if len(x.AwaitingTransfers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.AwaitingTransfers})
+		if !f(fd_GenesisState_awaiting_transfers, value) {
+			return
+		}
+	}
+	if x.ResumeCursor != nil {
+		value := protoreflect.ValueOfMessage(x.ResumeCursor.ProtoReflect())
+		if !f(fd_GenesisState_resume_cursor, value) {
+			return
+		}
+	}
+	if x.TokenPaused != false {
+		value := protoreflect.ValueOfBool(x.TokenPaused)
+		if !f(fd_GenesisState_token_paused, value) {
+			return
+		}
+	}
+	if len(x.RateLimitUsage) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_16_map{m: &x.RateLimitUsage})
+		if !f(fd_GenesisState_rate_limit_usage, value) {
+			return
+		}
+	}
+	if len(x.RateLimitBuckets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.RateLimitBuckets})
+		if !f(fd_GenesisState_rate_limit_buckets, value) {
+			return
+		}
+	}
+	if len(x.RateLimitedTransfers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.RateLimitedTransfers})
+		if !f(fd_GenesisState_rate_limited_transfers, value) {
+			return
+		}
+	}
+	if len(x.TransferQueue) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.TransferQueue})
+		if !f(fd_GenesisState_transfer_queue, value) {
+			return
+		}
+	}
+	if x.TransferQueueSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TransferQueueSequence)
+		if !f(fd_GenesisState_transfer_queue_sequence, value) {
+			return
+		}
+	}
+	if len(x.PruneRetries) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_21_list{list: &x.PruneRetries})
+		if !f(fd_GenesisState_prune_retries, value) {
+			return
+		}
+	}
+	if len(x.DirtyAccounts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_22_list{list: &x.DirtyAccounts})
+		if !f(fd_GenesisState_dirty_accounts, value) {
+			return
+		}
+	}
+	if x.SweepCursor != nil {
+		value := protoreflect.ValueOfMessage(x.SweepCursor.ProtoReflect())
+		if !f(fd_GenesisState_sweep_cursor, value) {
+			return
+		}
+	}
+	if len(x.FailedForwards) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_24_list{list: &x.FailedForwards})
+		if !f(fd_GenesisState_failed_forwards, value) {
+			return
+		}
+	}
+	if x.FallbackSweepCursor != "" {
+		value := protoreflect.ValueOfString(x.FallbackSweepCursor)
+		if !f(fd_GenesisState_fallback_sweep_cursor, value) {
+			return
+		}
+	}
+	if len(x.OwedFees) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_26_list{list: &x.OwedFees})
+		if !f(fd_GenesisState_owed_fees, value) {
+			return
+		}
+	}
+	if len(x.TotalTransferredAmount) != 0 {
+		value := protoreflect.ValueOfMap(&_GenesisState_27_map{m: &x.TotalTransferredAmount})
+		if !f(fd_GenesisState_total_transferred_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.GenesisState.num_of_accounts":
+		return len(x.NumOfAccounts) != 0
+	case "noble.autocctp.v1.GenesisState.num_of_transfers":
+		return len(x.NumOfTransfers) != 0
+	case "noble.autocctp.v1.GenesisState.params":
+		return x.Params != nil
+	case "noble.autocctp.v1.GenesisState.domains":
+		return len(x.Domains) != 0
+	case "noble.autocctp.v1.GenesisState.failed_transfers":
+		return len(x.FailedTransfers) != 0
+	case "noble.autocctp.v1.GenesisState.transfer_history":
+		return len(x.TransferHistory) != 0
+	case "noble.autocctp.v1.GenesisState.paused":
+		return x.Paused != false
+	case "noble.autocctp.v1.GenesisState.paused_destination_domains":
+		return len(x.PausedDestinationDomains) != 0
+	case "noble.autocctp.v1.GenesisState.total_fees":
+		return len(x.TotalFees) != 0
+	case "noble.autocctp.v1.GenesisState.stats_history":
+		return len(x.StatsHistory) != 0
+	case "noble.autocctp.v1.GenesisState.outcome_stats":
+		return len(x.OutcomeStats) != 0
+	case "noble.autocctp.v1.GenesisState.awaiting_transfers":
+		return len(x.AwaitingTransfers) != 0
+	case "noble.autocctp.v1.GenesisState.resume_cursor":
+		return x.ResumeCursor != nil
+	case "noble.autocctp.v1.GenesisState.token_paused":
+		return x.TokenPaused != false
+	case "noble.autocctp.v1.GenesisState.rate_limit_usage":
+		return len(x.RateLimitUsage) != 0
+	case "noble.autocctp.v1.GenesisState.rate_limit_buckets":
+		return len(x.RateLimitBuckets) != 0
+	case "noble.autocctp.v1.GenesisState.rate_limited_transfers":
+		return len(x.RateLimitedTransfers) != 0
+	case "noble.autocctp.v1.GenesisState.transfer_queue":
+		return len(x.TransferQueue) != 0
+	case "noble.autocctp.v1.GenesisState.transfer_queue_sequence":
+		return x.TransferQueueSequence != uint64(0)
+	case "noble.autocctp.v1.GenesisState.prune_retries":
+		return len(x.PruneRetries) != 0
+	case "noble.autocctp.v1.GenesisState.dirty_accounts":
+		return len(x.DirtyAccounts) != 0
+	case "noble.autocctp.v1.GenesisState.sweep_cursor":
+		return x.SweepCursor != nil
+	case "noble.autocctp.v1.GenesisState.failed_forwards":
+		return len(x.FailedForwards) != 0
+	case "noble.autocctp.v1.GenesisState.fallback_sweep_cursor":
+		return x.FallbackSweepCursor != ""
+	case "noble.autocctp.v1.GenesisState.owed_fees":
+		return len(x.OwedFees) != 0
+	case "noble.autocctp.v1.GenesisState.total_transferred_amount":
+		return len(x.TotalTransferredAmount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.GenesisState.num_of_accounts":
+		x.NumOfAccounts = nil
+	case "noble.autocctp.v1.GenesisState.num_of_transfers":
+		x.NumOfTransfers = nil
+	case "noble.autocctp.v1.GenesisState.params":
+		x.Params = nil
+	case "noble.autocctp.v1.GenesisState.domains":
+		x.Domains = nil
+	case "noble.autocctp.v1.GenesisState.failed_transfers":
+		x.FailedTransfers = nil
+	case "noble.autocctp.v1.GenesisState.transfer_history":
+		x.TransferHistory = nil
+	case "noble.autocctp.v1.GenesisState.paused":
+		x.Paused = false
+	case "noble.autocctp.v1.GenesisState.paused_destination_domains":
+		x.PausedDestinationDomains = nil
+	case "noble.autocctp.v1.GenesisState.total_fees":
+		x.TotalFees = nil
+	case "noble.autocctp.v1.GenesisState.stats_history":
+		x.StatsHistory = nil
+	case "noble.autocctp.v1.GenesisState.outcome_stats":
+		x.OutcomeStats = nil
+	case "noble.autocctp.v1.GenesisState.awaiting_transfers":
+		x.AwaitingTransfers = nil
+	case "noble.autocctp.v1.GenesisState.resume_cursor":
+		x.ResumeCursor = nil
+	case "noble.autocctp.v1.GenesisState.token_paused":
+		x.TokenPaused = false
+	case "noble.autocctp.v1.GenesisState.rate_limit_usage":
+		x.RateLimitUsage = nil
+	case "noble.autocctp.v1.GenesisState.rate_limit_buckets":
+		x.RateLimitBuckets = nil
+	case "noble.autocctp.v1.GenesisState.rate_limited_transfers":
+		x.RateLimitedTransfers = nil
+	case "noble.autocctp.v1.GenesisState.transfer_queue":
+		x.TransferQueue = nil
+	case "noble.autocctp.v1.GenesisState.transfer_queue_sequence":
+		x.TransferQueueSequence = uint64(0)
+	case "noble.autocctp.v1.GenesisState.prune_retries":
+		x.PruneRetries = nil
+	case "noble.autocctp.v1.GenesisState.dirty_accounts":
+		x.DirtyAccounts = nil
+	case "noble.autocctp.v1.GenesisState.sweep_cursor":
+		x.SweepCursor = nil
+	case "noble.autocctp.v1.GenesisState.failed_forwards":
+		x.FailedForwards = nil
+	case "noble.autocctp.v1.GenesisState.fallback_sweep_cursor":
+		x.FallbackSweepCursor = ""
+	case "noble.autocctp.v1.GenesisState.owed_fees":
+		x.OwedFees = nil
+	case "noble.autocctp.v1.GenesisState.total_transferred_amount":
+		x.TotalTransferredAmount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "noble.autocctp.v1.GenesisState.num_of_accounts":
-		if len(x.NumOfAccounts) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_1_map{})
+	case "noble.autocctp.v1.GenesisState.num_of_accounts":
+		if len(x.NumOfAccounts) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_1_map{})
+		}
+		mapValue := &_GenesisState_1_map{m: &x.NumOfAccounts}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.autocctp.v1.GenesisState.num_of_transfers":
+		if len(x.NumOfTransfers) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_2_map{})
+		}
+		mapValue := &_GenesisState_2_map{m: &x.NumOfTransfers}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.autocctp.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.autocctp.v1.GenesisState.domains":
+		if len(x.Domains) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Domains}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.failed_transfers":
+		if len(x.FailedTransfers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.FailedTransfers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.transfer_history":
+		if len(x.TransferHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.TransferHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.paused":
+		value := x.Paused
+		return protoreflect.ValueOfBool(value)
+	case "noble.autocctp.v1.GenesisState.paused_destination_domains":
+		if len(x.PausedDestinationDomains) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.PausedDestinationDomains}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.total_fees":
+		if len(x.TotalFees) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_10_map{})
+		}
+		mapValue := &_GenesisState_10_map{m: &x.TotalFees}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.autocctp.v1.GenesisState.stats_history":
+		if len(x.StatsHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.StatsHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.outcome_stats":
+		if len(x.OutcomeStats) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_12_map{})
+		}
+		mapValue := &_GenesisState_12_map{m: &x.OutcomeStats}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.autocctp.v1.GenesisState.awaiting_transfers":
+		if len(x.AwaitingTransfers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.AwaitingTransfers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.resume_cursor":
+		value := x.ResumeCursor
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.autocctp.v1.GenesisState.token_paused":
+		value := x.TokenPaused
+		return protoreflect.ValueOfBool(value)
+	case "noble.autocctp.v1.GenesisState.rate_limit_usage":
+		if len(x.RateLimitUsage) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_16_map{})
+		}
+		mapValue := &_GenesisState_16_map{m: &x.RateLimitUsage}
+		return protoreflect.ValueOfMap(mapValue)
+	case "noble.autocctp.v1.GenesisState.rate_limit_buckets":
+		if len(x.RateLimitBuckets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.RateLimitBuckets}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.rate_limited_transfers":
+		if len(x.RateLimitedTransfers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.RateLimitedTransfers}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.transfer_queue":
+		if len(x.TransferQueue) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.TransferQueue}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.transfer_queue_sequence":
+		value := x.TransferQueueSequence
+		return protoreflect.ValueOfUint64(value)
+	case "noble.autocctp.v1.GenesisState.prune_retries":
+		if len(x.PruneRetries) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_21_list{})
+		}
+		listValue := &_GenesisState_21_list{list: &x.PruneRetries}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.dirty_accounts":
+		if len(x.DirtyAccounts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_22_list{})
+		}
+		listValue := &_GenesisState_22_list{list: &x.DirtyAccounts}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.sweep_cursor":
+		value := x.SweepCursor
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.autocctp.v1.GenesisState.failed_forwards":
+		if len(x.FailedForwards) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_24_list{})
+		}
+		listValue := &_GenesisState_24_list{list: &x.FailedForwards}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.fallback_sweep_cursor":
+		value := x.FallbackSweepCursor
+		return protoreflect.ValueOfString(value)
+	case "noble.autocctp.v1.GenesisState.owed_fees":
+		if len(x.OwedFees) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_26_list{})
+		}
+		listValue := &_GenesisState_26_list{list: &x.OwedFees}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.GenesisState.total_transferred_amount":
+		if len(x.TotalTransferredAmount) == 0 {
+			return protoreflect.ValueOfMap(&_GenesisState_27_map{})
+		}
+		mapValue := &_GenesisState_27_map{m: &x.TotalTransferredAmount}
+		return protoreflect.ValueOfMap(mapValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.GenesisState.num_of_accounts":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_1_map)
+		x.NumOfAccounts = *cmv.m
+	case "noble.autocctp.v1.GenesisState.num_of_transfers":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_2_map)
+		x.NumOfTransfers = *cmv.m
+	case "noble.autocctp.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "noble.autocctp.v1.GenesisState.domains":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Domains = *clv.list
+	case "noble.autocctp.v1.GenesisState.failed_transfers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.FailedTransfers = *clv.list
+	case "noble.autocctp.v1.GenesisState.transfer_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.TransferHistory = *clv.list
+	case "noble.autocctp.v1.GenesisState.paused":
+		x.Paused = value.Bool()
+	case "noble.autocctp.v1.GenesisState.paused_destination_domains":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.PausedDestinationDomains = *clv.list
+	case "noble.autocctp.v1.GenesisState.total_fees":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_10_map)
+		x.TotalFees = *cmv.m
+	case "noble.autocctp.v1.GenesisState.stats_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.StatsHistory = *clv.list
+	case "noble.autocctp.v1.GenesisState.outcome_stats":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_12_map)
+		x.OutcomeStats = *cmv.m
+	case "noble.autocctp.v1.GenesisState.awaiting_transfers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.AwaitingTransfers = *clv.list
+	case "noble.autocctp.v1.GenesisState.resume_cursor":
+		x.ResumeCursor = value.Message().Interface().(*ResumeCursor)
+	case "noble.autocctp.v1.GenesisState.token_paused":
+		x.TokenPaused = value.Bool()
+	case "noble.autocctp.v1.GenesisState.rate_limit_usage":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_16_map)
+		x.RateLimitUsage = *cmv.m
+	case "noble.autocctp.v1.GenesisState.rate_limit_buckets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.RateLimitBuckets = *clv.list
+	case "noble.autocctp.v1.GenesisState.rate_limited_transfers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.RateLimitedTransfers = *clv.list
+	case "noble.autocctp.v1.GenesisState.transfer_queue":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.TransferQueue = *clv.list
+	case "noble.autocctp.v1.GenesisState.transfer_queue_sequence":
+		x.TransferQueueSequence = value.Uint()
+	case "noble.autocctp.v1.GenesisState.prune_retries":
+		lv := value.List()
+		clv := lv.(*_GenesisState_21_list)
+		x.PruneRetries = *clv.list
+	case "noble.autocctp.v1.GenesisState.dirty_accounts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_22_list)
+		x.DirtyAccounts = *clv.list
+	case "noble.autocctp.v1.GenesisState.sweep_cursor":
+		x.SweepCursor = value.Message().Interface().(*SweepCursor)
+	case "noble.autocctp.v1.GenesisState.failed_forwards":
+		lv := value.List()
+		clv := lv.(*_GenesisState_24_list)
+		x.FailedForwards = *clv.list
+	case "noble.autocctp.v1.GenesisState.fallback_sweep_cursor":
+		x.FallbackSweepCursor = value.Interface().(string)
+	case "noble.autocctp.v1.GenesisState.owed_fees":
+		lv := value.List()
+		clv := lv.(*_GenesisState_26_list)
+		x.OwedFees = *clv.list
+	case "noble.autocctp.v1.GenesisState.total_transferred_amount":
+		mv := value.Map()
+		cmv := mv.(*_GenesisState_27_map)
+		x.TotalTransferredAmount = *cmv.m
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.GenesisState.num_of_accounts":
+		if x.NumOfAccounts == nil {
+			x.NumOfAccounts = make(map[uint32]uint64)
+		}
+		value := &_GenesisState_1_map{m: &x.NumOfAccounts}
+		return protoreflect.ValueOfMap(value)
+	case "noble.autocctp.v1.GenesisState.num_of_transfers":
+		if x.NumOfTransfers == nil {
+			x.NumOfTransfers = make(map[uint32]uint64)
+		}
+		value := &_GenesisState_2_map{m: &x.NumOfTransfers}
+		return protoreflect.ValueOfMap(value)
+	case "noble.autocctp.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "noble.autocctp.v1.GenesisState.domains":
+		if x.Domains == nil {
+			x.Domains = []*DomainConfig{}
+		}
+		value := &_GenesisState_5_list{list: &x.Domains}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.failed_transfers":
+		if x.FailedTransfers == nil {
+			x.FailedTransfers = []*FailedTransfer{}
+		}
+		value := &_GenesisState_6_list{list: &x.FailedTransfers}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.transfer_history":
+		if x.TransferHistory == nil {
+			x.TransferHistory = []*TransferRecord{}
+		}
+		value := &_GenesisState_7_list{list: &x.TransferHistory}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.paused_destination_domains":
+		if x.PausedDestinationDomains == nil {
+			x.PausedDestinationDomains = []uint32{}
+		}
+		value := &_GenesisState_9_list{list: &x.PausedDestinationDomains}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.total_fees":
+		if x.TotalFees == nil {
+			x.TotalFees = make(map[uint32]string)
+		}
+		value := &_GenesisState_10_map{m: &x.TotalFees}
+		return protoreflect.ValueOfMap(value)
+	case "noble.autocctp.v1.GenesisState.stats_history":
+		if x.StatsHistory == nil {
+			x.StatsHistory = []*StatsBucket{}
+		}
+		value := &_GenesisState_11_list{list: &x.StatsHistory}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.outcome_stats":
+		if x.OutcomeStats == nil {
+			x.OutcomeStats = make(map[uint32]*OutcomeStats)
+		}
+		value := &_GenesisState_12_map{m: &x.OutcomeStats}
+		return protoreflect.ValueOfMap(value)
+	case "noble.autocctp.v1.GenesisState.awaiting_transfers":
+		if x.AwaitingTransfers == nil {
+			x.AwaitingTransfers = []string{}
+		}
+		value := &_GenesisState_13_list{list: &x.AwaitingTransfers}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.resume_cursor":
+		if x.ResumeCursor == nil {
+			x.ResumeCursor = new(ResumeCursor)
+		}
+		return protoreflect.ValueOfMessage(x.ResumeCursor.ProtoReflect())
+	case "noble.autocctp.v1.GenesisState.rate_limit_usage":
+		if x.RateLimitUsage == nil {
+			x.RateLimitUsage = make(map[uint32]*RateLimitUsage)
+		}
+		value := &_GenesisState_16_map{m: &x.RateLimitUsage}
+		return protoreflect.ValueOfMap(value)
+	case "noble.autocctp.v1.GenesisState.rate_limit_buckets":
+		if x.RateLimitBuckets == nil {
+			x.RateLimitBuckets = []*RateLimitBucket{}
+		}
+		value := &_GenesisState_17_list{list: &x.RateLimitBuckets}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.rate_limited_transfers":
+		if x.RateLimitedTransfers == nil {
+			x.RateLimitedTransfers = []string{}
+		}
+		value := &_GenesisState_18_list{list: &x.RateLimitedTransfers}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.transfer_queue":
+		if x.TransferQueue == nil {
+			x.TransferQueue = []*QueuedTransfer{}
+		}
+		value := &_GenesisState_19_list{list: &x.TransferQueue}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.prune_retries":
+		if x.PruneRetries == nil {
+			x.PruneRetries = []*PruneRetry{}
+		}
+		value := &_GenesisState_21_list{list: &x.PruneRetries}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.dirty_accounts":
+		if x.DirtyAccounts == nil {
+			x.DirtyAccounts = []string{}
+		}
+		value := &_GenesisState_22_list{list: &x.DirtyAccounts}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.sweep_cursor":
+		if x.SweepCursor == nil {
+			x.SweepCursor = new(SweepCursor)
+		}
+		return protoreflect.ValueOfMessage(x.SweepCursor.ProtoReflect())
+	case "noble.autocctp.v1.GenesisState.failed_forwards":
+		if x.FailedForwards == nil {
+			x.FailedForwards = []*FailedForward{}
+		}
+		value := &_GenesisState_24_list{list: &x.FailedForwards}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.owed_fees":
+		if x.OwedFees == nil {
+			x.OwedFees = []*OwedFee{}
+		}
+		value := &_GenesisState_26_list{list: &x.OwedFees}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.GenesisState.total_transferred_amount":
+		if x.TotalTransferredAmount == nil {
+			x.TotalTransferredAmount = make(map[uint32]string)
+		}
+		value := &_GenesisState_27_map{m: &x.TotalTransferredAmount}
+		return protoreflect.ValueOfMap(value)
+	case "noble.autocctp.v1.GenesisState.paused":
+		panic(fmt.Errorf("field paused of message noble.autocctp.v1.GenesisState is not mutable"))
+	case "noble.autocctp.v1.GenesisState.token_paused":
+		panic(fmt.Errorf("field token_paused of message noble.autocctp.v1.GenesisState is not mutable"))
+	case "noble.autocctp.v1.GenesisState.transfer_queue_sequence":
+		panic(fmt.Errorf("field transfer_queue_sequence of message noble.autocctp.v1.GenesisState is not mutable"))
+	case "noble.autocctp.v1.GenesisState.fallback_sweep_cursor":
+		panic(fmt.Errorf("field fallback_sweep_cursor of message noble.autocctp.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.GenesisState.num_of_accounts":
+		m := make(map[uint32]uint64)
+		return protoreflect.ValueOfMap(&_GenesisState_1_map{m: &m})
+	case "noble.autocctp.v1.GenesisState.num_of_transfers":
+		m := make(map[uint32]uint64)
+		return protoreflect.ValueOfMap(&_GenesisState_2_map{m: &m})
+	case "noble.autocctp.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.autocctp.v1.GenesisState.domains":
+		list := []*DomainConfig{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.failed_transfers":
+		list := []*FailedTransfer{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.transfer_history":
+		list := []*TransferRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.paused":
+		return protoreflect.ValueOfBool(false)
+	case "noble.autocctp.v1.GenesisState.paused_destination_domains":
+		list := []uint32{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.total_fees":
+		m := make(map[uint32]string)
+		return protoreflect.ValueOfMap(&_GenesisState_10_map{m: &m})
+	case "noble.autocctp.v1.GenesisState.stats_history":
+		list := []*StatsBucket{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.outcome_stats":
+		m := make(map[uint32]*OutcomeStats)
+		return protoreflect.ValueOfMap(&_GenesisState_12_map{m: &m})
+	case "noble.autocctp.v1.GenesisState.awaiting_transfers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.resume_cursor":
+		m := new(ResumeCursor)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.autocctp.v1.GenesisState.token_paused":
+		return protoreflect.ValueOfBool(false)
+	case "noble.autocctp.v1.GenesisState.rate_limit_usage":
+		m := make(map[uint32]*RateLimitUsage)
+		return protoreflect.ValueOfMap(&_GenesisState_16_map{m: &m})
+	case "noble.autocctp.v1.GenesisState.rate_limit_buckets":
+		list := []*RateLimitBucket{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.rate_limited_transfers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.transfer_queue":
+		list := []*QueuedTransfer{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.transfer_queue_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.autocctp.v1.GenesisState.prune_retries":
+		list := []*PruneRetry{}
+		return protoreflect.ValueOfList(&_GenesisState_21_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.dirty_accounts":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_22_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.sweep_cursor":
+		m := new(SweepCursor)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.autocctp.v1.GenesisState.failed_forwards":
+		list := []*FailedForward{}
+		return protoreflect.ValueOfList(&_GenesisState_24_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.fallback_sweep_cursor":
+		return protoreflect.ValueOfString("")
+	case "noble.autocctp.v1.GenesisState.owed_fees":
+		list := []*OwedFee{}
+		return protoreflect.ValueOfList(&_GenesisState_26_list{list: &list})
+	case "noble.autocctp.v1.GenesisState.total_transferred_amount":
+		m := make(map[uint32]string)
+		return protoreflect.ValueOfMap(&_GenesisState_27_map{m: &m})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.autocctp.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.NumOfAccounts) > 0 {
+			SiZeMaP := func(k uint32, v uint64) {
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint32, 0, len(x.NumOfAccounts))
+				for k := range x.NumOfAccounts {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.NumOfAccounts[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.NumOfAccounts {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.NumOfTransfers) > 0 {
+			SiZeMaP := func(k uint32, v uint64) {
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint32, 0, len(x.NumOfTransfers))
+				for k := range x.NumOfTransfers {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.NumOfTransfers[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.NumOfTransfers {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Domains) > 0 {
+			for _, e := range x.Domains {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FailedTransfers) > 0 {
+			for _, e := range x.FailedTransfers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TransferHistory) > 0 {
+			for _, e := range x.TransferHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Paused {
+			n += 2
+		}
+		if len(x.PausedDestinationDomains) > 0 {
+			l = 0
+			for _, e := range x.PausedDestinationDomains {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.TotalFees) > 0 {
+			SiZeMaP := func(k uint32, v string) {
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint32, 0, len(x.TotalFees))
+				for k := range x.TotalFees {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.TotalFees[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.TotalFees {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.StatsHistory) > 0 {
+			for _, e := range x.StatsHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.OutcomeStats) > 0 {
+			SiZeMaP := func(k uint32, v *OutcomeStats) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + l
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint32, 0, len(x.OutcomeStats))
+				for k := range x.OutcomeStats {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.OutcomeStats[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.OutcomeStats {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.AwaitingTransfers) > 0 {
+			for _, s := range x.AwaitingTransfers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ResumeCursor != nil {
+			l = options.Size(x.ResumeCursor)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TokenPaused {
+			n += 2
+		}
+		if len(x.RateLimitUsage) > 0 {
+			SiZeMaP := func(k uint32, v *RateLimitUsage) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + l
+				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint32, 0, len(x.RateLimitUsage))
+				for k := range x.RateLimitUsage {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.RateLimitUsage[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.RateLimitUsage {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if len(x.RateLimitBuckets) > 0 {
+			for _, e := range x.RateLimitBuckets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RateLimitedTransfers) > 0 {
+			for _, s := range x.RateLimitedTransfers {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TransferQueue) > 0 {
+			for _, e := range x.TransferQueue {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TransferQueueSequence != 0 {
+			n += 2 + runtime.Sov(uint64(x.TransferQueueSequence))
+		}
+		if len(x.PruneRetries) > 0 {
+			for _, e := range x.PruneRetries {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DirtyAccounts) > 0 {
+			for _, s := range x.DirtyAccounts {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SweepCursor != nil {
+			l = options.Size(x.SweepCursor)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FailedForwards) > 0 {
+			for _, e := range x.FailedForwards {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.FallbackSweepCursor)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.OwedFees) > 0 {
+			for _, e := range x.OwedFees {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TotalTransferredAmount) > 0 {
+			SiZeMaP := func(k uint32, v string) {
+				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + len(v) + runtime.Sov(uint64(len(v)))
+				n += mapEntrySize + 2 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]uint32, 0, len(x.TotalTransferredAmount))
+				for k := range x.TotalTransferredAmount {
+					sortme = append(sortme, k)
+				}
+				sort.Slice(sortme, func(i, j int) bool {
+					return sortme[i] < sortme[j]
+				})
+				for _, k := range sortme {
+					v := x.TotalTransferredAmount[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.TotalTransferredAmount {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalTransferredAmount) > 0 {
+			MaRsHaLmAp := func(k uint32, v string) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xda
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForTotalTransferredAmount := make([]uint32, 0, len(x.TotalTransferredAmount))
+				for k := range x.TotalTransferredAmount {
+					keysForTotalTransferredAmount = append(keysForTotalTransferredAmount, uint32(k))
+				}
+				sort.Slice(keysForTotalTransferredAmount, func(i, j int) bool {
+					return keysForTotalTransferredAmount[i] < keysForTotalTransferredAmount[j]
+				})
+				for iNdEx := len(keysForTotalTransferredAmount) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.TotalTransferredAmount[uint32(keysForTotalTransferredAmount[iNdEx])]
+					out, err := MaRsHaLmAp(keysForTotalTransferredAmount[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.TotalTransferredAmount {
+					v := x.TotalTransferredAmount[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.OwedFees) > 0 {
+			for iNdEx := len(x.OwedFees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OwedFees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xd2
+			}
+		}
+		if len(x.FallbackSweepCursor) > 0 {
+			i -= len(x.FallbackSweepCursor)
+			copy(dAtA[i:], x.FallbackSweepCursor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FallbackSweepCursor)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if len(x.FailedForwards) > 0 {
+			for iNdEx := len(x.FailedForwards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FailedForwards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if x.SweepCursor != nil {
+			encoded, err := options.Marshal(x.SweepCursor)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+		if len(x.DirtyAccounts) > 0 {
+			for iNdEx := len(x.DirtyAccounts) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DirtyAccounts[iNdEx])
+				copy(dAtA[i:], x.DirtyAccounts[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DirtyAccounts[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if len(x.PruneRetries) > 0 {
+			for iNdEx := len(x.PruneRetries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PruneRetries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if x.TransferQueueSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TransferQueueSequence))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.TransferQueue) > 0 {
+			for iNdEx := len(x.TransferQueue) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TransferQueue[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.RateLimitedTransfers) > 0 {
+			for iNdEx := len(x.RateLimitedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RateLimitedTransfers[iNdEx])
+				copy(dAtA[i:], x.RateLimitedTransfers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RateLimitedTransfers[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.RateLimitBuckets) > 0 {
+			for iNdEx := len(x.RateLimitBuckets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RateLimitBuckets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.RateLimitUsage) > 0 {
+			MaRsHaLmAp := func(k uint32, v *RateLimitUsage) (protoiface.MarshalOutput, error) {
+				baseI := i
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForRateLimitUsage := make([]uint32, 0, len(x.RateLimitUsage))
+				for k := range x.RateLimitUsage {
+					keysForRateLimitUsage = append(keysForRateLimitUsage, uint32(k))
+				}
+				sort.Slice(keysForRateLimitUsage, func(i, j int) bool {
+					return keysForRateLimitUsage[i] < keysForRateLimitUsage[j]
+				})
+				for iNdEx := len(keysForRateLimitUsage) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.RateLimitUsage[uint32(keysForRateLimitUsage[iNdEx])]
+					out, err := MaRsHaLmAp(keysForRateLimitUsage[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.RateLimitUsage {
+					v := x.RateLimitUsage[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if x.TokenPaused {
+			i--
+			if x.TokenPaused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.ResumeCursor != nil {
+			encoded, err := options.Marshal(x.ResumeCursor)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.AwaitingTransfers) > 0 {
+			for iNdEx := len(x.AwaitingTransfers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AwaitingTransfers[iNdEx])
+				copy(dAtA[i:], x.AwaitingTransfers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AwaitingTransfers[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.OutcomeStats) > 0 {
+			MaRsHaLmAp := func(k uint32, v *OutcomeStats) (protoiface.MarshalOutput, error) {
+				baseI := i
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x62
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForOutcomeStats := make([]uint32, 0, len(x.OutcomeStats))
+				for k := range x.OutcomeStats {
+					keysForOutcomeStats = append(keysForOutcomeStats, uint32(k))
+				}
+				sort.Slice(keysForOutcomeStats, func(i, j int) bool {
+					return keysForOutcomeStats[i] < keysForOutcomeStats[j]
+				})
+				for iNdEx := len(keysForOutcomeStats) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.OutcomeStats[uint32(keysForOutcomeStats[iNdEx])]
+					out, err := MaRsHaLmAp(keysForOutcomeStats[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.OutcomeStats {
+					v := x.OutcomeStats[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.StatsHistory) > 0 {
+			for iNdEx := len(x.StatsHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StatsHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.TotalFees) > 0 {
+			MaRsHaLmAp := func(k uint32, v string) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x52
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForTotalFees := make([]uint32, 0, len(x.TotalFees))
+				for k := range x.TotalFees {
+					keysForTotalFees = append(keysForTotalFees, uint32(k))
+				}
+				sort.Slice(keysForTotalFees, func(i, j int) bool {
+					return keysForTotalFees[i] < keysForTotalFees[j]
+				})
+				for iNdEx := len(keysForTotalFees) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.TotalFees[uint32(keysForTotalFees[iNdEx])]
+					out, err := MaRsHaLmAp(keysForTotalFees[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.TotalFees {
+					v := x.TotalFees[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.PausedDestinationDomains) > 0 {
+			var pksize2 int
+			for _, num := range x.PausedDestinationDomains {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.PausedDestinationDomains {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Paused {
+			i--
+			if x.Paused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.TransferHistory) > 0 {
+			for iNdEx := len(x.TransferHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TransferHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.FailedTransfers) > 0 {
+			for iNdEx := len(x.FailedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FailedTransfers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Domains) > 0 {
+			for iNdEx := len(x.Domains) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Domains[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NumOfTransfers) > 0 {
+			MaRsHaLmAp := func(k uint32, v uint64) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i = runtime.EncodeVarint(dAtA, i, uint64(v))
+				i--
+				dAtA[i] = 0x10
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0x12
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForNumOfTransfers := make([]uint32, 0, len(x.NumOfTransfers))
+				for k := range x.NumOfTransfers {
+					keysForNumOfTransfers = append(keysForNumOfTransfers, uint32(k))
+				}
+				sort.Slice(keysForNumOfTransfers, func(i, j int) bool {
+					return keysForNumOfTransfers[i] < keysForNumOfTransfers[j]
+				})
+				for iNdEx := len(keysForNumOfTransfers) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.NumOfTransfers[uint32(keysForNumOfTransfers[iNdEx])]
+					out, err := MaRsHaLmAp(keysForNumOfTransfers[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.NumOfTransfers {
+					v := x.NumOfTransfers[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if len(x.NumOfAccounts) > 0 {
+			MaRsHaLmAp := func(k uint32, v uint64) (protoiface.MarshalOutput, error) {
+				baseI := i
+				i = runtime.EncodeVarint(dAtA, i, uint64(v))
+				i--
+				dAtA[i] = 0x10
+				i = runtime.EncodeVarint(dAtA, i, uint64(k))
+				i--
+				dAtA[i] = 0x8
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0xa
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForNumOfAccounts := make([]uint32, 0, len(x.NumOfAccounts))
+				for k := range x.NumOfAccounts {
+					keysForNumOfAccounts = append(keysForNumOfAccounts, uint32(k))
+				}
+				sort.Slice(keysForNumOfAccounts, func(i, j int) bool {
+					return keysForNumOfAccounts[i] < keysForNumOfAccounts[j]
+				})
+				for iNdEx := len(keysForNumOfAccounts) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.NumOfAccounts[uint32(keysForNumOfAccounts[iNdEx])]
+					out, err := MaRsHaLmAp(keysForNumOfAccounts[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.NumOfAccounts {
+					v := x.NumOfAccounts[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NumOfAccounts == nil {
+					x.NumOfAccounts = make(map[uint32]uint64)
+				}
+				var mapkey uint32
+				var mapvalue uint64
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.NumOfAccounts[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumOfTransfers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NumOfTransfers == nil {
+					x.NumOfTransfers = make(map[uint32]uint64)
+				}
+				var mapkey uint32
+				var mapvalue uint64
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.NumOfTransfers[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Domains = append(x.Domains, &DomainConfig{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Domains[len(x.Domains)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedTransfers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailedTransfers = append(x.FailedTransfers, &FailedTransfer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FailedTransfers[len(x.FailedTransfers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransferHistory = append(x.TransferHistory, &TransferRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransferHistory[len(x.TransferHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Paused = bool(v != 0)
+			case 9:
+				if wireType == 0 {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.PausedDestinationDomains = append(x.PausedDestinationDomains, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.PausedDestinationDomains) == 0 {
+						x.PausedDestinationDomains = make([]uint32, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint32
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.PausedDestinationDomains = append(x.PausedDestinationDomains, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedDestinationDomains", wireType)
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalFees == nil {
+					x.TotalFees = make(map[uint32]string)
+				}
+				var mapkey uint32
+				var mapvalue string
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						var stringLenmapvalue uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapvalue := int(stringLenmapvalue)
+						if intStringLenmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+						if postStringIndexmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapvalue > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+						iNdEx = postStringIndexmapvalue
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.TotalFees[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StatsHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StatsHistory = append(x.StatsHistory, &StatsBucket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StatsHistory[len(x.StatsHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutcomeStats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.OutcomeStats == nil {
+					x.OutcomeStats = make(map[uint32]*OutcomeStats)
+				}
+				var mapkey uint32
+				var mapvalue *OutcomeStats
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						var mapmsglen int
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapmsglen |= int(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						if mapmsglen < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postmsgIndex := iNdEx + mapmsglen
+						if postmsgIndex < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postmsgIndex > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = &OutcomeStats{}
+						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						iNdEx = postmsgIndex
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.OutcomeStats[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AwaitingTransfers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AwaitingTransfers = append(x.AwaitingTransfers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ResumeCursor", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ResumeCursor == nil {
+					x.ResumeCursor = &ResumeCursor{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ResumeCursor); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TokenPaused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.TokenPaused = bool(v != 0)
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateLimitUsage", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RateLimitUsage == nil {
+					x.RateLimitUsage = make(map[uint32]*RateLimitUsage)
+				}
+				var mapkey uint32
+				var mapvalue *RateLimitUsage
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						var mapmsglen int
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapmsglen |= int(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						if mapmsglen < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postmsgIndex := iNdEx + mapmsglen
+						if postmsgIndex < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postmsgIndex > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = &RateLimitUsage{}
+						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						iNdEx = postmsgIndex
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.RateLimitUsage[mapkey] = mapvalue
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateLimitBuckets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RateLimitBuckets = append(x.RateLimitBuckets, &RateLimitBucket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RateLimitBuckets[len(x.RateLimitBuckets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RateLimitedTransfers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RateLimitedTransfers = append(x.RateLimitedTransfers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferQueue", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransferQueue = append(x.TransferQueue, &QueuedTransfer{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransferQueue[len(x.TransferQueue)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferQueueSequence", wireType)
+				}
+				x.TransferQueueSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TransferQueueSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PruneRetries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PruneRetries = append(x.PruneRetries, &PruneRetry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PruneRetries[len(x.PruneRetries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DirtyAccounts", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DirtyAccounts = append(x.DirtyAccounts, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SweepCursor", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SweepCursor == nil {
+					x.SweepCursor = &SweepCursor{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SweepCursor); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedForwards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailedForwards = append(x.FailedForwards, &FailedForward{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FailedForwards[len(x.FailedForwards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FallbackSweepCursor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FallbackSweepCursor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwedFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwedFees = append(x.OwedFees, &OwedFee{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OwedFees[len(x.OwedFees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalTransferredAmount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalTransferredAmount == nil {
+					x.TotalTransferredAmount = make(map[uint32]string)
+				}
+				var mapkey uint32
+				var mapvalue string
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapkey |= uint32(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+					} else if fieldNum == 2 {
+						var stringLenmapvalue uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapvalue |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapvalue := int(stringLenmapvalue)
+						if intStringLenmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+						if postStringIndexmapvalue < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapvalue > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+						iNdEx = postStringIndexmapvalue
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						iNdEx += skippy
+					}
+				}
+				x.TotalTransferredAmount[mapkey] = mapvalue
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ResumeCursor         protoreflect.MessageDescriptor
+	fd_ResumeCursor_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_autocctp_v1_genesis_proto_init()
+	md_ResumeCursor = File_noble_autocctp_v1_genesis_proto.Messages().ByName("ResumeCursor")
+	fd_ResumeCursor_address = md_ResumeCursor.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_ResumeCursor)(nil)
+
+type fastReflection_ResumeCursor ResumeCursor
+
+func (x *ResumeCursor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ResumeCursor)(x)
+}
+
+func (x *ResumeCursor) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_autocctp_v1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ResumeCursor_messageType fastReflection_ResumeCursor_messageType
+var _ protoreflect.MessageType = fastReflection_ResumeCursor_messageType{}
+
+type fastReflection_ResumeCursor_messageType struct{}
+
+func (x fastReflection_ResumeCursor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ResumeCursor)(nil)
+}
+func (x fastReflection_ResumeCursor_messageType) New() protoreflect.Message {
+	return new(fastReflection_ResumeCursor)
+}
+func (x fastReflection_ResumeCursor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ResumeCursor
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ResumeCursor) Descriptor() protoreflect.MessageDescriptor {
+	return md_ResumeCursor
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ResumeCursor) Type() protoreflect.MessageType {
+	return _fastReflection_ResumeCursor_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ResumeCursor) New() protoreflect.Message {
+	return new(fastReflection_ResumeCursor)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ResumeCursor) Interface() protoreflect.ProtoMessage {
+	return (*ResumeCursor)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ResumeCursor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ResumeCursor_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ResumeCursor) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.ResumeCursor.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.ResumeCursor"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.ResumeCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ResumeCursor) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.ResumeCursor.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.ResumeCursor"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.ResumeCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ResumeCursor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.autocctp.v1.ResumeCursor.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.ResumeCursor"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.ResumeCursor does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ResumeCursor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.ResumeCursor.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.ResumeCursor"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.ResumeCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ResumeCursor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.ResumeCursor.address":
+		panic(fmt.Errorf("field address of message noble.autocctp.v1.ResumeCursor is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.ResumeCursor"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.ResumeCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ResumeCursor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.ResumeCursor.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.ResumeCursor"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.ResumeCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ResumeCursor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.autocctp.v1.ResumeCursor", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ResumeCursor) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ResumeCursor) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ResumeCursor) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ResumeCursor) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ResumeCursor)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ResumeCursor)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
 		}
-		mapValue := &_GenesisState_1_map{m: &x.NumOfAccounts}
-		return protoreflect.ValueOfMap(mapValue)
-	case "noble.autocctp.v1.GenesisState.num_of_transfers":
-		if len(x.NumOfTransfers) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_2_map{})
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
 		}
-		mapValue := &_GenesisState_2_map{m: &x.NumOfTransfers}
-		return protoreflect.ValueOfMap(mapValue)
-	case "noble.autocctp.v1.GenesisState.total_transferred":
-		if len(x.TotalTransferred) == 0 {
-			return protoreflect.ValueOfMap(&_GenesisState_3_map{})
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
 		}
-		mapValue := &_GenesisState_3_map{m: &x.TotalTransferred}
-		return protoreflect.ValueOfMap(mapValue)
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ResumeCursor)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ResumeCursor: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ResumeCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SweepCursor                    protoreflect.MessageDescriptor
+	fd_SweepCursor_destination_domain protoreflect.FieldDescriptor
+	fd_SweepCursor_address            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_autocctp_v1_genesis_proto_init()
+	md_SweepCursor = File_noble_autocctp_v1_genesis_proto.Messages().ByName("SweepCursor")
+	fd_SweepCursor_destination_domain = md_SweepCursor.Fields().ByName("destination_domain")
+	fd_SweepCursor_address = md_SweepCursor.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_SweepCursor)(nil)
+
+type fastReflection_SweepCursor SweepCursor
+
+func (x *SweepCursor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SweepCursor)(x)
+}
+
+func (x *SweepCursor) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_autocctp_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SweepCursor_messageType fastReflection_SweepCursor_messageType
+var _ protoreflect.MessageType = fastReflection_SweepCursor_messageType{}
+
+type fastReflection_SweepCursor_messageType struct{}
+
+func (x fastReflection_SweepCursor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SweepCursor)(nil)
+}
+func (x fastReflection_SweepCursor_messageType) New() protoreflect.Message {
+	return new(fastReflection_SweepCursor)
+}
+func (x fastReflection_SweepCursor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SweepCursor
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SweepCursor) Descriptor() protoreflect.MessageDescriptor {
+	return md_SweepCursor
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SweepCursor) Type() protoreflect.MessageType {
+	return _fastReflection_SweepCursor_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SweepCursor) New() protoreflect.Message {
+	return new(fastReflection_SweepCursor)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SweepCursor) Interface() protoreflect.ProtoMessage {
+	return (*SweepCursor)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SweepCursor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DestinationDomain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DestinationDomain)
+		if !f(fd_SweepCursor_destination_domain, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_SweepCursor_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SweepCursor) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.SweepCursor.destination_domain":
+		return x.DestinationDomain != uint32(0)
+	case "noble.autocctp.v1.SweepCursor.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.SweepCursor"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.SweepCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SweepCursor) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.SweepCursor.destination_domain":
+		x.DestinationDomain = uint32(0)
+	case "noble.autocctp.v1.SweepCursor.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.SweepCursor"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.SweepCursor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SweepCursor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.autocctp.v1.SweepCursor.destination_domain":
+		value := x.DestinationDomain
+		return protoreflect.ValueOfUint32(value)
+	case "noble.autocctp.v1.SweepCursor.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.SweepCursor"))
 		}
-		panic(fmt.Errorf("message noble.autocctp.v1.GenesisState does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message noble.autocctp.v1.SweepCursor does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -452,25 +4987,17 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SweepCursor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "noble.autocctp.v1.GenesisState.num_of_accounts":
-		mv := value.Map()
-		cmv := mv.(*_GenesisState_1_map)
-		x.NumOfAccounts = *cmv.m
-	case "noble.autocctp.v1.GenesisState.num_of_transfers":
-		mv := value.Map()
-		cmv := mv.(*_GenesisState_2_map)
-		x.NumOfTransfers = *cmv.m
-	case "noble.autocctp.v1.GenesisState.total_transferred":
-		mv := value.Map()
-		cmv := mv.(*_GenesisState_3_map)
-		x.TotalTransferred = *cmv.m
+	case "noble.autocctp.v1.SweepCursor.destination_domain":
+		x.DestinationDomain = uint32(value.Uint())
+	case "noble.autocctp.v1.SweepCursor.address":
+		x.Address = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.SweepCursor"))
 		}
-		panic(fmt.Errorf("message noble.autocctp.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.autocctp.v1.SweepCursor does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -482,65 +5009,46 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 // For extension fields, it implicitly stores the provided ExtensionType
 // if not already stored.
 // It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "noble.autocctp.v1.GenesisState.num_of_accounts":
-		if x.NumOfAccounts == nil {
-			x.NumOfAccounts = make(map[uint32]uint64)
-		}
-		value := &_GenesisState_1_map{m: &x.NumOfAccounts}
-		return protoreflect.ValueOfMap(value)
-	case "noble.autocctp.v1.GenesisState.num_of_transfers":
-		if x.NumOfTransfers == nil {
-			x.NumOfTransfers = make(map[uint32]uint64)
-		}
-		value := &_GenesisState_2_map{m: &x.NumOfTransfers}
-		return protoreflect.ValueOfMap(value)
-	case "noble.autocctp.v1.GenesisState.total_transferred":
-		if x.TotalTransferred == nil {
-			x.TotalTransferred = make(map[uint32]uint64)
-		}
-		value := &_GenesisState_3_map{m: &x.TotalTransferred}
-		return protoreflect.ValueOfMap(value)
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SweepCursor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.SweepCursor.destination_domain":
+		panic(fmt.Errorf("field destination_domain of message noble.autocctp.v1.SweepCursor is not mutable"))
+	case "noble.autocctp.v1.SweepCursor.address":
+		panic(fmt.Errorf("field address of message noble.autocctp.v1.SweepCursor is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.SweepCursor"))
 		}
-		panic(fmt.Errorf("message noble.autocctp.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.autocctp.v1.SweepCursor does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SweepCursor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "noble.autocctp.v1.GenesisState.num_of_accounts":
-		m := make(map[uint32]uint64)
-		return protoreflect.ValueOfMap(&_GenesisState_1_map{m: &m})
-	case "noble.autocctp.v1.GenesisState.num_of_transfers":
-		m := make(map[uint32]uint64)
-		return protoreflect.ValueOfMap(&_GenesisState_2_map{m: &m})
-	case "noble.autocctp.v1.GenesisState.total_transferred":
-		m := make(map[uint32]uint64)
-		return protoreflect.ValueOfMap(&_GenesisState_3_map{m: &m})
+	case "noble.autocctp.v1.SweepCursor.destination_domain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.autocctp.v1.SweepCursor.address":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.GenesisState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.SweepCursor"))
 		}
-		panic(fmt.Errorf("message noble.autocctp.v1.GenesisState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message noble.autocctp.v1.SweepCursor does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SweepCursor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in noble.autocctp.v1.GenesisState", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in noble.autocctp.v1.SweepCursor", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -548,268 +5056,101 @@ func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SweepCursor) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
 // SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
-}
-
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
-}
-
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if len(x.NumOfAccounts) > 0 {
-			SiZeMaP := func(k uint32, v uint64) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]uint32, 0, len(x.NumOfAccounts))
-				for k := range x.NumOfAccounts {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.NumOfAccounts[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.NumOfAccounts {
-					SiZeMaP(k, v)
-				}
-			}
-		}
-		if len(x.NumOfTransfers) > 0 {
-			SiZeMaP := func(k uint32, v uint64) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]uint32, 0, len(x.NumOfTransfers))
-				for k := range x.NumOfTransfers {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.NumOfTransfers[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.NumOfTransfers {
-					SiZeMaP(k, v)
-				}
-			}
-		}
-		if len(x.TotalTransferred) > 0 {
-			SiZeMaP := func(k uint32, v uint64) {
-				mapEntrySize := 1 + runtime.Sov(uint64(k)) + 1 + runtime.Sov(uint64(v))
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]uint32, 0, len(x.TotalTransferred))
-				for k := range x.TotalTransferred {
-					sortme = append(sortme, k)
-				}
-				sort.Slice(sortme, func(i, j int) bool {
-					return sortme[i] < sortme[j]
-				})
-				for _, k := range sortme {
-					v := x.TotalTransferred[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.TotalTransferred {
-					SiZeMaP(k, v)
-				}
-			}
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
-	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.TotalTransferred) > 0 {
-			MaRsHaLmAp := func(k uint32, v uint64) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64(v))
-				i--
-				dAtA[i] = 0x10
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x1a
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForTotalTransferred := make([]uint32, 0, len(x.TotalTransferred))
-				for k := range x.TotalTransferred {
-					keysForTotalTransferred = append(keysForTotalTransferred, uint32(k))
-				}
-				sort.Slice(keysForTotalTransferred, func(i, j int) bool {
-					return keysForTotalTransferred[i] < keysForTotalTransferred[j]
-				})
-				for iNdEx := len(keysForTotalTransferred) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.TotalTransferred[uint32(keysForTotalTransferred[iNdEx])]
-					out, err := MaRsHaLmAp(keysForTotalTransferred[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.TotalTransferred {
-					v := x.TotalTransferred[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.NumOfTransfers) > 0 {
-			MaRsHaLmAp := func(k uint32, v uint64) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64(v))
-				i--
-				dAtA[i] = 0x10
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0x12
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForNumOfTransfers := make([]uint32, 0, len(x.NumOfTransfers))
-				for k := range x.NumOfTransfers {
-					keysForNumOfTransfers = append(keysForNumOfTransfers, uint32(k))
-				}
-				sort.Slice(keysForNumOfTransfers, func(i, j int) bool {
-					return keysForNumOfTransfers[i] < keysForNumOfTransfers[j]
-				})
-				for iNdEx := len(keysForNumOfTransfers) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.NumOfTransfers[uint32(keysForNumOfTransfers[iNdEx])]
-					out, err := MaRsHaLmAp(keysForNumOfTransfers[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.NumOfTransfers {
-					v := x.NumOfTransfers[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
-			}
-		}
-		if len(x.NumOfAccounts) > 0 {
-			MaRsHaLmAp := func(k uint32, v uint64) (protoiface.MarshalOutput, error) {
-				baseI := i
-				i = runtime.EncodeVarint(dAtA, i, uint64(v))
-				i--
-				dAtA[i] = 0x10
-				i = runtime.EncodeVarint(dAtA, i, uint64(k))
-				i--
-				dAtA[i] = 0x8
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0xa
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForNumOfAccounts := make([]uint32, 0, len(x.NumOfAccounts))
-				for k := range x.NumOfAccounts {
-					keysForNumOfAccounts = append(keysForNumOfAccounts, uint32(k))
-				}
-				sort.Slice(keysForNumOfAccounts, func(i, j int) bool {
-					return keysForNumOfAccounts[i] < keysForNumOfAccounts[j]
-				})
-				for iNdEx := len(keysForNumOfAccounts) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.NumOfAccounts[uint32(keysForNumOfAccounts[iNdEx])]
-					out, err := MaRsHaLmAp(keysForNumOfAccounts[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
-			} else {
-				for k := range x.NumOfAccounts {
-					v := x.NumOfAccounts[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SweepCursor) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SweepCursor) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SweepCursor) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SweepCursor)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
 			}
 		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DestinationDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestinationDomain))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SweepCursor)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DestinationDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationDomain))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -821,7 +5162,7 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*SweepCursor)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -853,116 +5194,17 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SweepCursor: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SweepCursor: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.NumOfAccounts == nil {
-					x.NumOfAccounts = make(map[uint32]uint64)
-				}
-				var mapkey uint32
-				var mapvalue uint64
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkey |= uint32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else if fieldNum == 2 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapvalue |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
-				}
-				x.NumOfAccounts[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumOfTransfers", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
 				}
-				var msglen int
+				x.DestinationDomain = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -970,98 +5212,18 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.NumOfTransfers == nil {
-					x.NumOfTransfers = make(map[uint32]uint64)
-				}
-				var mapkey uint32
-				var mapvalue uint64
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkey |= uint32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else if fieldNum == 2 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapvalue |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestinationDomain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
-				x.NumOfTransfers[mapkey] = mapvalue
-				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalTransferred", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1071,90 +5233,23 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TotalTransferred == nil {
-					x.TotalTransferred = make(map[uint32]uint64)
-				}
-				var mapkey uint32
-				var mapvalue uint64
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapkey |= uint32(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else if fieldNum == 2 {
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapvalue |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						iNdEx += skippy
-					}
-				}
-				x.TotalTransferred[mapkey] = mapvalue
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1210,9 +5305,37 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumOfAccounts    map[uint32]uint64 `protobuf:"bytes,1,rep,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	NumOfTransfers   map[uint32]uint64 `protobuf:"bytes,2,rep,name=num_of_transfers,json=numOfTransfers,proto3" json:"num_of_transfers,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	TotalTransferred map[uint32]uint64 `protobuf:"bytes,3,rep,name=total_transferred,json=totalTransferred,proto3" json:"total_transferred,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	NumOfAccounts            map[uint32]uint64        `protobuf:"bytes,1,rep,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	NumOfTransfers           map[uint32]uint64        `protobuf:"bytes,2,rep,name=num_of_transfers,json=numOfTransfers,proto3" json:"num_of_transfers,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Params                   *Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	Domains                  []*DomainConfig          `protobuf:"bytes,5,rep,name=domains,proto3" json:"domains,omitempty"`
+	FailedTransfers          []*FailedTransfer        `protobuf:"bytes,6,rep,name=failed_transfers,json=failedTransfers,proto3" json:"failed_transfers,omitempty"`
+	TransferHistory          []*TransferRecord        `protobuf:"bytes,7,rep,name=transfer_history,json=transferHistory,proto3" json:"transfer_history,omitempty"`
+	Paused                   bool                     `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	PausedDestinationDomains []uint32                 `protobuf:"varint,9,rep,packed,name=paused_destination_domains,json=pausedDestinationDomains,proto3" json:"paused_destination_domains,omitempty"`
+	TotalFees                map[uint32]string        `protobuf:"bytes,10,rep,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StatsHistory             []*StatsBucket           `protobuf:"bytes,11,rep,name=stats_history,json=statsHistory,proto3" json:"stats_history,omitempty"`
+	OutcomeStats             map[uint32]*OutcomeStats `protobuf:"bytes,12,rep,name=outcome_stats,json=outcomeStats,proto3" json:"outcome_stats,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AwaitingTransfers        []string                 `protobuf:"bytes,13,rep,name=awaiting_transfers,json=awaitingTransfers,proto3" json:"awaiting_transfers,omitempty"`
+	// The position of the resumption of the deferred transfers, if in progress.
+	ResumeCursor *ResumeCursor `protobuf:"bytes,14,opt,name=resume_cursor,json=resumeCursor,proto3" json:"resume_cursor,omitempty"`
+	// Whether the minting token was paused in the fiat-tokenfactory at the last end block.
+	TokenPaused          bool                       `protobuf:"varint,15,opt,name=token_paused,json=tokenPaused,proto3" json:"token_paused,omitempty"`
+	RateLimitUsage       map[uint32]*RateLimitUsage `protobuf:"bytes,16,rep,name=rate_limit_usage,json=rateLimitUsage,proto3" json:"rate_limit_usage,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RateLimitBuckets     []*RateLimitBucket         `protobuf:"bytes,17,rep,name=rate_limit_buckets,json=rateLimitBuckets,proto3" json:"rate_limit_buckets,omitempty"`
+	RateLimitedTransfers []string                   `protobuf:"bytes,18,rep,name=rate_limited_transfers,json=rateLimitedTransfers,proto3" json:"rate_limited_transfers,omitempty"`
+	TransferQueue        []*QueuedTransfer          `protobuf:"bytes,19,rep,name=transfer_queue,json=transferQueue,proto3" json:"transfer_queue,omitempty"`
+	// The identifier assigned to the next queued transfer.
+	TransferQueueSequence uint64        `protobuf:"varint,20,opt,name=transfer_queue_sequence,json=transferQueueSequence,proto3" json:"transfer_queue_sequence,omitempty"`
+	PruneRetries          []*PruneRetry `protobuf:"bytes,21,rep,name=prune_retries,json=pruneRetries,proto3" json:"prune_retries,omitempty"`
+	DirtyAccounts         []string      `protobuf:"bytes,22,rep,name=dirty_accounts,json=dirtyAccounts,proto3" json:"dirty_accounts,omitempty"`
+	// The position of the sweep of the AutoCCTP accounts balances, if in progress.
+	SweepCursor    *SweepCursor     `protobuf:"bytes,23,opt,name=sweep_cursor,json=sweepCursor,proto3" json:"sweep_cursor,omitempty"`
+	FailedForwards []*FailedForward `protobuf:"bytes,24,rep,name=failed_forwards,json=failedForwards,proto3" json:"failed_forwards,omitempty"`
+	// The address of the last failed transfer checked against the fallback policy, if any.
+	FallbackSweepCursor    string            `protobuf:"bytes,25,opt,name=fallback_sweep_cursor,json=fallbackSweepCursor,proto3" json:"fallback_sweep_cursor,omitempty"`
+	OwedFees               []*OwedFee        `protobuf:"bytes,26,rep,name=owed_fees,json=owedFees,proto3" json:"owed_fees,omitempty"`
+	TotalTransferredAmount map[uint32]string `protobuf:"bytes,27,rep,name=total_transferred_amount,json=totalTransferredAmount,proto3" json:"total_transferred_amount,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1249,39 +5372,396 @@ func (x *GenesisState) GetNumOfTransfers() map[uint32]uint64 {
 	return nil
 }
 
-func (x *GenesisState) GetTotalTransferred() map[uint32]uint64 {
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDomains() []*DomainConfig {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFailedTransfers() []*FailedTransfer {
+	if x != nil {
+		return x.FailedTransfers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTransferHistory() []*TransferRecord {
+	if x != nil {
+		return x.TransferHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GenesisState) GetPausedDestinationDomains() []uint32 {
+	if x != nil {
+		return x.PausedDestinationDomains
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTotalFees() map[uint32]string {
+	if x != nil {
+		return x.TotalFees
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStatsHistory() []*StatsBucket {
+	if x != nil {
+		return x.StatsHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetOutcomeStats() map[uint32]*OutcomeStats {
+	if x != nil {
+		return x.OutcomeStats
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAwaitingTransfers() []string {
+	if x != nil {
+		return x.AwaitingTransfers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetResumeCursor() *ResumeCursor {
+	if x != nil {
+		return x.ResumeCursor
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTokenPaused() bool {
+	if x != nil {
+		return x.TokenPaused
+	}
+	return false
+}
+
+func (x *GenesisState) GetRateLimitUsage() map[uint32]*RateLimitUsage {
+	if x != nil {
+		return x.RateLimitUsage
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRateLimitBuckets() []*RateLimitBucket {
+	if x != nil {
+		return x.RateLimitBuckets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRateLimitedTransfers() []string {
+	if x != nil {
+		return x.RateLimitedTransfers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTransferQueue() []*QueuedTransfer {
+	if x != nil {
+		return x.TransferQueue
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTransferQueueSequence() uint64 {
+	if x != nil {
+		return x.TransferQueueSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetPruneRetries() []*PruneRetry {
+	if x != nil {
+		return x.PruneRetries
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDirtyAccounts() []string {
+	if x != nil {
+		return x.DirtyAccounts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSweepCursor() *SweepCursor {
+	if x != nil {
+		return x.SweepCursor
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFailedForwards() []*FailedForward {
+	if x != nil {
+		return x.FailedForwards
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFallbackSweepCursor() string {
+	if x != nil {
+		return x.FallbackSweepCursor
+	}
+	return ""
+}
+
+func (x *GenesisState) GetOwedFees() []*OwedFee {
+	if x != nil {
+		return x.OwedFees
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTotalTransferredAmount() map[uint32]string {
 	if x != nil {
-		return x.TotalTransferred
+		return x.TotalTransferredAmount
 	}
 	return nil
 }
 
+// ResumeCursor is the position from which the transfers deferred while paused are resumed.
+type ResumeCursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address of the last awaiting transfer resumed, or empty to resume from the first one.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ResumeCursor) Reset() {
+	*x = ResumeCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_autocctp_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeCursor) ProtoMessage() {}
+
+// Deprecated: Use ResumeCursor.ProtoReflect.Descriptor instead.
+func (*ResumeCursor) Descriptor() ([]byte, []int) {
+	return file_noble_autocctp_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResumeCursor) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// SweepCursor is the position from which the next sweep of the AutoCCTP accounts balances resumes.
+type SweepCursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The destination domain of the last account checked.
+	DestinationDomain uint32 `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// The address of the last account checked.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *SweepCursor) Reset() {
+	*x = SweepCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_autocctp_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SweepCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SweepCursor) ProtoMessage() {}
+
+// Deprecated: Use SweepCursor.ProtoReflect.Descriptor instead.
+func (*SweepCursor) Descriptor() ([]byte, []int) {
+	return file_noble_autocctp_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SweepCursor) GetDestinationDomain() uint32 {
+	if x != nil {
+		return x.DestinationDomain
+	}
+	return 0
+}
+
+func (x *SweepCursor) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 var File_noble_autocctp_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_noble_autocctp_v1_genesis_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70,
 	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x11, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74,
-	0x70, 0x2e, 0x76, 0x31, 0x22, 0xf7, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
+	0x70, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x61, 0x75, 0x74,
+	0x6f, 0x63, 0x63, 0x74, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x61, 0x75, 0x74,
+	0x6f, 0x63, 0x63, 0x74, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x61, 0x75, 0x74,
+	0x6f, 0x63, 0x63, 0x74, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x13, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f,
+	0x6f, 0x66, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63,
+	0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x5d, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
 	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e,
 	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x12, 0x5d, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6e, 0x6f,
+	0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3f, 0x0a, 0x07,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x52, 0x0a,
+	0x10, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x12, 0x52, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f,
 	0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4e, 0x75, 0x6d,
-	0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x12, 0x62, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6e, 0x6f,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x3c, 0x0a,
+	0x1a, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x18, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x5d, 0x0a, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63,
+	0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x5c, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x12, 0x61, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x11, 0x61, 0x77, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x0d,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x63, 0x0a, 0x10, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x72, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x56, 0x0a, 0x12, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x10, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x4e, 0x0a, 0x16, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x12, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x12, 0x4e, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x70, 0x72,
+	0x75, 0x6e, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63,
+	0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x64, 0x69, 0x72, 0x74, 0x79, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x64, 0x69, 0x72, 0x74, 0x79, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f,
 	0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x72, 0x65, 0x64, 0x1a, 0x40, 0x0a, 0x12, 0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x63, 0x63,
+	0x53, 0x77, 0x65, 0x65, 0x70, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x0b, 0x73, 0x77, 0x65,
+	0x65, 0x70, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63,
+	0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x66, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x53, 0x77, 0x65, 0x65, 0x70, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3d, 0x0a,
+	0x09, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77, 0x65, 0x64, 0x46, 0x65, 0x65, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x08, 0x6f, 0x77, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x85, 0x01, 0x0a,
+	0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3b, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x16, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x40, 0x0a, 0x12, 0x4e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x63, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c,
@@ -1289,24 +5769,52 @@ var file_noble_autocctp_v1_genesis_proto_rawDesc = []byte{
 	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
 	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x15, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0xba,
-	0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63,
-	0x74, 0x70, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
-	0x2f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75, 0x74,
-	0x6f, 0x63, 0x63, 0x74, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x41, 0x58, 0xaa, 0x02, 0x11,
-	0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x11, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x63,
-	0x74, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x41, 0x75,
-	0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x41,
-	0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x60, 0x0a, 0x11, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x64, 0x0a, 0x13, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63,
+	0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x49, 0x0a, 0x1b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
+	0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x22, 0x28, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x70, 0x0a,
+	0x0b, 0x53, 0x77, 0x65, 0x65, 0x70, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x12,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42,
+	0xba, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x61, 0x75, 0x74, 0x6f, 0x63,
+	0x63, 0x74, 0x70, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x75,
+	0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x41, 0x58, 0xaa, 0x02,
+	0x11, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x11, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x41, 0x75, 0x74, 0x6f, 0x63,
+	0x63, 0x74, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x41,
+	0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a,
+	0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1321,22 +5829,56 @@ func file_noble_autocctp_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_noble_autocctp_v1_genesis_proto_rawDescData
 }
 
-var file_noble_autocctp_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_noble_autocctp_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_noble_autocctp_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: noble.autocctp.v1.GenesisState
-	nil,                  // 1: noble.autocctp.v1.GenesisState.NumOfAccountsEntry
-	nil,                  // 2: noble.autocctp.v1.GenesisState.NumOfTransfersEntry
-	nil,                  // 3: noble.autocctp.v1.GenesisState.TotalTransferredEntry
+	(*GenesisState)(nil),    // 0: noble.autocctp.v1.GenesisState
+	(*ResumeCursor)(nil),    // 1: noble.autocctp.v1.ResumeCursor
+	(*SweepCursor)(nil),     // 2: noble.autocctp.v1.SweepCursor
+	nil,                     // 3: noble.autocctp.v1.GenesisState.NumOfAccountsEntry
+	nil,                     // 4: noble.autocctp.v1.GenesisState.NumOfTransfersEntry
+	nil,                     // 5: noble.autocctp.v1.GenesisState.TotalFeesEntry
+	nil,                     // 6: noble.autocctp.v1.GenesisState.OutcomeStatsEntry
+	nil,                     // 7: noble.autocctp.v1.GenesisState.RateLimitUsageEntry
+	nil,                     // 8: noble.autocctp.v1.GenesisState.TotalTransferredAmountEntry
+	(*Params)(nil),          // 9: noble.autocctp.v1.Params
+	(*DomainConfig)(nil),    // 10: noble.autocctp.v1.DomainConfig
+	(*FailedTransfer)(nil),  // 11: noble.autocctp.v1.FailedTransfer
+	(*TransferRecord)(nil),  // 12: noble.autocctp.v1.TransferRecord
+	(*StatsBucket)(nil),     // 13: noble.autocctp.v1.StatsBucket
+	(*RateLimitBucket)(nil), // 14: noble.autocctp.v1.RateLimitBucket
+	(*QueuedTransfer)(nil),  // 15: noble.autocctp.v1.QueuedTransfer
+	(*PruneRetry)(nil),      // 16: noble.autocctp.v1.PruneRetry
+	(*FailedForward)(nil),   // 17: noble.autocctp.v1.FailedForward
+	(*OwedFee)(nil),         // 18: noble.autocctp.v1.OwedFee
+	(*OutcomeStats)(nil),    // 19: noble.autocctp.v1.OutcomeStats
+	(*RateLimitUsage)(nil),  // 20: noble.autocctp.v1.RateLimitUsage
 }
 var file_noble_autocctp_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: noble.autocctp.v1.GenesisState.num_of_accounts:type_name -> noble.autocctp.v1.GenesisState.NumOfAccountsEntry
-	2, // 1: noble.autocctp.v1.GenesisState.num_of_transfers:type_name -> noble.autocctp.v1.GenesisState.NumOfTransfersEntry
-	3, // 2: noble.autocctp.v1.GenesisState.total_transferred:type_name -> noble.autocctp.v1.GenesisState.TotalTransferredEntry
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3,  // 0: noble.autocctp.v1.GenesisState.num_of_accounts:type_name -> noble.autocctp.v1.GenesisState.NumOfAccountsEntry
+	4,  // 1: noble.autocctp.v1.GenesisState.num_of_transfers:type_name -> noble.autocctp.v1.GenesisState.NumOfTransfersEntry
+	9,  // 2: noble.autocctp.v1.GenesisState.params:type_name -> noble.autocctp.v1.Params
+	10, // 3: noble.autocctp.v1.GenesisState.domains:type_name -> noble.autocctp.v1.DomainConfig
+	11, // 4: noble.autocctp.v1.GenesisState.failed_transfers:type_name -> noble.autocctp.v1.FailedTransfer
+	12, // 5: noble.autocctp.v1.GenesisState.transfer_history:type_name -> noble.autocctp.v1.TransferRecord
+	5,  // 6: noble.autocctp.v1.GenesisState.total_fees:type_name -> noble.autocctp.v1.GenesisState.TotalFeesEntry
+	13, // 7: noble.autocctp.v1.GenesisState.stats_history:type_name -> noble.autocctp.v1.StatsBucket
+	6,  // 8: noble.autocctp.v1.GenesisState.outcome_stats:type_name -> noble.autocctp.v1.GenesisState.OutcomeStatsEntry
+	1,  // 9: noble.autocctp.v1.GenesisState.resume_cursor:type_name -> noble.autocctp.v1.ResumeCursor
+	7,  // 10: noble.autocctp.v1.GenesisState.rate_limit_usage:type_name -> noble.autocctp.v1.GenesisState.RateLimitUsageEntry
+	14, // 11: noble.autocctp.v1.GenesisState.rate_limit_buckets:type_name -> noble.autocctp.v1.RateLimitBucket
+	15, // 12: noble.autocctp.v1.GenesisState.transfer_queue:type_name -> noble.autocctp.v1.QueuedTransfer
+	16, // 13: noble.autocctp.v1.GenesisState.prune_retries:type_name -> noble.autocctp.v1.PruneRetry
+	2,  // 14: noble.autocctp.v1.GenesisState.sweep_cursor:type_name -> noble.autocctp.v1.SweepCursor
+	17, // 15: noble.autocctp.v1.GenesisState.failed_forwards:type_name -> noble.autocctp.v1.FailedForward
+	18, // 16: noble.autocctp.v1.GenesisState.owed_fees:type_name -> noble.autocctp.v1.OwedFee
+	8,  // 17: noble.autocctp.v1.GenesisState.total_transferred_amount:type_name -> noble.autocctp.v1.GenesisState.TotalTransferredAmountEntry
+	19, // 18: noble.autocctp.v1.GenesisState.OutcomeStatsEntry.value:type_name -> noble.autocctp.v1.OutcomeStats
+	20, // 19: noble.autocctp.v1.GenesisState.RateLimitUsageEntry.value:type_name -> noble.autocctp.v1.RateLimitUsage
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_noble_autocctp_v1_genesis_proto_init() }
@@ -1344,6 +5886,9 @@ func file_noble_autocctp_v1_genesis_proto_init() {
 	if File_noble_autocctp_v1_genesis_proto != nil {
 		return
 	}
+	file_noble_autocctp_v1_domain_proto_init()
+	file_noble_autocctp_v1_params_proto_init()
+	file_noble_autocctp_v1_transfer_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_noble_autocctp_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -1357,6 +5902,30 @@ func file_noble_autocctp_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_noble_autocctp_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_autocctp_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SweepCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1364,7 +5933,7 @@ func file_noble_autocctp_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_autocctp_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
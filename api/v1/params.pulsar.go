@@ -0,0 +1,2302 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package autocctpv1
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_Params_8_list)(nil)
+
+type _Params_8_list struct {
+	list *[]*TransferFee
+}
+
+func (x *_Params_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransferFee)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransferFee)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_8_list) AppendMutable() protoreflect.Value {
+	v := new(TransferFee)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_8_list) NewElement() protoreflect.Value {
+	v := new(TransferFee)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                               protoreflect.MessageDescriptor
+	fd_Params_minimum_transfer_amount       protoreflect.FieldDescriptor
+	fd_Params_max_transfer_attempts         protoreflect.FieldDescriptor
+	fd_Params_retry_base_delay              protoreflect.FieldDescriptor
+	fd_Params_retry_max_delay               protoreflect.FieldDescriptor
+	fd_Params_split_oversized_transfers     protoreflect.FieldDescriptor
+	fd_Params_max_transfer_history          protoreflect.FieldDescriptor
+	fd_Params_fee_recipient                 protoreflect.FieldDescriptor
+	fd_Params_transfer_fees                 protoreflect.FieldDescriptor
+	fd_Params_max_transfers_per_block       protoreflect.FieldDescriptor
+	fd_Params_transfer_gas_limit            protoreflect.FieldDescriptor
+	fd_Params_sweep_batch_size              protoreflect.FieldDescriptor
+	fd_Params_stats_bucket_duration         protoreflect.FieldDescriptor
+	fd_Params_stats_history_retention       protoreflect.FieldDescriptor
+	fd_Params_max_pruned_accounts_per_block protoreflect.FieldDescriptor
+	fd_Params_fallback_sweep_batch_size     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_autocctp_v1_params_proto_init()
+	md_Params = File_noble_autocctp_v1_params_proto.Messages().ByName("Params")
+	fd_Params_minimum_transfer_amount = md_Params.Fields().ByName("minimum_transfer_amount")
+	fd_Params_max_transfer_attempts = md_Params.Fields().ByName("max_transfer_attempts")
+	fd_Params_retry_base_delay = md_Params.Fields().ByName("retry_base_delay")
+	fd_Params_retry_max_delay = md_Params.Fields().ByName("retry_max_delay")
+	fd_Params_split_oversized_transfers = md_Params.Fields().ByName("split_oversized_transfers")
+	fd_Params_max_transfer_history = md_Params.Fields().ByName("max_transfer_history")
+	fd_Params_fee_recipient = md_Params.Fields().ByName("fee_recipient")
+	fd_Params_transfer_fees = md_Params.Fields().ByName("transfer_fees")
+	fd_Params_max_transfers_per_block = md_Params.Fields().ByName("max_transfers_per_block")
+	fd_Params_transfer_gas_limit = md_Params.Fields().ByName("transfer_gas_limit")
+	fd_Params_sweep_batch_size = md_Params.Fields().ByName("sweep_batch_size")
+	fd_Params_stats_bucket_duration = md_Params.Fields().ByName("stats_bucket_duration")
+	fd_Params_stats_history_retention = md_Params.Fields().ByName("stats_history_retention")
+	fd_Params_max_pruned_accounts_per_block = md_Params.Fields().ByName("max_pruned_accounts_per_block")
+	fd_Params_fallback_sweep_batch_size = md_Params.Fields().ByName("fallback_sweep_batch_size")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_autocctp_v1_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinimumTransferAmount != "" {
+		value := protoreflect.ValueOfString(x.MinimumTransferAmount)
+		if !f(fd_Params_minimum_transfer_amount, value) {
+			return
+		}
+	}
+	if x.MaxTransferAttempts != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTransferAttempts)
+		if !f(fd_Params_max_transfer_attempts, value) {
+			return
+		}
+	}
+	if x.RetryBaseDelay != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RetryBaseDelay)
+		if !f(fd_Params_retry_base_delay, value) {
+			return
+		}
+	}
+	if x.RetryMaxDelay != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RetryMaxDelay)
+		if !f(fd_Params_retry_max_delay, value) {
+			return
+		}
+	}
+	if x.SplitOversizedTransfers != false {
+		value := protoreflect.ValueOfBool(x.SplitOversizedTransfers)
+		if !f(fd_Params_split_oversized_transfers, value) {
+			return
+		}
+	}
+	if x.MaxTransferHistory != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTransferHistory)
+		if !f(fd_Params_max_transfer_history, value) {
+			return
+		}
+	}
+	if x.FeeRecipient != "" {
+		value := protoreflect.ValueOfString(x.FeeRecipient)
+		if !f(fd_Params_fee_recipient, value) {
+			return
+		}
+	}
+	if len(x.TransferFees) != 0 {
+		value := protoreflect.ValueOfList(&_Params_8_list{list: &x.TransferFees})
+		if !f(fd_Params_transfer_fees, value) {
+			return
+		}
+	}
+	if x.MaxTransfersPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTransfersPerBlock)
+		if !f(fd_Params_max_transfers_per_block, value) {
+			return
+		}
+	}
+	if x.TransferGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TransferGasLimit)
+		if !f(fd_Params_transfer_gas_limit, value) {
+			return
+		}
+	}
+	if x.SweepBatchSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SweepBatchSize)
+		if !f(fd_Params_sweep_batch_size, value) {
+			return
+		}
+	}
+	if x.StatsBucketDuration != nil {
+		value := protoreflect.ValueOfMessage(x.StatsBucketDuration.ProtoReflect())
+		if !f(fd_Params_stats_bucket_duration, value) {
+			return
+		}
+	}
+	if x.StatsHistoryRetention != nil {
+		value := protoreflect.ValueOfMessage(x.StatsHistoryRetention.ProtoReflect())
+		if !f(fd_Params_stats_history_retention, value) {
+			return
+		}
+	}
+	if x.MaxPrunedAccountsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxPrunedAccountsPerBlock)
+		if !f(fd_Params_max_pruned_accounts_per_block, value) {
+			return
+		}
+	}
+	if x.FallbackSweepBatchSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FallbackSweepBatchSize)
+		if !f(fd_Params_fallback_sweep_batch_size, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.Params.minimum_transfer_amount":
+		return x.MinimumTransferAmount != ""
+	case "noble.autocctp.v1.Params.max_transfer_attempts":
+		return x.MaxTransferAttempts != uint64(0)
+	case "noble.autocctp.v1.Params.retry_base_delay":
+		return x.RetryBaseDelay != uint64(0)
+	case "noble.autocctp.v1.Params.retry_max_delay":
+		return x.RetryMaxDelay != uint64(0)
+	case "noble.autocctp.v1.Params.split_oversized_transfers":
+		return x.SplitOversizedTransfers != false
+	case "noble.autocctp.v1.Params.max_transfer_history":
+		return x.MaxTransferHistory != uint64(0)
+	case "noble.autocctp.v1.Params.fee_recipient":
+		return x.FeeRecipient != ""
+	case "noble.autocctp.v1.Params.transfer_fees":
+		return len(x.TransferFees) != 0
+	case "noble.autocctp.v1.Params.max_transfers_per_block":
+		return x.MaxTransfersPerBlock != uint64(0)
+	case "noble.autocctp.v1.Params.transfer_gas_limit":
+		return x.TransferGasLimit != uint64(0)
+	case "noble.autocctp.v1.Params.sweep_batch_size":
+		return x.SweepBatchSize != uint64(0)
+	case "noble.autocctp.v1.Params.stats_bucket_duration":
+		return x.StatsBucketDuration != nil
+	case "noble.autocctp.v1.Params.stats_history_retention":
+		return x.StatsHistoryRetention != nil
+	case "noble.autocctp.v1.Params.max_pruned_accounts_per_block":
+		return x.MaxPrunedAccountsPerBlock != uint64(0)
+	case "noble.autocctp.v1.Params.fallback_sweep_batch_size":
+		return x.FallbackSweepBatchSize != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Params"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.Params.minimum_transfer_amount":
+		x.MinimumTransferAmount = ""
+	case "noble.autocctp.v1.Params.max_transfer_attempts":
+		x.MaxTransferAttempts = uint64(0)
+	case "noble.autocctp.v1.Params.retry_base_delay":
+		x.RetryBaseDelay = uint64(0)
+	case "noble.autocctp.v1.Params.retry_max_delay":
+		x.RetryMaxDelay = uint64(0)
+	case "noble.autocctp.v1.Params.split_oversized_transfers":
+		x.SplitOversizedTransfers = false
+	case "noble.autocctp.v1.Params.max_transfer_history":
+		x.MaxTransferHistory = uint64(0)
+	case "noble.autocctp.v1.Params.fee_recipient":
+		x.FeeRecipient = ""
+	case "noble.autocctp.v1.Params.transfer_fees":
+		x.TransferFees = nil
+	case "noble.autocctp.v1.Params.max_transfers_per_block":
+		x.MaxTransfersPerBlock = uint64(0)
+	case "noble.autocctp.v1.Params.transfer_gas_limit":
+		x.TransferGasLimit = uint64(0)
+	case "noble.autocctp.v1.Params.sweep_batch_size":
+		x.SweepBatchSize = uint64(0)
+	case "noble.autocctp.v1.Params.stats_bucket_duration":
+		x.StatsBucketDuration = nil
+	case "noble.autocctp.v1.Params.stats_history_retention":
+		x.StatsHistoryRetention = nil
+	case "noble.autocctp.v1.Params.max_pruned_accounts_per_block":
+		x.MaxPrunedAccountsPerBlock = uint64(0)
+	case "noble.autocctp.v1.Params.fallback_sweep_batch_size":
+		x.FallbackSweepBatchSize = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Params"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.autocctp.v1.Params.minimum_transfer_amount":
+		value := x.MinimumTransferAmount
+		return protoreflect.ValueOfString(value)
+	case "noble.autocctp.v1.Params.max_transfer_attempts":
+		value := x.MaxTransferAttempts
+		return protoreflect.ValueOfUint64(value)
+	case "noble.autocctp.v1.Params.retry_base_delay":
+		value := x.RetryBaseDelay
+		return protoreflect.ValueOfUint64(value)
+	case "noble.autocctp.v1.Params.retry_max_delay":
+		value := x.RetryMaxDelay
+		return protoreflect.ValueOfUint64(value)
+	case "noble.autocctp.v1.Params.split_oversized_transfers":
+		value := x.SplitOversizedTransfers
+		return protoreflect.ValueOfBool(value)
+	case "noble.autocctp.v1.Params.max_transfer_history":
+		value := x.MaxTransferHistory
+		return protoreflect.ValueOfUint64(value)
+	case "noble.autocctp.v1.Params.fee_recipient":
+		value := x.FeeRecipient
+		return protoreflect.ValueOfString(value)
+	case "noble.autocctp.v1.Params.transfer_fees":
+		if len(x.TransferFees) == 0 {
+			return protoreflect.ValueOfList(&_Params_8_list{})
+		}
+		listValue := &_Params_8_list{list: &x.TransferFees}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.autocctp.v1.Params.max_transfers_per_block":
+		value := x.MaxTransfersPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "noble.autocctp.v1.Params.transfer_gas_limit":
+		value := x.TransferGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "noble.autocctp.v1.Params.sweep_batch_size":
+		value := x.SweepBatchSize
+		return protoreflect.ValueOfUint64(value)
+	case "noble.autocctp.v1.Params.stats_bucket_duration":
+		value := x.StatsBucketDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.autocctp.v1.Params.stats_history_retention":
+		value := x.StatsHistoryRetention
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.autocctp.v1.Params.max_pruned_accounts_per_block":
+		value := x.MaxPrunedAccountsPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "noble.autocctp.v1.Params.fallback_sweep_batch_size":
+		value := x.FallbackSweepBatchSize
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Params"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.Params.minimum_transfer_amount":
+		x.MinimumTransferAmount = value.Interface().(string)
+	case "noble.autocctp.v1.Params.max_transfer_attempts":
+		x.MaxTransferAttempts = value.Uint()
+	case "noble.autocctp.v1.Params.retry_base_delay":
+		x.RetryBaseDelay = value.Uint()
+	case "noble.autocctp.v1.Params.retry_max_delay":
+		x.RetryMaxDelay = value.Uint()
+	case "noble.autocctp.v1.Params.split_oversized_transfers":
+		x.SplitOversizedTransfers = value.Bool()
+	case "noble.autocctp.v1.Params.max_transfer_history":
+		x.MaxTransferHistory = value.Uint()
+	case "noble.autocctp.v1.Params.fee_recipient":
+		x.FeeRecipient = value.Interface().(string)
+	case "noble.autocctp.v1.Params.transfer_fees":
+		lv := value.List()
+		clv := lv.(*_Params_8_list)
+		x.TransferFees = *clv.list
+	case "noble.autocctp.v1.Params.max_transfers_per_block":
+		x.MaxTransfersPerBlock = value.Uint()
+	case "noble.autocctp.v1.Params.transfer_gas_limit":
+		x.TransferGasLimit = value.Uint()
+	case "noble.autocctp.v1.Params.sweep_batch_size":
+		x.SweepBatchSize = value.Uint()
+	case "noble.autocctp.v1.Params.stats_bucket_duration":
+		x.StatsBucketDuration = value.Message().Interface().(*durationpb.Duration)
+	case "noble.autocctp.v1.Params.stats_history_retention":
+		x.StatsHistoryRetention = value.Message().Interface().(*durationpb.Duration)
+	case "noble.autocctp.v1.Params.max_pruned_accounts_per_block":
+		x.MaxPrunedAccountsPerBlock = value.Uint()
+	case "noble.autocctp.v1.Params.fallback_sweep_batch_size":
+		x.FallbackSweepBatchSize = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Params"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.Params.transfer_fees":
+		if x.TransferFees == nil {
+			x.TransferFees = []*TransferFee{}
+		}
+		value := &_Params_8_list{list: &x.TransferFees}
+		return protoreflect.ValueOfList(value)
+	case "noble.autocctp.v1.Params.stats_bucket_duration":
+		if x.StatsBucketDuration == nil {
+			x.StatsBucketDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.StatsBucketDuration.ProtoReflect())
+	case "noble.autocctp.v1.Params.stats_history_retention":
+		if x.StatsHistoryRetention == nil {
+			x.StatsHistoryRetention = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.StatsHistoryRetention.ProtoReflect())
+	case "noble.autocctp.v1.Params.minimum_transfer_amount":
+		panic(fmt.Errorf("field minimum_transfer_amount of message noble.autocctp.v1.Params is not mutable"))
+	case "noble.autocctp.v1.Params.max_transfer_attempts":
+		panic(fmt.Errorf("field max_transfer_attempts of message noble.autocctp.v1.Params is not mutable"))
+	case "noble.autocctp.v1.Params.retry_base_delay":
+		panic(fmt.Errorf("field retry_base_delay of message noble.autocctp.v1.Params is not mutable"))
+	case "noble.autocctp.v1.Params.retry_max_delay":
+		panic(fmt.Errorf("field retry_max_delay of message noble.autocctp.v1.Params is not mutable"))
+	case "noble.autocctp.v1.Params.split_oversized_transfers":
+		panic(fmt.Errorf("field split_oversized_transfers of message noble.autocctp.v1.Params is not mutable"))
+	case "noble.autocctp.v1.Params.max_transfer_history":
+		panic(fmt.Errorf("field max_transfer_history of message noble.autocctp.v1.Params is not mutable"))
+	case "noble.autocctp.v1.Params.fee_recipient":
+		panic(fmt.Errorf("field fee_recipient of message noble.autocctp.v1.Params is not mutable"))
+	case "noble.autocctp.v1.Params.max_transfers_per_block":
+		panic(fmt.Errorf("field max_transfers_per_block of message noble.autocctp.v1.Params is not mutable"))
+	case "noble.autocctp.v1.Params.transfer_gas_limit":
+		panic(fmt.Errorf("field transfer_gas_limit of message noble.autocctp.v1.Params is not mutable"))
+	case "noble.autocctp.v1.Params.sweep_batch_size":
+		panic(fmt.Errorf("field sweep_batch_size of message noble.autocctp.v1.Params is not mutable"))
+	case "noble.autocctp.v1.Params.max_pruned_accounts_per_block":
+		panic(fmt.Errorf("field max_pruned_accounts_per_block of message noble.autocctp.v1.Params is not mutable"))
+	case "noble.autocctp.v1.Params.fallback_sweep_batch_size":
+		panic(fmt.Errorf("field fallback_sweep_batch_size of message noble.autocctp.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Params"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.Params.minimum_transfer_amount":
+		return protoreflect.ValueOfString("")
+	case "noble.autocctp.v1.Params.max_transfer_attempts":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.autocctp.v1.Params.retry_base_delay":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.autocctp.v1.Params.retry_max_delay":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.autocctp.v1.Params.split_oversized_transfers":
+		return protoreflect.ValueOfBool(false)
+	case "noble.autocctp.v1.Params.max_transfer_history":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.autocctp.v1.Params.fee_recipient":
+		return protoreflect.ValueOfString("")
+	case "noble.autocctp.v1.Params.transfer_fees":
+		list := []*TransferFee{}
+		return protoreflect.ValueOfList(&_Params_8_list{list: &list})
+	case "noble.autocctp.v1.Params.max_transfers_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.autocctp.v1.Params.transfer_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.autocctp.v1.Params.sweep_batch_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.autocctp.v1.Params.stats_bucket_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.autocctp.v1.Params.stats_history_retention":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.autocctp.v1.Params.max_pruned_accounts_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.autocctp.v1.Params.fallback_sweep_batch_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.Params"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.autocctp.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MinimumTransferAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxTransferAttempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTransferAttempts))
+		}
+		if x.RetryBaseDelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.RetryBaseDelay))
+		}
+		if x.RetryMaxDelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.RetryMaxDelay))
+		}
+		if x.SplitOversizedTransfers {
+			n += 2
+		}
+		if x.MaxTransferHistory != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTransferHistory))
+		}
+		l = len(x.FeeRecipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TransferFees) > 0 {
+			for _, e := range x.TransferFees {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxTransfersPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTransfersPerBlock))
+		}
+		if x.TransferGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.TransferGasLimit))
+		}
+		if x.SweepBatchSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.SweepBatchSize))
+		}
+		if x.StatsBucketDuration != nil {
+			l = options.Size(x.StatsBucketDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StatsHistoryRetention != nil {
+			l = options.Size(x.StatsHistoryRetention)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxPrunedAccountsPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxPrunedAccountsPerBlock))
+		}
+		if x.FallbackSweepBatchSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.FallbackSweepBatchSize))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FallbackSweepBatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FallbackSweepBatchSize))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.MaxPrunedAccountsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPrunedAccountsPerBlock))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.StatsHistoryRetention != nil {
+			encoded, err := options.Marshal(x.StatsHistoryRetention)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.StatsBucketDuration != nil {
+			encoded, err := options.Marshal(x.StatsBucketDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.SweepBatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SweepBatchSize))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.TransferGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TransferGasLimit))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.MaxTransfersPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTransfersPerBlock))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.TransferFees) > 0 {
+			for iNdEx := len(x.TransferFees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TransferFees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.FeeRecipient) > 0 {
+			i -= len(x.FeeRecipient)
+			copy(dAtA[i:], x.FeeRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeRecipient)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.MaxTransferHistory != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTransferHistory))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.SplitOversizedTransfers {
+			i--
+			if x.SplitOversizedTransfers {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.RetryMaxDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetryMaxDelay))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RetryBaseDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetryBaseDelay))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxTransferAttempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTransferAttempts))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.MinimumTransferAmount) > 0 {
+			i -= len(x.MinimumTransferAmount)
+			copy(dAtA[i:], x.MinimumTransferAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinimumTransferAmount)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinimumTransferAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinimumTransferAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTransferAttempts", wireType)
+				}
+				x.MaxTransferAttempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTransferAttempts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryBaseDelay", wireType)
+				}
+				x.RetryBaseDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetryBaseDelay |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryMaxDelay", wireType)
+				}
+				x.RetryMaxDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetryMaxDelay |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SplitOversizedTransfers", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SplitOversizedTransfers = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTransferHistory", wireType)
+				}
+				x.MaxTransferHistory = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTransferHistory |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeRecipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransferFees = append(x.TransferFees, &TransferFee{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransferFees[len(x.TransferFees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTransfersPerBlock", wireType)
+				}
+				x.MaxTransfersPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTransfersPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferGasLimit", wireType)
+				}
+				x.TransferGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TransferGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SweepBatchSize", wireType)
+				}
+				x.SweepBatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SweepBatchSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StatsBucketDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StatsBucketDuration == nil {
+					x.StatsBucketDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StatsBucketDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StatsHistoryRetention", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StatsHistoryRetention == nil {
+					x.StatsHistoryRetention = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StatsHistoryRetention); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedAccountsPerBlock", wireType)
+				}
+				x.MaxPrunedAccountsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxPrunedAccountsPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FallbackSweepBatchSize", wireType)
+				}
+				x.FallbackSweepBatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FallbackSweepBatchSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TransferFee                    protoreflect.MessageDescriptor
+	fd_TransferFee_destination_domain protoreflect.FieldDescriptor
+	fd_TransferFee_flat_amount        protoreflect.FieldDescriptor
+	fd_TransferFee_basis_points       protoreflect.FieldDescriptor
+	fd_TransferFee_max_amount         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_autocctp_v1_params_proto_init()
+	md_TransferFee = File_noble_autocctp_v1_params_proto.Messages().ByName("TransferFee")
+	fd_TransferFee_destination_domain = md_TransferFee.Fields().ByName("destination_domain")
+	fd_TransferFee_flat_amount = md_TransferFee.Fields().ByName("flat_amount")
+	fd_TransferFee_basis_points = md_TransferFee.Fields().ByName("basis_points")
+	fd_TransferFee_max_amount = md_TransferFee.Fields().ByName("max_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_TransferFee)(nil)
+
+type fastReflection_TransferFee TransferFee
+
+func (x *TransferFee) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TransferFee)(x)
+}
+
+func (x *TransferFee) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_autocctp_v1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TransferFee_messageType fastReflection_TransferFee_messageType
+var _ protoreflect.MessageType = fastReflection_TransferFee_messageType{}
+
+type fastReflection_TransferFee_messageType struct{}
+
+func (x fastReflection_TransferFee_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TransferFee)(nil)
+}
+func (x fastReflection_TransferFee_messageType) New() protoreflect.Message {
+	return new(fastReflection_TransferFee)
+}
+func (x fastReflection_TransferFee_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransferFee
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TransferFee) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransferFee
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TransferFee) Type() protoreflect.MessageType {
+	return _fastReflection_TransferFee_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TransferFee) New() protoreflect.Message {
+	return new(fastReflection_TransferFee)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TransferFee) Interface() protoreflect.ProtoMessage {
+	return (*TransferFee)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TransferFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DestinationDomain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DestinationDomain)
+		if !f(fd_TransferFee_destination_domain, value) {
+			return
+		}
+	}
+	if x.FlatAmount != "" {
+		value := protoreflect.ValueOfString(x.FlatAmount)
+		if !f(fd_TransferFee_flat_amount, value) {
+			return
+		}
+	}
+	if x.BasisPoints != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.BasisPoints)
+		if !f(fd_TransferFee_basis_points, value) {
+			return
+		}
+	}
+	if x.MaxAmount != "" {
+		value := protoreflect.ValueOfString(x.MaxAmount)
+		if !f(fd_TransferFee_max_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TransferFee) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.TransferFee.destination_domain":
+		return x.DestinationDomain != uint32(0)
+	case "noble.autocctp.v1.TransferFee.flat_amount":
+		return x.FlatAmount != ""
+	case "noble.autocctp.v1.TransferFee.basis_points":
+		return x.BasisPoints != uint32(0)
+	case "noble.autocctp.v1.TransferFee.max_amount":
+		return x.MaxAmount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.TransferFee"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.TransferFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferFee) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.TransferFee.destination_domain":
+		x.DestinationDomain = uint32(0)
+	case "noble.autocctp.v1.TransferFee.flat_amount":
+		x.FlatAmount = ""
+	case "noble.autocctp.v1.TransferFee.basis_points":
+		x.BasisPoints = uint32(0)
+	case "noble.autocctp.v1.TransferFee.max_amount":
+		x.MaxAmount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.TransferFee"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.TransferFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TransferFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.autocctp.v1.TransferFee.destination_domain":
+		value := x.DestinationDomain
+		return protoreflect.ValueOfUint32(value)
+	case "noble.autocctp.v1.TransferFee.flat_amount":
+		value := x.FlatAmount
+		return protoreflect.ValueOfString(value)
+	case "noble.autocctp.v1.TransferFee.basis_points":
+		value := x.BasisPoints
+		return protoreflect.ValueOfUint32(value)
+	case "noble.autocctp.v1.TransferFee.max_amount":
+		value := x.MaxAmount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.TransferFee"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.TransferFee does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.TransferFee.destination_domain":
+		x.DestinationDomain = uint32(value.Uint())
+	case "noble.autocctp.v1.TransferFee.flat_amount":
+		x.FlatAmount = value.Interface().(string)
+	case "noble.autocctp.v1.TransferFee.basis_points":
+		x.BasisPoints = uint32(value.Uint())
+	case "noble.autocctp.v1.TransferFee.max_amount":
+		x.MaxAmount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.TransferFee"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.TransferFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.TransferFee.destination_domain":
+		panic(fmt.Errorf("field destination_domain of message noble.autocctp.v1.TransferFee is not mutable"))
+	case "noble.autocctp.v1.TransferFee.flat_amount":
+		panic(fmt.Errorf("field flat_amount of message noble.autocctp.v1.TransferFee is not mutable"))
+	case "noble.autocctp.v1.TransferFee.basis_points":
+		panic(fmt.Errorf("field basis_points of message noble.autocctp.v1.TransferFee is not mutable"))
+	case "noble.autocctp.v1.TransferFee.max_amount":
+		panic(fmt.Errorf("field max_amount of message noble.autocctp.v1.TransferFee is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.TransferFee"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.TransferFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TransferFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.autocctp.v1.TransferFee.destination_domain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.autocctp.v1.TransferFee.flat_amount":
+		return protoreflect.ValueOfString("")
+	case "noble.autocctp.v1.TransferFee.basis_points":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.autocctp.v1.TransferFee.max_amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.autocctp.v1.TransferFee"))
+		}
+		panic(fmt.Errorf("message noble.autocctp.v1.TransferFee does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TransferFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.autocctp.v1.TransferFee", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TransferFee) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TransferFee) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TransferFee) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TransferFee) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TransferFee)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DestinationDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestinationDomain))
+		}
+		l = len(x.FlatAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BasisPoints != 0 {
+			n += 1 + runtime.Sov(uint64(x.BasisPoints))
+		}
+		l = len(x.MaxAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TransferFee)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxAmount) > 0 {
+			i -= len(x.MaxAmount)
+			copy(dAtA[i:], x.MaxAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxAmount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.BasisPoints != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BasisPoints))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.FlatAmount) > 0 {
+			i -= len(x.FlatAmount)
+			copy(dAtA[i:], x.FlatAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FlatAmount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DestinationDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationDomain))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TransferFee)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransferFee: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransferFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+				}
+				x.DestinationDomain = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestinationDomain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FlatAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FlatAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+				}
+				x.BasisPoints = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BasisPoints |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: noble/autocctp/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the governance controlled parameters of the AutoCCTP module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The minimum amount of the minting denom that can be transferred via AutoCCTP.
+	MinimumTransferAmount string `protobuf:"bytes,1,opt,name=minimum_transfer_amount,json=minimumTransferAmount,proto3" json:"minimum_transfer_amount,omitempty"`
+	// The maximum number of attempts executed for an automatic transfer before giving up
+	// the automatic retries.
+	MaxTransferAttempts uint64 `protobuf:"varint,2,opt,name=max_transfer_attempts,json=maxTransferAttempts,proto3" json:"max_transfer_attempts,omitempty"`
+	// The number of blocks to wait before retrying a failed transfer for the first time.
+	// The delay is doubled after every failed attempt.
+	RetryBaseDelay uint64 `protobuf:"varint,3,opt,name=retry_base_delay,json=retryBaseDelay,proto3" json:"retry_base_delay,omitempty"`
+	// The maximum number of blocks to wait before retrying a failed transfer.
+	RetryMaxDelay uint64 `protobuf:"varint,4,opt,name=retry_max_delay,json=retryMaxDelay,proto3" json:"retry_max_delay,omitempty"`
+	// If true, deposits pushing the balance of an AutoCCTP account above the CCTP per message
+	// burn limit are accepted, and the balance is transferred in multiple chunks of at most
+	// the limit each.
+	SplitOversizedTransfers bool `protobuf:"varint,5,opt,name=split_oversized_transfers,json=splitOversizedTransfers,proto3" json:"split_oversized_transfers,omitempty"`
+	// The maximum number of transfer records kept in the history of every AutoCCTP account.
+	// Older records are pruned. If zero, the history is not recorded.
+	MaxTransferHistory uint64 `protobuf:"varint,6,opt,name=max_transfer_history,json=maxTransferHistory,proto3" json:"max_transfer_history,omitempty"`
+	// The address receiving the fees deducted from the automatic transfers. If empty, the
+	// fees are sent to the module account.
+	FeeRecipient string `protobuf:"bytes,7,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+	// The fees deducted from the automatic transfers, per destination domain. Transfers to
+	// destination domains without an entry are not charged.
+	TransferFees []*TransferFee `protobuf:"bytes,8,rep,name=transfer_fees,json=transferFees,proto3" json:"transfer_fees,omitempty"`
+	// The maximum number of CCTP burns executed at the end of every block, counting every
+	// chunk of a split transfer. The transfers exceeding the limit are queued and processed in
+	// the following blocks. If zero, the transfers are not limited.
+	MaxTransfersPerBlock uint64 `protobuf:"varint,9,opt,name=max_transfers_per_block,json=maxTransfersPerBlock,proto3" json:"max_transfers_per_block,omitempty"`
+	// The maximum amount of gas consumed by every CCTP transfer executed at the end of the
+	// block. Transfers exceeding the limit are recorded as failed. If zero, the gas is not
+	// limited.
+	TransferGasLimit uint64 `protobuf:"varint,10,opt,name=transfer_gas_limit,json=transferGasLimit,proto3" json:"transfer_gas_limit,omitempty"`
+	// The number of AutoCCTP accounts checked at the beginning of every block for balances
+	// that were not marked for clearing. If zero, the accounts are not checked.
+	SweepBatchSize uint64 `protobuf:"varint,11,opt,name=sweep_batch_size,json=sweepBatchSize,proto3" json:"sweep_batch_size,omitempty"`
+	// The duration of the time buckets in which the transfers executed to every destination
+	// domain are aggregated. If zero, the statistics history is not recorded.
+	StatsBucketDuration *durationpb.Duration `protobuf:"bytes,12,opt,name=stats_bucket_duration,json=statsBucketDuration,proto3" json:"stats_bucket_duration,omitempty"`
+	// The time for which the buckets of the statistics history are kept. Older buckets are
+	// pruned at the end of the block. If zero, the buckets are never pruned.
+	StatsHistoryRetention *durationpb.Duration `protobuf:"bytes,13,opt,name=stats_history_retention,json=statsHistoryRetention,proto3" json:"stats_history_retention,omitempty"`
+	// The maximum number of expired AutoCCTP accounts pruned at the end of every block. The
+	// remaining accounts are pruned in the following blocks. If zero, the pruning is not
+	// limited.
+	MaxPrunedAccountsPerBlock uint64 `protobuf:"varint,14,opt,name=max_pruned_accounts_per_block,json=maxPrunedAccountsPerBlock,proto3" json:"max_pruned_accounts_per_block,omitempty"`
+	// The maximum number of failed transfers checked at the end of every block against the
+	// fallback policy of their account. The remaining failed transfers are checked in the
+	// following blocks. If zero, the failed transfers checked are not limited.
+	FallbackSweepBatchSize uint64 `protobuf:"varint,15,opt,name=fallback_sweep_batch_size,json=fallbackSweepBatchSize,proto3" json:"fallback_sweep_batch_size,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_autocctp_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_noble_autocctp_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMinimumTransferAmount() string {
+	if x != nil {
+		return x.MinimumTransferAmount
+	}
+	return ""
+}
+
+func (x *Params) GetMaxTransferAttempts() uint64 {
+	if x != nil {
+		return x.MaxTransferAttempts
+	}
+	return 0
+}
+
+func (x *Params) GetRetryBaseDelay() uint64 {
+	if x != nil {
+		return x.RetryBaseDelay
+	}
+	return 0
+}
+
+func (x *Params) GetRetryMaxDelay() uint64 {
+	if x != nil {
+		return x.RetryMaxDelay
+	}
+	return 0
+}
+
+func (x *Params) GetSplitOversizedTransfers() bool {
+	if x != nil {
+		return x.SplitOversizedTransfers
+	}
+	return false
+}
+
+func (x *Params) GetMaxTransferHistory() uint64 {
+	if x != nil {
+		return x.MaxTransferHistory
+	}
+	return 0
+}
+
+func (x *Params) GetFeeRecipient() string {
+	if x != nil {
+		return x.FeeRecipient
+	}
+	return ""
+}
+
+func (x *Params) GetTransferFees() []*TransferFee {
+	if x != nil {
+		return x.TransferFees
+	}
+	return nil
+}
+
+func (x *Params) GetMaxTransfersPerBlock() uint64 {
+	if x != nil {
+		return x.MaxTransfersPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetTransferGasLimit() uint64 {
+	if x != nil {
+		return x.TransferGasLimit
+	}
+	return 0
+}
+
+func (x *Params) GetSweepBatchSize() uint64 {
+	if x != nil {
+		return x.SweepBatchSize
+	}
+	return 0
+}
+
+func (x *Params) GetStatsBucketDuration() *durationpb.Duration {
+	if x != nil {
+		return x.StatsBucketDuration
+	}
+	return nil
+}
+
+func (x *Params) GetStatsHistoryRetention() *durationpb.Duration {
+	if x != nil {
+		return x.StatsHistoryRetention
+	}
+	return nil
+}
+
+func (x *Params) GetMaxPrunedAccountsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxPrunedAccountsPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetFallbackSweepBatchSize() uint64 {
+	if x != nil {
+		return x.FallbackSweepBatchSize
+	}
+	return 0
+}
+
+// TransferFee defines the fee deducted from the automatic transfers to a destination
+// domain. The fee is the sum of the flat amount and the basis points of the transferred
+// amount, capped at the max amount if positive.
+type TransferFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestinationDomain uint32 `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	FlatAmount        string `protobuf:"bytes,2,opt,name=flat_amount,json=flatAmount,proto3" json:"flat_amount,omitempty"`
+	BasisPoints       uint32 `protobuf:"varint,3,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	MaxAmount         string `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+}
+
+func (x *TransferFee) Reset() {
+	*x = TransferFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_autocctp_v1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferFee) ProtoMessage() {}
+
+// Deprecated: Use TransferFee.ProtoReflect.Descriptor instead.
+func (*TransferFee) Descriptor() ([]byte, []int) {
+	return file_noble_autocctp_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferFee) GetDestinationDomain() uint32 {
+	if x != nil {
+		return x.DestinationDomain
+	}
+	return 0
+}
+
+func (x *TransferFee) GetFlatAmount() string {
+	if x != nil {
+		return x.FlatAmount
+	}
+	return ""
+}
+
+func (x *TransferFee) GetBasisPoints() uint32 {
+	if x != nil {
+		return x.BasisPoints
+	}
+	return 0
+}
+
+func (x *TransferFee) GetMaxAmount() string {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return ""
+}
+
+var File_noble_autocctp_v1_params_proto protoreflect.FileDescriptor
+
+var file_noble_autocctp_v1_params_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x11, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70,
+	0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x07, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x68, 0x0a, 0x17, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15,
+	0x6d, 0x61, 0x78, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x4f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x30,
+	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61,
+	0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x3d, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x49, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61,
+	0x78, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x61, 0x78,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x77, 0x65, 0x65, 0x70,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x57, 0x0a, 0x15, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x13, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x17, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x15, 0x73, 0x74, 0x61, 0x74, 0x73, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x40, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x39, 0x0a, 0x19, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x77,
+	0x65, 0x65, 0x70, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x77,
+	0x65, 0x65, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x83, 0x02, 0x0a,
+	0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x2d, 0x0a, 0x12,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x51, 0x0a, 0x0b, 0x66,
+	0x6c, 0x61, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0a, 0x66, 0x6c, 0x61, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x4f, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0xb9, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x61, 0x75, 0x74,
+	0x6f, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x64, 0x65, 0x76, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x2f, 0x76, 0x31, 0x3b,
+	0x61, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x41, 0x58,
+	0xaa, 0x02, 0x11, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74,
+	0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x41, 0x75, 0x74,
+	0x6f, 0x63, 0x63, 0x74, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x4e, 0x6f, 0x62, 0x6c, 0x65,
+	0x5c, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65,
+	0x3a, 0x3a, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x63, 0x74, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_noble_autocctp_v1_params_proto_rawDescOnce sync.Once
+	file_noble_autocctp_v1_params_proto_rawDescData = file_noble_autocctp_v1_params_proto_rawDesc
+)
+
+func file_noble_autocctp_v1_params_proto_rawDescGZIP() []byte {
+	file_noble_autocctp_v1_params_proto_rawDescOnce.Do(func() {
+		file_noble_autocctp_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_noble_autocctp_v1_params_proto_rawDescData)
+	})
+	return file_noble_autocctp_v1_params_proto_rawDescData
+}
+
+var file_noble_autocctp_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_noble_autocctp_v1_params_proto_goTypes = []interface{}{
+	(*Params)(nil),              // 0: noble.autocctp.v1.Params
+	(*TransferFee)(nil),         // 1: noble.autocctp.v1.TransferFee
+	(*durationpb.Duration)(nil), // 2: google.protobuf.Duration
+}
+var file_noble_autocctp_v1_params_proto_depIdxs = []int32{
+	1, // 0: noble.autocctp.v1.Params.transfer_fees:type_name -> noble.autocctp.v1.TransferFee
+	2, // 1: noble.autocctp.v1.Params.stats_bucket_duration:type_name -> google.protobuf.Duration
+	2, // 2: noble.autocctp.v1.Params.stats_history_retention:type_name -> google.protobuf.Duration
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_noble_autocctp_v1_params_proto_init() }
+func file_noble_autocctp_v1_params_proto_init() {
+	if File_noble_autocctp_v1_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_noble_autocctp_v1_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_autocctp_v1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_noble_autocctp_v1_params_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_noble_autocctp_v1_params_proto_goTypes,
+		DependencyIndexes: file_noble_autocctp_v1_params_proto_depIdxs,
+		MessageInfos:      file_noble_autocctp_v1_params_proto_msgTypes,
+	}.Build()
+	File_noble_autocctp_v1_params_proto = out.File
+	file_noble_autocctp_v1_params_proto_rawDesc = nil
+	file_noble_autocctp_v1_params_proto_goTypes = nil
+	file_noble_autocctp_v1_params_proto_depIdxs = nil
+}
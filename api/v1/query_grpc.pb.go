@@ -22,6 +22,18 @@ const (
 	Query_Address_FullMethodName                  = "/noble.autocctp.v1.Query/Address"
 	Query_Stats_FullMethodName                    = "/noble.autocctp.v1.Query/Stats"
 	Query_StatsByDestinationDomain_FullMethodName = "/noble.autocctp.v1.Query/StatsByDestinationDomain"
+	Query_StatsHistory_FullMethodName             = "/noble.autocctp.v1.Query/StatsHistory"
+	Query_Params_FullMethodName                   = "/noble.autocctp.v1.Query/Params"
+	Query_Domains_FullMethodName                  = "/noble.autocctp.v1.Query/Domains"
+	Query_Domain_FullMethodName                   = "/noble.autocctp.v1.Query/Domain"
+	Query_FailedTransfers_FullMethodName          = "/noble.autocctp.v1.Query/FailedTransfers"
+	Query_FailedTransfer_FullMethodName           = "/noble.autocctp.v1.Query/FailedTransfer"
+	Query_TransferHistory_FullMethodName          = "/noble.autocctp.v1.Query/TransferHistory"
+	Query_Account_FullMethodName                  = "/noble.autocctp.v1.Query/Account"
+	Query_Accounts_FullMethodName                 = "/noble.autocctp.v1.Query/Accounts"
+	Query_AwaitingTransfers_FullMethodName        = "/noble.autocctp.v1.Query/AwaitingTransfers"
+	Query_QueuedTransfers_FullMethodName          = "/noble.autocctp.v1.Query/QueuedTransfers"
+	Query_Paused_FullMethodName                   = "/noble.autocctp.v1.Query/Paused"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +46,30 @@ type QueryClient interface {
 	Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error)
 	// Queries StatsByDestinationDomain.
 	StatsByDestinationDomain(ctx context.Context, in *QueryStatsByDestinationDomain, opts ...grpc.CallOption) (*QueryStatsByDestinationDomainResponse, error)
+	// Queries StatsHistory.
+	StatsHistory(ctx context.Context, in *QueryStatsHistory, opts ...grpc.CallOption) (*QueryStatsHistoryResponse, error)
+	// Queries Params.
+	Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries Domains.
+	Domains(ctx context.Context, in *QueryDomains, opts ...grpc.CallOption) (*QueryDomainsResponse, error)
+	// Queries Domain.
+	Domain(ctx context.Context, in *QueryDomain, opts ...grpc.CallOption) (*QueryDomainResponse, error)
+	// Queries FailedTransfers.
+	FailedTransfers(ctx context.Context, in *QueryFailedTransfers, opts ...grpc.CallOption) (*QueryFailedTransfersResponse, error)
+	// Queries FailedTransfer.
+	FailedTransfer(ctx context.Context, in *QueryFailedTransfer, opts ...grpc.CallOption) (*QueryFailedTransferResponse, error)
+	// Queries TransferHistory.
+	TransferHistory(ctx context.Context, in *QueryTransferHistory, opts ...grpc.CallOption) (*QueryTransferHistoryResponse, error)
+	// Queries Account.
+	Account(ctx context.Context, in *QueryAccount, opts ...grpc.CallOption) (*QueryAccountResponse, error)
+	// Queries Accounts.
+	Accounts(ctx context.Context, in *QueryAccounts, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
+	// Queries AwaitingTransfers.
+	AwaitingTransfers(ctx context.Context, in *QueryAwaitingTransfers, opts ...grpc.CallOption) (*QueryAwaitingTransfersResponse, error)
+	// Queries QueuedTransfers.
+	QueuedTransfers(ctx context.Context, in *QueryQueuedTransfers, opts ...grpc.CallOption) (*QueryQueuedTransfersResponse, error)
+	// Queries Paused.
+	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
 }
 
 type queryClient struct {
@@ -74,6 +110,126 @@ func (c *queryClient) StatsByDestinationDomain(ctx context.Context, in *QuerySta
 	return out, nil
 }
 
+func (c *queryClient) StatsHistory(ctx context.Context, in *QueryStatsHistory, opts ...grpc.CallOption) (*QueryStatsHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStatsHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_StatsHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Domains(ctx context.Context, in *QueryDomains, opts ...grpc.CallOption) (*QueryDomainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDomainsResponse)
+	err := c.cc.Invoke(ctx, Query_Domains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Domain(ctx context.Context, in *QueryDomain, opts ...grpc.CallOption) (*QueryDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDomainResponse)
+	err := c.cc.Invoke(ctx, Query_Domain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedTransfers(ctx context.Context, in *QueryFailedTransfers, opts ...grpc.CallOption) (*QueryFailedTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFailedTransfersResponse)
+	err := c.cc.Invoke(ctx, Query_FailedTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedTransfer(ctx context.Context, in *QueryFailedTransfer, opts ...grpc.CallOption) (*QueryFailedTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFailedTransferResponse)
+	err := c.cc.Invoke(ctx, Query_FailedTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferHistory(ctx context.Context, in *QueryTransferHistory, opts ...grpc.CallOption) (*QueryTransferHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTransferHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_TransferHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Account(ctx context.Context, in *QueryAccount, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountResponse)
+	err := c.cc.Invoke(ctx, Query_Account_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Accounts(ctx context.Context, in *QueryAccounts, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountsResponse)
+	err := c.cc.Invoke(ctx, Query_Accounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AwaitingTransfers(ctx context.Context, in *QueryAwaitingTransfers, opts ...grpc.CallOption) (*QueryAwaitingTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAwaitingTransfersResponse)
+	err := c.cc.Invoke(ctx, Query_AwaitingTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedTransfers(ctx context.Context, in *QueryQueuedTransfers, opts ...grpc.CallOption) (*QueryQueuedTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryQueuedTransfersResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPausedResponse)
+	err := c.cc.Invoke(ctx, Query_Paused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -84,6 +240,30 @@ type QueryServer interface {
 	Stats(context.Context, *QueryStats) (*QueryStatsResponse, error)
 	// Queries StatsByDestinationDomain.
 	StatsByDestinationDomain(context.Context, *QueryStatsByDestinationDomain) (*QueryStatsByDestinationDomainResponse, error)
+	// Queries StatsHistory.
+	StatsHistory(context.Context, *QueryStatsHistory) (*QueryStatsHistoryResponse, error)
+	// Queries Params.
+	Params(context.Context, *QueryParams) (*QueryParamsResponse, error)
+	// Queries Domains.
+	Domains(context.Context, *QueryDomains) (*QueryDomainsResponse, error)
+	// Queries Domain.
+	Domain(context.Context, *QueryDomain) (*QueryDomainResponse, error)
+	// Queries FailedTransfers.
+	FailedTransfers(context.Context, *QueryFailedTransfers) (*QueryFailedTransfersResponse, error)
+	// Queries FailedTransfer.
+	FailedTransfer(context.Context, *QueryFailedTransfer) (*QueryFailedTransferResponse, error)
+	// Queries TransferHistory.
+	TransferHistory(context.Context, *QueryTransferHistory) (*QueryTransferHistoryResponse, error)
+	// Queries Account.
+	Account(context.Context, *QueryAccount) (*QueryAccountResponse, error)
+	// Queries Accounts.
+	Accounts(context.Context, *QueryAccounts) (*QueryAccountsResponse, error)
+	// Queries AwaitingTransfers.
+	AwaitingTransfers(context.Context, *QueryAwaitingTransfers) (*QueryAwaitingTransfersResponse, error)
+	// Queries QueuedTransfers.
+	QueuedTransfers(context.Context, *QueryQueuedTransfers) (*QueryQueuedTransfersResponse, error)
+	// Queries Paused.
+	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -103,6 +283,42 @@ func (UnimplementedQueryServer) Stats(context.Context, *QueryStats) (*QueryStats
 func (UnimplementedQueryServer) StatsByDestinationDomain(context.Context, *QueryStatsByDestinationDomain) (*QueryStatsByDestinationDomainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StatsByDestinationDomain not implemented")
 }
+func (UnimplementedQueryServer) StatsHistory(context.Context, *QueryStatsHistory) (*QueryStatsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsHistory not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParams) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Domains(context.Context, *QueryDomains) (*QueryDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Domains not implemented")
+}
+func (UnimplementedQueryServer) Domain(context.Context, *QueryDomain) (*QueryDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Domain not implemented")
+}
+func (UnimplementedQueryServer) FailedTransfers(context.Context, *QueryFailedTransfers) (*QueryFailedTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedTransfers not implemented")
+}
+func (UnimplementedQueryServer) FailedTransfer(context.Context, *QueryFailedTransfer) (*QueryFailedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedTransfer not implemented")
+}
+func (UnimplementedQueryServer) TransferHistory(context.Context, *QueryTransferHistory) (*QueryTransferHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferHistory not implemented")
+}
+func (UnimplementedQueryServer) Account(context.Context, *QueryAccount) (*QueryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+}
+func (UnimplementedQueryServer) Accounts(context.Context, *QueryAccounts) (*QueryAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+}
+func (UnimplementedQueryServer) AwaitingTransfers(context.Context, *QueryAwaitingTransfers) (*QueryAwaitingTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AwaitingTransfers not implemented")
+}
+func (UnimplementedQueryServer) QueuedTransfers(context.Context, *QueryQueuedTransfers) (*QueryQueuedTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedTransfers not implemented")
+}
+func (UnimplementedQueryServer) Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -178,6 +394,222 @@ func _Query_StatsByDestinationDomain_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StatsHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatsHistory(ctx, req.(*QueryStatsHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Domains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomains)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Domains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Domains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Domains(ctx, req.(*QueryDomains))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Domain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Domain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Domain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Domain(ctx, req.(*QueryDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedTransfers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FailedTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedTransfers(ctx, req.(*QueryFailedTransfers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FailedTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedTransfer(ctx, req.(*QueryFailedTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferHistory(ctx, req.(*QueryTransferHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Account_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Account(ctx, req.(*QueryAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Accounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AwaitingTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAwaitingTransfers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AwaitingTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AwaitingTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AwaitingTransfers(ctx, req.(*QueryAwaitingTransfers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedTransfers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedTransfers(ctx, req.(*QueryQueuedTransfers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Paused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paused(ctx, req.(*QueryPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,6 +629,54 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StatsByDestinationDomain",
 			Handler:    _Query_StatsByDestinationDomain_Handler,
 		},
+		{
+			MethodName: "StatsHistory",
+			Handler:    _Query_StatsHistory_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Domains",
+			Handler:    _Query_Domains_Handler,
+		},
+		{
+			MethodName: "Domain",
+			Handler:    _Query_Domain_Handler,
+		},
+		{
+			MethodName: "FailedTransfers",
+			Handler:    _Query_FailedTransfers_Handler,
+		},
+		{
+			MethodName: "FailedTransfer",
+			Handler:    _Query_FailedTransfer_Handler,
+		},
+		{
+			MethodName: "TransferHistory",
+			Handler:    _Query_TransferHistory_Handler,
+		},
+		{
+			MethodName: "Account",
+			Handler:    _Query_Account_Handler,
+		},
+		{
+			MethodName: "Accounts",
+			Handler:    _Query_Accounts_Handler,
+		},
+		{
+			MethodName: "AwaitingTransfers",
+			Handler:    _Query_AwaitingTransfers_Handler,
+		},
+		{
+			MethodName: "QueuedTransfers",
+			Handler:    _Query_QueuedTransfers_Handler,
+		},
+		{
+			MethodName: "Paused",
+			Handler:    _Query_Paused_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/autocctp/v1/query.proto",
@@ -22,6 +22,14 @@ const (
 	Msg_RegisterAccount_FullMethodName             = "/noble.autocctp.v1.Msg/RegisterAccount"
 	Msg_RegisterAccountSignerlessly_FullMethodName = "/noble.autocctp.v1.Msg/RegisterAccountSignerlessly"
 	Msg_ClearAccount_FullMethodName                = "/noble.autocctp.v1.Msg/ClearAccount"
+	Msg_UpdateParams_FullMethodName                = "/noble.autocctp.v1.Msg/UpdateParams"
+	Msg_AddDomain_FullMethodName                   = "/noble.autocctp.v1.Msg/AddDomain"
+	Msg_UpdateDomain_FullMethodName                = "/noble.autocctp.v1.Msg/UpdateDomain"
+	Msg_DisableDomain_FullMethodName               = "/noble.autocctp.v1.Msg/DisableDomain"
+	Msg_SetFallbackPolicy_FullMethodName           = "/noble.autocctp.v1.Msg/SetFallbackPolicy"
+	Msg_SetPaused_FullMethodName                   = "/noble.autocctp.v1.Msg/SetPaused"
+	Msg_SetForwardOtherDenoms_FullMethodName       = "/noble.autocctp.v1.Msg/SetForwardOtherDenoms"
+	Msg_DeregisterAccount_FullMethodName           = "/noble.autocctp.v1.Msg/DeregisterAccount"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +39,14 @@ type MsgClient interface {
 	RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error)
 	RegisterAccountSignerlessly(ctx context.Context, in *MsgRegisterAccountSignerlessly, opts ...grpc.CallOption) (*MsgRegisterAccountSignerlesslyResponse, error)
 	ClearAccount(ctx context.Context, in *MsgClearAccount, opts ...grpc.CallOption) (*MsgClearAccountResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	AddDomain(ctx context.Context, in *MsgAddDomain, opts ...grpc.CallOption) (*MsgAddDomainResponse, error)
+	UpdateDomain(ctx context.Context, in *MsgUpdateDomain, opts ...grpc.CallOption) (*MsgUpdateDomainResponse, error)
+	DisableDomain(ctx context.Context, in *MsgDisableDomain, opts ...grpc.CallOption) (*MsgDisableDomainResponse, error)
+	SetFallbackPolicy(ctx context.Context, in *MsgSetFallbackPolicy, opts ...grpc.CallOption) (*MsgSetFallbackPolicyResponse, error)
+	SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error)
+	SetForwardOtherDenoms(ctx context.Context, in *MsgSetForwardOtherDenoms, opts ...grpc.CallOption) (*MsgSetForwardOtherDenomsResponse, error)
+	DeregisterAccount(ctx context.Context, in *MsgDeregisterAccount, opts ...grpc.CallOption) (*MsgDeregisterAccountResponse, error)
 }
 
 type msgClient struct {
@@ -71,6 +87,86 @@ func (c *msgClient) ClearAccount(ctx context.Context, in *MsgClearAccount, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddDomain(ctx context.Context, in *MsgAddDomain, opts ...grpc.CallOption) (*MsgAddDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddDomainResponse)
+	err := c.cc.Invoke(ctx, Msg_AddDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDomain(ctx context.Context, in *MsgUpdateDomain, opts ...grpc.CallOption) (*MsgUpdateDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateDomainResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableDomain(ctx context.Context, in *MsgDisableDomain, opts ...grpc.CallOption) (*MsgDisableDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDisableDomainResponse)
+	err := c.cc.Invoke(ctx, Msg_DisableDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFallbackPolicy(ctx context.Context, in *MsgSetFallbackPolicy, opts ...grpc.CallOption) (*MsgSetFallbackPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetFallbackPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFallbackPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetPausedResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPaused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetForwardOtherDenoms(ctx context.Context, in *MsgSetForwardOtherDenoms, opts ...grpc.CallOption) (*MsgSetForwardOtherDenomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetForwardOtherDenomsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetForwardOtherDenoms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterAccount(ctx context.Context, in *MsgDeregisterAccount, opts ...grpc.CallOption) (*MsgDeregisterAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDeregisterAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_DeregisterAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -78,6 +174,14 @@ type MsgServer interface {
 	RegisterAccount(context.Context, *MsgRegisterAccount) (*MsgRegisterAccountResponse, error)
 	RegisterAccountSignerlessly(context.Context, *MsgRegisterAccountSignerlessly) (*MsgRegisterAccountSignerlesslyResponse, error)
 	ClearAccount(context.Context, *MsgClearAccount) (*MsgClearAccountResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	AddDomain(context.Context, *MsgAddDomain) (*MsgAddDomainResponse, error)
+	UpdateDomain(context.Context, *MsgUpdateDomain) (*MsgUpdateDomainResponse, error)
+	DisableDomain(context.Context, *MsgDisableDomain) (*MsgDisableDomainResponse, error)
+	SetFallbackPolicy(context.Context, *MsgSetFallbackPolicy) (*MsgSetFallbackPolicyResponse, error)
+	SetPaused(context.Context, *MsgSetPaused) (*MsgSetPausedResponse, error)
+	SetForwardOtherDenoms(context.Context, *MsgSetForwardOtherDenoms) (*MsgSetForwardOtherDenomsResponse, error)
+	DeregisterAccount(context.Context, *MsgDeregisterAccount) (*MsgDeregisterAccountResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -97,6 +201,30 @@ func (UnimplementedMsgServer) RegisterAccountSignerlessly(context.Context, *MsgR
 func (UnimplementedMsgServer) ClearAccount(context.Context, *MsgClearAccount) (*MsgClearAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearAccount not implemented")
 }
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (UnimplementedMsgServer) AddDomain(context.Context, *MsgAddDomain) (*MsgAddDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDomain not implemented")
+}
+func (UnimplementedMsgServer) UpdateDomain(context.Context, *MsgUpdateDomain) (*MsgUpdateDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDomain not implemented")
+}
+func (UnimplementedMsgServer) DisableDomain(context.Context, *MsgDisableDomain) (*MsgDisableDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableDomain not implemented")
+}
+func (UnimplementedMsgServer) SetFallbackPolicy(context.Context, *MsgSetFallbackPolicy) (*MsgSetFallbackPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFallbackPolicy not implemented")
+}
+func (UnimplementedMsgServer) SetPaused(context.Context, *MsgSetPaused) (*MsgSetPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaused not implemented")
+}
+func (UnimplementedMsgServer) SetForwardOtherDenoms(context.Context, *MsgSetForwardOtherDenoms) (*MsgSetForwardOtherDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetForwardOtherDenoms not implemented")
+}
+func (UnimplementedMsgServer) DeregisterAccount(context.Context, *MsgDeregisterAccount) (*MsgDeregisterAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterAccount not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -172,6 +300,150 @@ func _Msg_ClearAccount_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddDomain(ctx, req.(*MsgAddDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDomain(ctx, req.(*MsgUpdateDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DisableDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableDomain(ctx, req.(*MsgDisableDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFallbackPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFallbackPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFallbackPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFallbackPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFallbackPolicy(ctx, req.(*MsgSetFallbackPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPaused(ctx, req.(*MsgSetPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetForwardOtherDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetForwardOtherDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetForwardOtherDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetForwardOtherDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetForwardOtherDenoms(ctx, req.(*MsgSetForwardOtherDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeregisterAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterAccount(ctx, req.(*MsgDeregisterAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +463,38 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearAccount",
 			Handler:    _Msg_ClearAccount_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddDomain",
+			Handler:    _Msg_AddDomain_Handler,
+		},
+		{
+			MethodName: "UpdateDomain",
+			Handler:    _Msg_UpdateDomain_Handler,
+		},
+		{
+			MethodName: "DisableDomain",
+			Handler:    _Msg_DisableDomain_Handler,
+		},
+		{
+			MethodName: "SetFallbackPolicy",
+			Handler:    _Msg_SetFallbackPolicy_Handler,
+		},
+		{
+			MethodName: "SetPaused",
+			Handler:    _Msg_SetPaused_Handler,
+		},
+		{
+			MethodName: "SetForwardOtherDenoms",
+			Handler:    _Msg_SetForwardOtherDenoms_Handler,
+		},
+		{
+			MethodName: "DeregisterAccount",
+			Handler:    _Msg_DeregisterAccount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/autocctp/v1/tx.proto",
@@ -56,6 +56,46 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "RegisterAccountSignerlessly",
 					Skip:      true,
 				},
+				{
+					RpcMethod: "SetFallbackPolicy",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "SetForwardOtherDenoms",
+					Use:       "set-forward-other-denoms [address] [forward-other-denoms]",
+					Short:     "Allow an AutoCCTP account to receive other denoms forwarded to the fallback recipient",
+					Long: `Allow, or disallow, an AutoCCTP account to receive denoms other than the minting denom,
+					which are forwarded to the fallback recipient. Must be signed by the fallback recipient`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "forward_other_denoms"}},
+				},
+				{
+					RpcMethod: "DeregisterAccount",
+					Use:       "deregister-account [address]",
+					Short:     "Deregister an AutoCCTP account sending its balance to the fallback recipient",
+					Long: `Deregister an AutoCCTP account sending its balance to the fallback recipient. The
+					account cannot receive funds nor be registered again. Must be signed by the fallback recipient`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // Only used by the authority.
+				},
+				{
+					RpcMethod: "AddDomain",
+					Skip:      true, // Only used by the authority.
+				},
+				{
+					RpcMethod: "UpdateDomain",
+					Skip:      true, // Only used by the authority.
+				},
+				{
+					RpcMethod: "DisableDomain",
+					Skip:      true, // Only used by the authority.
+				},
+				{
+					RpcMethod: "SetPaused",
+					Skip:      true, // Only used by the authority.
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -74,6 +114,84 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "StatsByDestinationDomain",
 					Skip:      true,
 				},
+				{
+					RpcMethod:      "StatsHistory",
+					Use:            "stats-history [destination-domain]",
+					Short:          "Query the AutoCCTP transfers executed to a destination domain aggregated in time buckets",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "destination_domain"}},
+				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the AutoCCTP module parameters",
+				},
+				{
+					RpcMethod: "Domains",
+					Use:       "domains",
+					Short:     "Query all the destination domains supported by AutoCCTP",
+				},
+				{
+					RpcMethod:      "Domain",
+					Use:            "domain [destination-domain]",
+					Short:          "Query a destination domain supported by AutoCCTP",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "destination_domain"}},
+				},
+				{
+					RpcMethod: "FailedTransfers",
+					Use:       "failed-transfers",
+					Short:     "Query all the failed AutoCCTP transfers",
+				},
+				{
+					RpcMethod:      "FailedTransfer",
+					Use:            "failed-transfer [address]",
+					Short:          "Query the failed transfer of an AutoCCTP account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "TransferHistory",
+					Use:            "transfer-history [address]",
+					Short:          "Query the transfer history of an AutoCCTP account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Account",
+					Use:            "account [address]",
+					Short:          "Query an AutoCCTP account and its balance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Accounts",
+					Use:       "accounts",
+					Short:     "Query the registered AutoCCTP accounts",
+					Long: `Query the registered AutoCCTP accounts, optionally filtered by destination domain,
+					mint recipient, and fallback recipient`,
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"destination_domain": {
+							Usage: "Only return the accounts associated with the destination domain",
+						},
+						"mint_recipient": {
+							Usage: "Only return the accounts associated with the mint recipient",
+						},
+						"fallback_recipient": {
+							Usage: "Only return the accounts associated with the fallback recipient",
+						},
+					},
+				},
+				{
+					RpcMethod: "QueuedTransfers",
+					Use:       "queued-transfers",
+					Short:     "Query the AutoCCTP transfers queued for execution in the following blocks",
+				},
+				{
+					RpcMethod: "AwaitingTransfers",
+					Use:       "awaiting-transfers",
+					Short:     "Query the AutoCCTP accounts whose transfer was deferred or did not complete",
+				},
+				{
+					RpcMethod: "Paused",
+					Use:       "paused",
+					Short:     "Query the pause state of the AutoCCTP automatic transfers",
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -25,6 +25,8 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -50,7 +52,7 @@ func GetQueryCmd() *cobra.Command {
 
 func QueryAddress() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "address [destination-domain] [mint-recipient] [fallback-recipient] (destination-caller)",
+		Use:   "address [destination-domain] [mint-recipient] [fallback-recipient] (destination-caller) (--expiration-height) (--expiration-time) (--address-version) (--salt)",
 		Short: "Query AutoCCTP address by destination domain, a mint recipient, and a fallback recipient",
 		Long: `Query AutoCCTP address by destination domain, a mint recipient, and a fallback recipient, with an optional destination caller address.
 		The command creates a 32-byte representation of the mint recipient, and optionally of the destination caller, to 
@@ -70,16 +72,28 @@ func QueryAddress() *cobra.Command {
 				return types.ErrInvalidInputs.Wrap(err.Error())
 			}
 
-			accountProperties, err := types.ValidateAndParseAccountFields(destinationDomain, args[1], args[2], args[3])
+			domain, err := queryDomain(clientCtx, destinationDomain)
+			if err != nil {
+				return err
+			}
+
+			accountProperties, err := types.ValidateAndParseAccountFields(destinationDomain, domain, args[1], args[2], args[3])
 			if err != nil {
 				return types.ErrInvalidInputs.Wrap(err.Error())
 			}
+			if err := parseAccountPropertiesFlags(cmd, accountProperties); err != nil {
+				return types.ErrInvalidInputs.Wrap(err.Error())
+			}
 
 			res, err := queryClient.Address(context.Background(), &types.QueryAddress{
 				DestinationDomain: accountProperties.DestinationDomain,
 				MintRecipient:     args[1],
 				FallbackRecipient: accountProperties.FallbackRecipient,
 				DestinationCaller: args[3],
+				ExpirationHeight:  accountProperties.ExpirationHeight,
+				ExpirationTime:    types.NewExpirationTime(accountProperties.ExpirationTime),
+				AddressVersion:    accountProperties.AddressVersion,
+				Salt:              accountProperties.Salt,
 			})
 			if err != nil {
 				return fmt.Errorf("error executing the query: %w", err)
@@ -89,6 +103,7 @@ func QueryAddress() *cobra.Command {
 		},
 	}
 
+	addAccountPropertiesFlags(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -108,18 +123,22 @@ func QueryStats() *cobra.Command {
 			var err error
 			if len(args) == 1 {
 
-				dD, parseErr := types.ParseDestinationDomain(args[0])
+				destinationDomain, parseErr := types.ParseDestinationDomain(args[0])
 				if parseErr != nil {
 					return types.ErrInvalidInputs.Wrap(parseErr.Error())
 				}
 
-				destinationDomain, valError := types.ValidateDestinationDomain(dD)
-				if valError != nil {
+				domain, queryErr := queryDomain(clientCtx, destinationDomain)
+				if queryErr != nil {
+					return queryErr
+				}
+
+				if valError := types.ValidateDestinationDomain(destinationDomain, domain); valError != nil {
 					return types.ErrInvalidInputs.Wrap(valError.Error())
 				}
 
 				res, err = queryClient.StatsByDestinationDomain(context.Background(), &types.QueryStatsByDestinationDomain{
-					DestinationDomain: uint32(destinationDomain),
+					DestinationDomain: destinationDomain,
 				})
 			} else {
 				res, err = queryClient.Stats(context.Background(), &types.QueryStats{})
@@ -136,3 +155,21 @@ func QueryStats() *cobra.Command {
 
 	return cmd
 }
+
+// queryDomain returns the registry entry of the destination domain, or nil if the domain
+// is not registered on chain.
+func queryDomain(clientCtx client.Context, destinationDomain uint32) (*types.DomainConfig, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	res, err := queryClient.Domain(context.Background(), &types.QueryDomain{
+		DestinationDomain: destinationDomain,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error querying the destination domain: %w", err)
+	}
+
+	return &res.Domain, nil
+}
@@ -21,7 +21,9 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -33,6 +35,17 @@ import (
 	"autocctp.dev/types"
 )
 
+const (
+	FlagMaxAttempts   = "max-attempts"
+	FlagTimeoutBlocks = "timeout-blocks"
+	FlagTimeout       = "timeout"
+
+	FlagExpirationHeight = "expiration-height"
+	FlagExpirationTime   = "expiration-time"
+	FlagAddressVersion   = "address-version"
+	FlagSalt             = "salt"
+)
+
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -44,13 +57,14 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(TxRegisterAccount())
 	cmd.AddCommand(TxRegisterAccountSignerlessly())
+	cmd.AddCommand(TxSetFallbackPolicy())
 
 	return cmd
 }
 
 func TxRegisterAccount() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register-account [destination-domain] [mint-recipient] [fallback-recipient] (destination-caller)",
+		Use:   "register-account [destination-domain] [mint-recipient] [fallback-recipient] (destination-caller) (--expiration-height) (--expiration-time) (--address-version) (--salt)",
 		Short: "Register an AutoCCTP account for a destination domain, a mint recipient, and a fallback recipient",
 		Long:  "Register an AutoCCTP account for a destination domain, a mint recipient, and a fallback recipient, with an optional destination caller",
 		Args:  cobra.RangeArgs(3, 4),
@@ -69,10 +83,18 @@ func TxRegisterAccount() *cobra.Command {
 				return types.ErrInvalidInputs.Wrap(err.Error())
 			}
 
-			accountProperties, err := types.ValidateAndParseAccountFields(destinationDomain, args[1], args[2], args[3])
+			domain, err := queryDomain(clientCtx, destinationDomain)
+			if err != nil {
+				return err
+			}
+
+			accountProperties, err := types.ValidateAndParseAccountFields(destinationDomain, domain, args[1], args[2], args[3])
 			if err != nil {
 				return types.ErrInvalidInputs.Wrap(err.Error())
 			}
+			if err := parseAccountPropertiesFlags(cmd, accountProperties); err != nil {
+				return types.ErrInvalidInputs.Wrap(err.Error())
+			}
 
 			msg := &types.MsgRegisterAccount{
 				Signer:            clientCtx.GetFromAddress().String(),
@@ -80,12 +102,17 @@ func TxRegisterAccount() *cobra.Command {
 				MintRecipient:     accountProperties.MintRecipient,
 				FallbackRecipient: accountProperties.FallbackRecipient,
 				DestinationCaller: accountProperties.DestinationCaller,
+				ExpirationHeight:  accountProperties.ExpirationHeight,
+				ExpirationTime:    types.NewExpirationTime(accountProperties.ExpirationTime),
+				AddressVersion:    accountProperties.AddressVersion,
+				Salt:              accountProperties.Salt,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addAccountPropertiesFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -93,7 +120,7 @@ func TxRegisterAccount() *cobra.Command {
 
 func TxRegisterAccountSignerlessly() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register-account-signerlessly [destination-domain] [mint-recipient] [fallback-recipient] (destination-caller)",
+		Use:   "register-account-signerlessly [destination-domain] [mint-recipient] [fallback-recipient] (destination-caller) (--expiration-height) (--expiration-time) (--address-version) (--salt)",
 		Short: "Signerlessly register an AutoCCTP account for a destination domain, a mint recipient, and a fallback recipient",
 		Long: `Signerlessly register an AutoCCTP account for a destination domain, a mint recipient, and a fallback recipient, with an optional destination caller.
 		A signerless registration does not require an existing wallet because no signature is required.`,
@@ -113,10 +140,18 @@ func TxRegisterAccountSignerlessly() *cobra.Command {
 				return types.ErrInvalidInputs.Wrap(err.Error())
 			}
 
-			accountProperties, err := types.ValidateAndParseAccountFields(destinationDomain, args[1], args[2], args[3])
+			domain, err := queryDomain(clientCtx, destinationDomain)
+			if err != nil {
+				return err
+			}
+
+			accountProperties, err := types.ValidateAndParseAccountFields(destinationDomain, domain, args[1], args[2], args[3])
 			if err != nil {
 				return types.ErrInvalidInputs.Wrap(err.Error())
 			}
+			if err := parseAccountPropertiesFlags(cmd, accountProperties); err != nil {
+				return types.ErrInvalidInputs.Wrap(err.Error())
+			}
 
 			address := types.GenerateAddress(*accountProperties)
 
@@ -126,6 +161,10 @@ func TxRegisterAccountSignerlessly() *cobra.Command {
 				MintRecipient:     accountProperties.MintRecipient,
 				FallbackRecipient: accountProperties.FallbackRecipient,
 				DestinationCaller: accountProperties.DestinationCaller,
+				ExpirationHeight:  accountProperties.ExpirationHeight,
+				ExpirationTime:    types.NewExpirationTime(accountProperties.ExpirationTime),
+				AddressVersion:    accountProperties.AddressVersion,
+				Salt:              accountProperties.Salt,
 			}
 
 			factory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
@@ -184,7 +223,122 @@ func TxRegisterAccountSignerlessly() *cobra.Command {
 		},
 	}
 
+	addAccountPropertiesFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+func TxSetFallbackPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fallback-policy [address] (--max-attempts) (--timeout-blocks) (--timeout)",
+		Short: "Set the policy to automatically clear an AutoCCTP account to the fallback recipient",
+		Long: `Set the policy to automatically clear an AutoCCTP account to the fallback recipient after a number of
+		failed transfer attempts, or after a timeout since the first failure. If no condition is specified, the policy is removed.
+		The transaction must be signed by the fallback recipient of the account.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxAttempts, err := cmd.Flags().GetUint64(FlagMaxAttempts)
+			if err != nil {
+				return err
+			}
+			timeoutBlocks, err := cmd.Flags().GetUint64(FlagTimeoutBlocks)
+			if err != nil {
+				return err
+			}
+			timeout, err := cmd.Flags().GetDuration(FlagTimeout)
+			if err != nil {
+				return err
+			}
+
+			var policy *types.FallbackPolicy
+			if maxAttempts != 0 || timeoutBlocks != 0 || timeout != 0 {
+				policy = &types.FallbackPolicy{
+					MaxAttempts:   maxAttempts,
+					TimeoutBlocks: timeoutBlocks,
+					Timeout:       timeout,
+				}
+				if err := policy.ValidateBasic(); err != nil {
+					return types.ErrInvalidInputs.Wrap(err.Error())
+				}
+			}
+
+			msg := &types.MsgSetFallbackPolicy{
+				Signer:         clientCtx.GetFromAddress().String(),
+				Address:        args[0],
+				FallbackPolicy: policy,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagMaxAttempts, 0, "Number of failed transfer attempts after which the account is cleared")
+	cmd.Flags().Uint64(FlagTimeoutBlocks, 0, "Number of blocks since the first failure after which the account is cleared")
+	cmd.Flags().Duration(FlagTimeout, 0, "Time since the first failure after which the account is cleared")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// addAccountPropertiesFlags adds the flags used to define the optional properties of an
+// AutoCCTP account.
+func addAccountPropertiesFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagExpirationHeight, 0, "Block height from which the account expires, requires address version 1")
+	cmd.Flags().String(FlagExpirationTime, "", "Time, in RFC3339 format, from which the account expires, requires address version 1")
+	cmd.Flags().Uint32(FlagAddressVersion, types.AddressVersionV0, "Version of the scheme used to derive the account address")
+	cmd.Flags().String(FlagSalt, "", "Hex encoded salt used to derive a different account address, requires address version 1")
+}
+
+// parseAccountPropertiesFlags sets the optional properties of an AutoCCTP account from the
+// command flags.
+func parseAccountPropertiesFlags(cmd *cobra.Command, accountProperties *types.AccountProperties) error {
+	expirationHeight, err := cmd.Flags().GetUint64(FlagExpirationHeight)
+	if err != nil {
+		return err
+	}
+	rawExpirationTime, err := cmd.Flags().GetString(FlagExpirationTime)
+	if err != nil {
+		return err
+	}
+	addressVersion, err := cmd.Flags().GetUint32(FlagAddressVersion)
+	if err != nil {
+		return err
+	}
+	rawSalt, err := cmd.Flags().GetString(FlagSalt)
+	if err != nil {
+		return err
+	}
+
+	var expirationTime time.Time
+	if rawExpirationTime != "" {
+		expirationTime, err = time.Parse(time.RFC3339, rawExpirationTime)
+		if err != nil {
+			return fmt.Errorf("invalid expiration time %s: %w", rawExpirationTime, err)
+		}
+		expirationTime = expirationTime.UTC()
+	}
+
+	salt, err := hex.DecodeString(rawSalt)
+	if err != nil {
+		return fmt.Errorf("invalid salt %s: %w", rawSalt, err)
+	}
+	if err := types.ValidateAddressDerivation(addressVersion, salt); err != nil {
+		return err
+	}
+	if err := types.ValidateExpirationSupport(addressVersion, expirationHeight, expirationTime); err != nil {
+		return err
+	}
+
+	accountProperties.ExpirationHeight = expirationHeight
+	accountProperties.ExpirationTime = expirationTime
+	accountProperties.AddressVersion = addressVersion
+	accountProperties.Salt = salt
+
+	return nil
+}
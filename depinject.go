@@ -21,13 +21,19 @@
 package autocctp
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	modulev1 "autocctp.dev/api/module/v1"
 	"autocctp.dev/keeper"
@@ -38,6 +44,7 @@ func init() {
 	appmodule.Register(
 		&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetAutoCCTPHooks),
 	)
 }
 
@@ -66,12 +73,22 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+	authorityAddress, err := in.AccountKeeper.AddressCodec().BytesToString(authority)
+	if err != nil {
+		panic(err)
+	}
+
 	k := keeper.NewKeeper(
 		in.Codec,
 		in.Logger,
 		in.StoreService,
 		in.TransientService,
 		in.EventService,
+		authorityAddress,
 		in.AccountKeeper,
 		in.BankKeeper,
 		in.FiatTokenFactoryKeeper,
@@ -82,3 +99,40 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{Keeper: k, Module: m, Restriction: k.SendRestrictionFn}
 }
+
+// InvokeSetAutoCCTPHooks sets the hooks provided by the other modules on the keeper, in the
+// order defined by the module config or, by default, in the alphabetical order of the
+// module names.
+func InvokeSetAutoCCTPHooks(config *modulev1.Module, keeper *keeper.Keeper, autocctpHooks map[string]types.AutoCCTPHooksWrapper) error {
+	// All arguments to invokers are optional.
+	if keeper == nil || config == nil {
+		return nil
+	}
+
+	modNames := slices.Sorted(maps.Keys(autocctpHooks))
+	order := config.HooksOrder
+	if len(order) == 0 {
+		order = modNames
+	}
+
+	if len(order) != len(modNames) {
+		return fmt.Errorf("len(hooks_order: %v) != len(hooks modules: %v)", order, modNames)
+	}
+
+	if len(modNames) == 0 {
+		return nil
+	}
+
+	var multiHooks types.MultiAutoCCTPHooks
+	for _, modName := range order {
+		hook, ok := autocctpHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find autocctp hooks for module %s", modName)
+		}
+
+		multiHooks = append(multiHooks, hook)
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
@@ -222,5 +222,5 @@ func TestClearAccount_ToMintRecipient(t *testing.T) {
 	stats := GetAutoCCTPStatsByDestinationDomain(t, ctx, val, destinationDomain)
 	require.Equal(t, uint64(1), stats.Accounts, "expected a different number of accounts")
 	require.Equal(t, uint64(1), stats.Transfers, "expected a different number of transfers")
-	require.Equal(t, transferAmt.Uint64(), stats.TotalTransferred, "expected a different total transferred")
+	require.Equal(t, transferAmt.String(), stats.TotalTransferredAmount.String(), "expected a different total transferred")
 }
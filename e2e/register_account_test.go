@@ -131,7 +131,7 @@ func TestRegisterAccountSignerlessly(t *testing.T) {
 
 	fees := GetTxFee(t, ctx, val, hash)
 	transferAmtNoFees := transferAmt.Sub(fees.AmountOf("uusdc"))
-	require.Equal(t, transferAmtNoFees.Uint64(), resp.TotalTransferred, "expected total transfer equal to initial amount minus fees")
+	require.Equal(t, transferAmtNoFees.String(), resp.TotalTransferredAmount.String(), "expected total transfer equal to initial amount minus fees")
 
 	tx := GetTx(t, ctx, val, hash)
 	for _, rawEvent := range tx.Events {
@@ -156,7 +156,7 @@ func TestRegisterAccountSignerlessly(t *testing.T) {
 	stats := GetAutoCCTPStatsByDestinationDomain(t, ctx, val, fmt.Sprintf("%d", s.destinationDomain))
 	require.Equal(t, uint64(1), stats.Accounts, "expected a different number of accounts")
 	require.Equal(t, uint64(1), stats.Transfers, "expected a different number of transfers")
-	require.Equal(t, transferAmtNoFees.Uint64(), stats.TotalTransferred, "expected a different total transferred amount")
+	require.Equal(t, transferAmtNoFees.String(), stats.TotalTransferredAmount.String(), "expected a different total transferred amount")
 }
 
 // TestFlowIBC tests the registration of an AutoCCTP account and its automatic transfer
@@ -363,7 +363,7 @@ func TestFlowIBC(t *testing.T) {
 			stats := GetAutoCCTPStatsByDestinationDomain(t, ctx, val, fmt.Sprintf("%d", s.destinationDomain))
 			require.Equal(t, uint64(1), stats.Accounts, "expected a different number of accounts")
 			require.Equal(t, uint64(2), stats.Transfers, "expected a different number of transfers")
-			require.Equal(t, ibcAmt1.Add(ibcAmt2).Uint64(), stats.TotalTransferred, "expected a different total transferred")
+			require.Equal(t, ibcAmt1.Add(ibcAmt2).String(), stats.TotalTransferredAmount.String(), "expected a different total transferred")
 		})
 	}
 }
@@ -22,64 +22,1010 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"autocctp.dev/types"
 )
 
-// ExecuteTransfers is an end block hook that clears all pending transfers from the transient state.
+// SweepAccounts is a begin block hook that marks for clearing the AutoCCTP accounts holding at
+// least the minimum transfer amount without a pending transfer, because the funds were received
+// after the end block of the module or without going through the send restriction.
+//
+// The accounts marked as dirty are checked first, then up to sweep_batch_size registered
+// accounts are checked, resuming from where the previous sweep stopped, so that every account
+// is eventually checked.
+func (k *Keeper) SweepAccounts(ctx context.Context) {
+	var dirty []string
+	if err := k.DirtyAccounts.Walk(ctx, nil, func(address string) (bool, error) {
+		dirty = append(dirty, address)
+		return false, nil
+	}); err != nil {
+		k.logger.Error("unable to get the dirty accounts", "err", err)
+	}
+	for _, address := range dirty {
+		if err := k.DirtyAccounts.Remove(ctx, address); err != nil {
+			k.logger.Error("begin block", "error", err)
+		}
+		if _, err := k.markAwaitingTransfer(ctx, address); err != nil {
+			k.logger.Error("begin block", "error", err)
+		}
+	}
+
+	batchSize := k.GetParams(ctx).SweepBatchSize
+	k.resumeAwaitingTransferBatch(ctx, batchSize)
+	if batchSize == 0 {
+		return
+	}
+
+	rng := new(collections.Range[collections.Pair[uint32, string]])
+	if cursor, err := k.SweepCursor.Get(ctx); err == nil {
+		rng = rng.StartExclusive(cursor)
+	}
+
+	var keys []collections.Pair[uint32, string]
+	if err := k.AccountsByDestinationDomain.Walk(ctx, rng, func(key collections.Pair[uint32, string], _ collections.NoValue) (bool, error) {
+		keys = append(keys, key)
+		return uint64(len(keys)) >= batchSize, nil
+	}); err != nil {
+		k.logger.Error("unable to walk the accounts", "err", err)
+		return
+	}
+
+	for _, key := range keys {
+		// Failed transfers are retried according to their own schedule.
+		if k.GetFailedTransfer(ctx, key.K2()) != nil {
+			continue
+		}
+		if _, err := k.markAwaitingTransfer(ctx, key.K2()); err != nil {
+			k.logger.Error("begin block", "error", err)
+		}
+	}
+
+	// The next sweep starts again from the first account once all the accounts are checked.
+	if uint64(len(keys)) < batchSize {
+		if err := k.SweepCursor.Remove(ctx); err != nil {
+			k.logger.Error("begin block", "error", err)
+		}
+		return
+	}
+	if err := k.SweepCursor.Set(ctx, keys[len(keys)-1]); err != nil {
+		k.logger.Error("begin block", "error", err)
+	}
+}
+
+// resumeAwaitingTransferBatch marks for clearing up to batchSize awaiting transfers, or all of
+// them if zero, while the resumption of the transfers deferred while paused is in progress,
+// continuing from where the previous batch stopped.
+func (k *Keeper) resumeAwaitingTransferBatch(ctx context.Context, batchSize uint64) {
+	cursor, err := k.ResumeCursor.Get(ctx)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			k.logger.Error("begin block", "error", err)
+		}
+		return
+	}
+
+	rng := new(collections.Range[string])
+	if cursor != "" {
+		rng = rng.StartExclusive(cursor)
+	}
+
+	var addresses []string
+	if err := k.AwaitingTransfers.Walk(ctx, rng, func(address string) (bool, error) {
+		addresses = append(addresses, address)
+		return batchSize != 0 && uint64(len(addresses)) >= batchSize, nil
+	}); err != nil {
+		k.logger.Error("unable to walk the awaiting transfers", "err", err)
+		return
+	}
+
+	for _, address := range addresses {
+		// Failed transfers are retried according to their own schedule.
+		if k.GetFailedTransfer(ctx, address) != nil {
+			continue
+		}
+		if _, err := k.markAwaitingTransfer(ctx, address); err != nil {
+			k.logger.Error("begin block", "error", err)
+		}
+	}
+
+	if batchSize == 0 || uint64(len(addresses)) < batchSize {
+		if err := k.ResumeCursor.Remove(ctx); err != nil {
+			k.logger.Error("begin block", "error", err)
+		}
+		return
+	}
+	if err := k.ResumeCursor.Set(ctx, addresses[len(addresses)-1]); err != nil {
+		k.logger.Error("begin block", "error", err)
+	}
+}
+
+// ExecuteTransfers is an end block hook that clears all pending transfers from the transient state
+// and retries the failed transfers scheduled for the current block.
+//
+// At most max_transfers_per_block CCTP burns are executed, starting from the queued transfers,
+// and the remaining transfers are queued for the following blocks.
+//
+// While paused, or while the minting token is paused, transfers are deferred until unpaused.
+// Transfers involving a blacklisted party are refused.
 func (k *Keeper) ExecuteTransfers(ctx context.Context) {
-	transfers, err := k.GetPendingTransfers(ctx)
-	if len(transfers) == 0 || err != nil {
+	if err := k.TransfersExecuted.Set(ctx, true); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+
+	if paused, _ := k.Paused.Get(ctx); paused {
+		k.deferPendingTransfers(ctx)
+		return
+	}
+	if k.deferTransfersIfTokenPaused(ctx) {
+		k.deferPendingTransfers(ctx)
+		return
+	}
+
+	pending, err := k.GetPendingTransfers(ctx)
+	if err != nil {
+		return
+	}
+
+	params := k.GetParams(ctx)
+
+	// The queued transfers are executed first, in the order they were queued.
+	transfers, err := k.GetQueuedTransfers(ctx, params.MaxTransfersPerBlock)
+	if err != nil {
+		k.logger.Error("unable to get queued transfers", "err", err)
+	}
+	scheduled := make(map[string]bool, len(transfers))
+	for _, transfer := range transfers {
+		scheduled[transfer.Address] = true
+	}
+	schedule := func(accounts []types.Account) {
+		for _, account := range accounts {
+			// Accounts still queued are executed once they reach the front of the queue.
+			if queued, _ := k.TransferQueueByAddress.Has(ctx, account.Address); queued || scheduled[account.Address] {
+				continue
+			}
+			scheduled[account.Address] = true
+			transfers = append(transfers, account)
+		}
+	}
+	schedule(pending)
+
+	retries, err := k.GetRetryableTransfers(ctx)
+	if err != nil {
+		k.logger.Error("unable to get retryable transfers", "err", err)
+	}
+	schedule(retries)
+
+	if len(transfers) == 0 {
 		return
 	}
 
 	k.logger.Info(fmt.Sprintf("executing %d automatic cctp transfer(s)", len(transfers)))
 
 	mintingToken := k.ftfKeeper.GetMintingDenom(ctx)
+	splitOversizedTransfers := params.SplitOversizedTransfers
+	processed := uint64(0)
+	burns := uint64(0)
+	burnLimitReached := func() bool {
+		return params.MaxTransfersPerBlock != 0 && burns >= params.MaxTransfersPerBlock
+	}
+	completed := make(map[string]bool, len(transfers))
 	for _, transfer := range transfers {
+		if k.IsPaused(ctx, transfer.DestinationDomain) {
+			if err := k.RemoveQueuedTransfer(ctx, transfer.Address); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			// The failed transfer, if any, is postponed so that it is not picked up again at
+			// every block while the domain is paused.
+			if err := k.DeferFailedTransfer(ctx, transfer.Address); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			continue
+		}
+
+		// The transfers exceeding the maximum number of burns executed in a block are queued,
+		// keeping their position if already queued.
+		if burnLimitReached() {
+			if err := k.EnqueueTransfer(ctx, transfer); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			continue
+		}
+		if err := k.RemoveQueuedTransfer(ctx, transfer.Address); err != nil {
+			k.logger.Error("end block", "error", err)
+		}
+		// The transfer deferred by the rate limit is evaluated again, and deferred once more
+		// without a new event if it still exceeds the capacity of the destination domain.
+		wasRateLimited, err := k.RateLimitedTransfers.Has(ctx, transfer.Address)
+		if err != nil {
+			k.logger.Error("end block", "error", err)
+		}
+		if err := k.RateLimitedTransfers.Remove(ctx, transfer.Address); err != nil {
+			k.logger.Error("end block", "error", err)
+		}
+		sequence := processed
+		processed++
+
 		balance := k.bankKeeper.GetBalance(ctx, transfer.GetAddress(), mintingToken.Denom)
 		if balance.IsZero() {
+			// The funds may have been cleared in a different way, so there is nothing to retry.
+			if err := k.RemoveFailedTransfer(ctx, transfer.Address); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			if err := k.OwedFees.Remove(ctx, transfer.Address); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
 			continue
 		}
 
-		var err error
-		if len(transfer.DestinationCaller) == 0 {
-			_, err = k.cctpService.DepositForBurn(ctx, &cctptypes.MsgDepositForBurn{
-				From:              transfer.Address,
-				Amount:            balance.Amount,
-				DestinationDomain: transfer.DestinationDomain,
-				MintRecipient:     transfer.MintRecipient,
-				BurnToken:         balance.Denom,
-			})
-		} else {
-			_, err = k.cctpService.DepositForBurnWithCaller(ctx, &cctptypes.MsgDepositForBurnWithCaller{
-				From:              transfer.Address,
-				Amount:            balance.Amount,
+		if reason := k.blacklistReason(ctx, transfer); reason != types.ComplianceReasonUnspecified {
+			k.emitTransferBlocked(ctx, transfer, balance, reason)
+			// The refused transfer is deferred, keeping its failed transfer, if any, so that
+			// it is retried once the party is removed from the blacklist.
+			if err := k.DeferFailedTransfer(ctx, transfer.Address); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			continue
+		}
+
+		chunkSize := balance.Amount
+		if splitOversizedTransfers {
+			maxTransferAmount, err := k.getMaxTransferAmount(ctx, balance.Denom)
+			if err != nil {
+				k.logger.Error("unable to get the max transfer amount", "denom", balance.Denom, "err", err)
+				k.handleFailedTransfer(ctx, transfer, balance, sequence, err)
+				continue
+			}
+			if maxTransferAmount.IsPositive() {
+				chunkSize = math.MinInt(chunkSize, maxTransferAmount)
+			}
+		}
+
+		// The fee is deducted from the transferred amount but only collected once the whole
+		// amount is transferred, and is kept as owed in the meantime so that the amount left
+		// by a partial transfer is not charged again. Balances not covering the fee are deferred
+		// until more funds are deposited.
+		owedFee, err := k.getOwedFee(ctx, params, transfer, balance)
+		if err != nil {
+			k.logger.Error("unable to get the owed transfer fee", "from", transfer.Address, "err", err)
+			k.handleFailedTransfer(ctx, transfer, balance, sequence, err)
+			continue
+		}
+		if owedFee.Amount.GTE(balance.Amount) {
+			k.logger.Info("automatic cctp transfer deferred", "from", transfer.Address, "reason", fmt.Sprintf("balance %s does not cover the transfer fee %s", balance, owedFee.Amount))
+			if err := k.DeferFailedTransfer(ctx, transfer.Address); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			continue
+		}
+		transferAmount := balance.Amount.Sub(owedFee.Amount)
+
+		// Only the amount fitting in the capacity left in the rate limit window is transferred,
+		// the remaining amount is carried over to the following blocks.
+		rateLimit, allowed, err := k.getRateLimitAllowance(ctx, transfer, transferAmount, chunkSize)
+		if err != nil {
+			k.logger.Error("unable to get the rate limit allowance", "from", transfer.Address, "err", err)
+			allowed = math.ZeroInt()
+		}
+		if !allowed.IsPositive() {
+			k.logger.Info("automatic cctp transfer deferred", "from", transfer.Address, "reason", fmt.Sprintf("rate limit of destination domain %d exceeded", transfer.DestinationDomain))
+			k.deferRateLimitedTransfer(ctx, transfer, sdk.NewCoin(balance.Denom, transferAmount), wasRateLimited)
+			continue
+		}
+
+		if err := k.runIsolated(ctx, params.TransferGasLimit, func(ctx context.Context) error {
+			return k.Hooks().BeforeTransferExecuted(ctx, transfer, balance)
+		}); err != nil {
+			// The vetoed transfer is deferred without counting a failed attempt, as it was
+			// refused rather than failed, and is kept as awaiting to be retried.
+			k.logger.Info("automatic cctp transfer vetoed by hooks", "from", transfer.Address, "err", err)
+			if err := k.DeferFailedTransfer(ctx, transfer.Address); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			continue
+		}
+
+		// The allowed amount is transferred in chunks of at most chunkSize, and the remaining
+		// amount is recorded as a failed transfer if one of the chunks fails.
+		remaining := allowed
+		for remaining.IsPositive() {
+			// The remaining amount is queued once the maximum number of burns is reached.
+			if burnLimitReached() {
+				if err := k.EnqueueTransfer(ctx, transfer); err != nil {
+					k.logger.Error("end block", "error", err)
+				}
+				break
+			}
+			burns++
+			amount := math.MinInt(remaining, chunkSize)
+
+			var nonce uint64
+			if err := k.runIsolated(ctx, params.TransferGasLimit, func(ctx context.Context) (err error) {
+				nonce, err = k.depositForBurn(ctx, transfer, sdk.NewCoin(balance.Denom, amount))
+				return err
+			}); err != nil {
+				k.logger.Error(
+					"unable to execute automatic cctp transfer",
+					"from", transfer.Address,
+					"to", transfer.MintRecipient,
+					"denom", balance.Denom,
+					"destination_domain", transfer.DestinationDomain,
+					"amount", amount,
+					"err", err,
+				)
+				// Only the failed burns are counted in the outcome statistics.
+				if err := k.IncrementFailedTransfers(ctx, transfer.DestinationDomain); err != nil {
+					k.logger.Error("end block", "error", err)
+				}
+				k.handleFailedTransfer(ctx, transfer, sdk.NewCoin(balance.Denom, remaining), sequence, err)
+				break
+			}
+
+			if err := k.eventService.EventManager(ctx).Emit(ctx, &types.TransferExecuted{
+				Address:           transfer.Address,
 				DestinationDomain: transfer.DestinationDomain,
 				MintRecipient:     transfer.MintRecipient,
-				BurnToken:         balance.Denom,
 				DestinationCaller: transfer.DestinationCaller,
-			})
+				Amount:            amount,
+				Denom:             balance.Denom,
+				Nonce:             nonce,
+				Sequence:          sequence,
+			}); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			if err := k.AddTransferRecord(ctx, transfer.Address, sdk.NewCoin(balance.Denom, amount), nonce, types.TransferOutcomeExecuted, nil); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			if err := k.runIsolated(ctx, params.TransferGasLimit, func(ctx context.Context) error {
+				return k.Hooks().AfterTransferExecuted(ctx, transfer, sdk.NewCoin(balance.Denom, amount), nonce)
+			}); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+
+			if err := k.IncrementNumOfTransfers(ctx, transfer.DestinationDomain); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			if err := k.IncrementTotalTransferred(ctx, transfer.DestinationDomain, amount); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			if err := k.AddStatsHistory(ctx, transfer.DestinationDomain, amount); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			if rateLimit != nil {
+				if err := k.AddRateLimitUsage(ctx, transfer.DestinationDomain, types.NewRateLimitUsage(1, amount)); err != nil {
+					k.logger.Error("end block", "error", err)
+				}
+			}
+			remaining = remaining.Sub(amount)
+		}
+
+		// The transferred amount is no longer part of the balance on which the fee is owed.
+		owedFee.AssessedAmount = owedFee.AssessedAmount.Sub(allowed.Sub(remaining))
+		if remaining.IsPositive() || allowed.LT(transferAmount) {
+			if err := k.OwedFees.Set(ctx, transfer.Address, owedFee); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+		}
+
+		if remaining.IsZero() {
+			if err := k.RemoveFailedTransfer(ctx, transfer.Address); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			if allowed.LT(transferAmount) {
+				k.deferRateLimitedTransfer(ctx, transfer, sdk.NewCoin(balance.Denom, transferAmount.Sub(allowed)), wasRateLimited)
+				continue
+			}
+			k.settleOwedFee(ctx, params, transfer, owedFee, balance.Denom)
+			completed[transfer.Address] = true
+		}
+	}
+
+	// The accounts whose transfer was deferred, or did not complete, are kept as awaiting.
+	for _, transfer := range transfers {
+		if completed[transfer.Address] {
+			if err := k.AwaitingTransfers.Remove(ctx, transfer.Address); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			continue
+		}
+		if err := k.updateAwaitingTransfer(ctx, transfer.Address); err != nil {
+			k.logger.Error("end block", "error", err)
+		}
+	}
+}
+
+// deferPendingTransfers keeps the accounts with a pending transfer as awaiting, as their
+// transfers are deferred while the automatic transfers are paused.
+func (k *Keeper) deferPendingTransfers(ctx context.Context) {
+	pending, err := k.GetPendingTransfers(ctx)
+	if err != nil {
+		k.logger.Error("unable to get pending transfers", "err", err)
+		return
+	}
+	for _, transfer := range pending {
+		if err := k.updateAwaitingTransfer(ctx, transfer.Address); err != nil {
+			k.logger.Error("end block", "error", err)
+		}
+	}
+}
+
+// getRateLimitAllowance returns the part of the amount transferred from the AutoCCTP account,
+// in chunks of at most chunkSize, fitting in the capacity left in the rolling window of the
+// rate limit of the destination domain. The rate limit is returned if the domain is rate limited.
+func (k *Keeper) getRateLimitAllowance(ctx context.Context, transfer types.Account, amount math.Int, chunkSize math.Int) (*types.RateLimit, math.Int, error) {
+	domain := k.GetDomain(ctx, transfer.DestinationDomain)
+	if domain == nil || domain.RateLimit == nil {
+		return nil, amount, nil
+	}
+
+	usage, err := k.PruneRateLimitUsage(ctx, transfer.DestinationDomain, domain.RateLimit.Window)
+	if err != nil {
+		return nil, math.ZeroInt(), err
+	}
+
+	return domain.RateLimit, domain.RateLimit.Allowance(usage, amount, chunkSize), nil
+}
+
+// deferRateLimitedTransfer defers the transfer of the coin from the AutoCCTP account, exceeding
+// the rate limit of the destination domain, to the following blocks. The transfer is moved to
+// the end of the transfer queue, so that it is evaluated again within the maximum number of
+// transfers per block, and the event is only emitted when it is deferred for the first time.
+func (k *Keeper) deferRateLimitedTransfer(ctx context.Context, transfer types.Account, coin sdk.Coin, wasRateLimited bool) {
+	if err := k.RateLimitedTransfers.Set(ctx, transfer.Address); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+	if err := k.EnqueueTransfer(ctx, transfer); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+	if wasRateLimited {
+		return
+	}
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.TransferRateLimited{
+		Address:           transfer.Address,
+		DestinationDomain: transfer.DestinationDomain,
+		Amount:            coin.Amount,
+		Denom:             coin.Denom,
+	}); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+}
+
+// deferTransfersIfTokenPaused returns true if the minting token is paused in the
+// fiat-tokenfactory, emitting an event for every pending transfer deferred. Once the token
+// is unpaused, the awaiting transfers are resumed starting from the next block.
+func (k *Keeper) deferTransfersIfTokenPaused(ctx context.Context) bool {
+	tokenPaused := k.ftfKeeper.GetPaused(ctx).Paused
+	wasPaused, _ := k.TokenPaused.Get(ctx)
+	if tokenPaused != wasPaused {
+		if err := k.TokenPaused.Set(ctx, tokenPaused); err != nil {
+			k.logger.Error("end block", "error", err)
+		}
+	}
+
+	if !tokenPaused {
+		if wasPaused {
+			if err := k.resumeAwaitingTransfers(ctx); err != nil {
+				k.logger.Error("unable to resume the transfers deferred while the token was paused", "err", err)
+			}
 		}
+		return false
+	}
+
+	transfers, err := k.GetPendingTransfers(ctx)
+	if err != nil {
+		return true
+	}
+	mintingToken := k.ftfKeeper.GetMintingDenom(ctx)
+	for _, transfer := range transfers {
+		balance := k.bankKeeper.GetBalance(ctx, transfer.GetAddress(), mintingToken.Denom)
+		k.emitTransferBlocked(ctx, transfer, balance, types.ComplianceReasonTokenPaused)
+	}
+
+	return true
+}
+
+// emitTransferBlocked emits the event of the transfer of the coin from the AutoCCTP account
+// blocked by the fiat-tokenfactory compliance checks.
+func (k *Keeper) emitTransferBlocked(ctx context.Context, transfer types.Account, coin sdk.Coin, reason types.ComplianceReason) {
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.TransferBlocked{
+		Address:           transfer.Address,
+		DestinationDomain: transfer.DestinationDomain,
+		Amount:            coin.Amount,
+		Denom:             coin.Denom,
+		Reason:            reason,
+	}); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+}
+
+// runIsolated runs fn in a branched cache context with a gas meter limited to gasLimit, or
+// unlimited if zero. The writes, and the events emitted, are committed only if fn succeeds.
+// Panics, including running out of gas, are recovered and returned as errors so that a
+// single transfer cannot halt the chain.
+func (k *Keeper) runIsolated(ctx context.Context, gasLimit uint64, fn func(context.Context) error) (err error) {
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if gasLimit == 0 {
+		cacheCtx = cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	} else {
+		cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = fmt.Errorf("out of gas in location %s: gas limit %d", outOfGas.Descriptor, gasLimit)
+			} else {
+				err = fmt.Errorf("recovered from panic: %v", r)
+			}
+		}
+	}()
+
+	if err := fn(cacheCtx); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
+
+// getTransferFee returns the fee of the transfer of the balance from the AutoCCTP account,
+// or zero if no transfer fee is set for its destination domain.
+func getTransferFee(params types.Params, transfer types.Account, balance sdk.Coin) math.Int {
+	transferFee := params.GetTransferFee(transfer.DestinationDomain)
+	if transferFee == nil {
+		return math.ZeroInt()
+	}
+
+	return math.MaxInt(transferFee.Amount(balance.Amount), math.ZeroInt())
+}
+
+// getOwedFee returns the transfer fee owed by the AutoCCTP account for the transfer of the
+// balance. The fee is computed once for every deposit, on the part of the balance it has not
+// been computed on yet, and added to the fee already owed by the account.
+func (k *Keeper) getOwedFee(ctx context.Context, params types.Params, transfer types.Account, balance sdk.Coin) (types.OwedFee, error) {
+	owedFee, err := k.OwedFees.Get(ctx, transfer.Address)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.OwedFee{}, fmt.Errorf("error getting the owed fee for address %s: %w", transfer.Address, err)
+	}
+	// The fee is computed again on the whole balance if the funds were moved out of the
+	// account in a different way.
+	if err != nil || balance.Amount.LT(owedFee.AssessedAmount) {
+		owedFee = types.OwedFee{Address: transfer.Address, Amount: math.ZeroInt(), AssessedAmount: math.ZeroInt()}
+	}
+
+	if deposited := balance.Amount.Sub(owedFee.AssessedAmount); deposited.IsPositive() {
+		owedFee.Amount = owedFee.Amount.Add(getTransferFee(params, transfer, sdk.NewCoin(balance.Denom, deposited)))
+		owedFee.AssessedAmount = balance.Amount
+	}
+
+	return owedFee, nil
+}
+
+// settleOwedFee collects the fee owed by the AutoCCTP account once its funds are entirely
+// transferred. If the fee cannot be collected, it is kept as owed and collected along with
+// the transfer of the next deposit.
+func (k *Keeper) settleOwedFee(ctx context.Context, params types.Params, transfer types.Account, owedFee types.OwedFee, denom string) {
+	if owedFee.Amount.IsPositive() {
+		if err := k.runIsolated(ctx, params.TransferGasLimit, func(ctx context.Context) error {
+			return k.collectTransferFee(ctx, transfer, sdk.NewCoin(denom, owedFee.Amount))
+		}); err != nil {
+			k.logger.Error("unable to collect the transfer fee", "from", transfer.Address, "err", err)
+			if err := k.OwedFees.Set(ctx, transfer.Address, owedFee); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			return
+		}
+	}
 
+	if err := k.OwedFees.Remove(ctx, transfer.Address); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+}
+
+// collectTransferFee sends the fee from the AutoCCTP account to the fee recipient. If the
+// fee recipient is not set, the fee is sent to the module account.
+func (k *Keeper) collectTransferFee(ctx context.Context, transfer types.Account, fee sdk.Coin) error {
+	params := k.GetParams(ctx)
+	coins := sdk.NewCoins(fee)
+	recipient := authtypes.NewModuleAddress(types.ModuleName)
+	if params.FeeRecipient == "" {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, transfer.GetAddress(), types.ModuleName, coins); err != nil {
+			return fmt.Errorf("error sending the transfer fee: %w", err)
+		}
+	} else {
+		var err error
+		recipient, err = k.accountKeeper.AddressCodec().StringToBytes(params.FeeRecipient)
+		if err != nil {
+			return fmt.Errorf("invalid fee recipient: %w", err)
+		}
+		if err := k.bankKeeper.SendCoins(ctx, transfer.GetAddress(), recipient, coins); err != nil {
+			return fmt.Errorf("error sending the transfer fee: %w", err)
+		}
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.TransferFeeCollected{
+		Address:           transfer.Address,
+		DestinationDomain: transfer.DestinationDomain,
+		Recipient:         recipient.String(),
+		Amount:            fee.Amount,
+		Denom:             fee.Denom,
+	}); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+	if err := k.IncrementTotalFees(ctx, transfer.DestinationDomain, fee.Amount); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+
+	return nil
+}
+
+// depositForBurn executes the CCTP transfer of the coin from the AutoCCTP account to the
+// associated mint recipient, returning the nonce of the CCTP message.
+func (k *Keeper) depositForBurn(ctx context.Context, transfer types.Account, coin sdk.Coin) (uint64, error) {
+	if len(transfer.DestinationCaller) == 0 {
+		resp, err := k.cctpService.DepositForBurn(ctx, &cctptypes.MsgDepositForBurn{
+			From:              transfer.Address,
+			Amount:            coin.Amount,
+			DestinationDomain: transfer.DestinationDomain,
+			MintRecipient:     transfer.MintRecipient,
+			BurnToken:         coin.Denom,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return resp.GetNonce(), nil
+	}
+
+	resp, err := k.cctpService.DepositForBurnWithCaller(ctx, &cctptypes.MsgDepositForBurnWithCaller{
+		From:              transfer.Address,
+		Amount:            coin.Amount,
+		DestinationDomain: transfer.DestinationDomain,
+		MintRecipient:     transfer.MintRecipient,
+		BurnToken:         coin.Denom,
+		DestinationCaller: transfer.DestinationCaller,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetNonce(), nil
+}
+
+// handleFailedTransfer records the failed transfer of the coin from the AutoCCTP account,
+// scheduling it for a retry unless it exhausted the attempts, and emits the associated event
+// with the position of the transfer in the execution order of the block.
+func (k *Keeper) handleFailedTransfer(ctx context.Context, transfer types.Account, coin sdk.Coin, sequence uint64, transferErr error) {
+	params := k.GetParams(ctx)
+	if err := k.SetFailedTransfer(ctx, transfer.Address, coin.Amount, transferErr); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+	if failedTransfer := k.GetFailedTransfer(ctx, transfer.Address); failedTransfer != nil && failedTransfer.Attempts >= params.MaxTransferAttempts {
+		// The transfer is no longer retried automatically, it is kept until the account is
+		// cleared manually or by its fallback policy.
+		if err := k.eventService.EventManager(ctx).Emit(ctx, &types.TransferRetriesExhausted{
+			Address:           transfer.Address,
+			DestinationDomain: transfer.DestinationDomain,
+			Amount:            coin.Amount,
+			Attempts:          failedTransfer.Attempts,
+		}); err != nil {
+			k.logger.Error("end block", "error", err)
+		}
+	}
+	if err := k.AddTransferRecord(ctx, transfer.Address, coin, 0, types.TransferOutcomeFailed, transferErr); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+	if err := k.runIsolated(ctx, params.TransferGasLimit, func(ctx context.Context) error {
+		return k.Hooks().AfterTransferFailed(ctx, transfer, coin, transferErr)
+	}); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.TransferFailed{
+		Address:           transfer.Address,
+		DestinationDomain: transfer.DestinationDomain,
+		MintRecipient:     transfer.MintRecipient,
+		DestinationCaller: transfer.DestinationCaller,
+		Amount:            coin.Amount,
+		Denom:             coin.Denom,
+		Error:             transferErr.Error(),
+		Sequence:          sequence,
+	}); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+}
+
+// SweepFailedTransfers is an end block hook that clears to the fallback address the accounts
+// with a failed transfer whose fallback policy conditions are met.
+//
+// Up to fallback_sweep_batch_size failed transfers are checked, resuming from where the
+// previous sweep stopped, so that every failed transfer is eventually checked.
+func (k *Keeper) SweepFailedTransfers(ctx context.Context) {
+	params := k.GetParams(ctx)
+	batchSize := params.FallbackSweepBatchSize
+
+	rng := new(collections.Range[string])
+	if cursor, err := k.FallbackSweepCursor.Get(ctx); err == nil {
+		rng = rng.StartExclusive(cursor)
+	}
+
+	var failedTransfers []types.FailedTransfer
+	if err := k.FailedTransfers.Walk(ctx, rng, func(_ string, failedTransfer types.FailedTransfer) (bool, error) {
+		failedTransfers = append(failedTransfers, failedTransfer)
+		return batchSize != 0 && uint64(len(failedTransfers)) >= batchSize, nil
+	}); err != nil {
+		k.logger.Error("unable to walk the failed transfers", "err", err)
+		return
+	}
+
+	// The next sweep starts again from the first failed transfer once all of them are checked.
+	if batchSize == 0 || uint64(len(failedTransfers)) < batchSize {
+		if err := k.FallbackSweepCursor.Remove(ctx); err != nil {
+			k.logger.Error("end block", "error", err)
+		}
+	} else if err := k.FallbackSweepCursor.Set(ctx, failedTransfers[len(failedTransfers)-1].Address); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gasLimit := params.TransferGasLimit
+	mintingToken := k.ftfKeeper.GetMintingDenom(ctx)
+	for _, failedTransfer := range failedTransfers {
+		address, err := k.accountKeeper.AddressCodec().StringToBytes(failedTransfer.Address)
 		if err != nil {
+			continue
+		}
+		account, ok := k.accountKeeper.GetAccount(ctx, address).(*types.Account)
+		if !ok || account.FallbackPolicy == nil {
+			continue
+		}
+
+		reason := account.FallbackPolicy.ClearingReason(failedTransfer, sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+		if reason == types.ClearingReasonUnspecified {
+			continue
+		}
+
+		balance := k.bankKeeper.GetBalance(ctx, address, mintingToken.Denom)
+		if balance.IsZero() {
+			if err := k.RemoveFailedTransfer(ctx, failedTransfer.Address); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			continue
+		}
+
+		if err := k.runIsolated(ctx, gasLimit, func(ctx context.Context) error {
+			return k.clearAccount(ctx, account, sdk.NewCoins(balance), true, reason)
+		}); err != nil {
 			k.logger.Error(
-				"unable to execute automatic cctp transfer",
-				"from", transfer.Address,
-				"to", transfer.MintRecipient,
-				"denom", balance.Denom,
-				"destination_domain", transfer.DestinationDomain,
-				"amount", balance.Amount,
+				"unable to clear account to fallback recipient",
+				"address", account.Address,
+				"fallback_recipient", account.FallbackRecipient,
+				"reason", reason.String(),
 				"err", err,
 			)
-		} else {
-			if err := k.IncrementNumOfTransfers(ctx, transfer.DestinationDomain); err != nil {
+		}
+	}
+}
+
+// ForwardOtherDenoms is an end block hook that forwards to the fallback recipient the denoms
+// other than the minting denom received in the current block by the AutoCCTP accounts, and
+// retries the failed forwards scheduled for the current block.
+func (k *Keeper) ForwardOtherDenoms(ctx context.Context) {
+	forwards, err := k.GetPendingForwards(ctx)
+	if err != nil {
+		k.logger.Error("unable to get pending forwards", "err", err)
+	}
+	scheduled := make(map[string]bool, len(forwards))
+	for _, forward := range forwards {
+		scheduled[forward.Address] = true
+	}
+
+	retryable, err := k.GetRetryableForwards(ctx)
+	if err != nil {
+		k.logger.Error("unable to get retryable forwards", "err", err)
+	}
+	for _, forward := range retryable {
+		if !scheduled[forward.Address] {
+			scheduled[forward.Address] = true
+			forwards = append(forwards, forward)
+		}
+	}
+
+	mintingToken := k.ftfKeeper.GetMintingDenom(ctx)
+	for _, forward := range forwards {
+		coins := sdk.NewCoins()
+		for _, coin := range k.bankKeeper.GetAllBalances(ctx, forward.GetAddress()) {
+			if coin.Denom != mintingToken.Denom {
+				coins = coins.Add(coin)
+			}
+		}
+		// The failed forward is dropped once there is nothing left to forward, or the account
+		// no longer forwards the other denoms.
+		if coins.IsZero() || forward.Deregistered || !forward.ForwardOtherDenoms {
+			if err := k.RemoveFailedForward(ctx, forward.Address); err != nil {
+				k.logger.Error("end block", "error", err)
+			}
+			continue
+		}
+
+		fallbackRecipient, err := k.accountKeeper.AddressCodec().StringToBytes(forward.FallbackRecipient)
+		if err != nil {
+			k.logger.Error("end block", "error", err)
+			continue
+		}
+		if err := k.bankKeeper.SendCoins(ctx, forward.GetAddress(), fallbackRecipient, coins); err != nil {
+			k.logger.Error(
+				"unable to forward other denoms to fallback recipient",
+				"address", forward.Address,
+				"fallback_recipient", forward.FallbackRecipient,
+				"coins", coins.String(),
+				"err", err,
+			)
+			if err := k.SetFailedForward(ctx, forward.Address, err); err != nil {
 				k.logger.Error("end block", "error", err)
 			}
-			if err := k.IncrementTotalTransferred(ctx, transfer.DestinationDomain, balance.Amount); err != nil {
+			continue
+		}
+		if err := k.RemoveFailedForward(ctx, forward.Address); err != nil {
+			k.logger.Error("end block", "error", err)
+		}
+
+		for _, coin := range coins {
+			if err := k.AddTransferRecord(ctx, forward.Address, coin, 0, types.TransferOutcomeFallback, nil); err != nil {
 				k.logger.Error("end block", "error", err)
 			}
 		}
+		if err := k.eventService.EventManager(ctx).Emit(ctx, &types.OtherDenomsForwarded{
+			Address:  forward.Address,
+			Receiver: forward.FallbackRecipient,
+			Coins:    coins,
+		}); err != nil {
+			k.logger.Error("end block", "error", err)
+		}
+	}
+}
+
+// PruneStatsHistory is an end block hook that removes the buckets of the statistics history
+// which ended before the retention period.
+func (k *Keeper) PruneStatsHistory(ctx context.Context) {
+	params := k.GetParams(ctx)
+	if params.StatsHistoryRetention <= 0 {
+		return
+	}
+
+	cutoff := sdk.UnwrapSDKContext(ctx).BlockTime().Add(-params.StatsHistoryRetention - params.StatsBucketDuration)
+
+	// The buckets are keyed by destination domain first, so the expired buckets are removed
+	// for each destination domain found in the history, skipping to the next one.
+	rng := new(collections.Range[collections.Pair[uint32, time.Time]])
+	for {
+		var found bool
+		var destinationDomain uint32
+		if err := k.StatsHistory.Walk(ctx, rng, func(key collections.Pair[uint32, time.Time], _ types.StatsBucket) (bool, error) {
+			found, destinationDomain = true, key.K1()
+			return true, nil
+		}); err != nil {
+			k.logger.Error("end block", "error", err)
+			return
+		}
+		if !found {
+			return
+		}
+
+		if err := k.StatsHistory.Clear(ctx, collections.NewPrefixedPairRange[uint32, time.Time](destinationDomain).EndExclusive(cutoff)); err != nil {
+			k.logger.Error("end block", "error", err)
+			return
+		}
+		if destinationDomain == ^uint32(0) {
+			return
+		}
+		rng = new(collections.Range[collections.Pair[uint32, time.Time]]).StartInclusive(collections.PairPrefix[uint32, time.Time](destinationDomain + 1))
+	}
+}
+
+// PruneExpiredAccounts is an end block hook that deregisters the AutoCCTP accounts which
+// reached their expiration, sending their remaining balances to the fallback recipient.
+//
+// At most max_pruned_accounts_per_block accounts are pruned, starting from the ones whose
+// pruning failed before, and the remaining ones are pruned in the following blocks. Every
+// account is pruned in isolation, and a failed pruning is retried with the delays of the
+// failed transfers until it reaches max_transfer_attempts attempts.
+func (k *Keeper) PruneExpiredAccounts(ctx context.Context) {
+	params := k.GetParams(ctx)
+	limit := params.MaxPrunedAccountsPerBlock
+
+	retries, err := k.GetPruneRetries(ctx, limit)
+	if err != nil {
+		k.logger.Error("unable to get prune retries", "err", err)
+	}
+	for _, retry := range retries {
+		if err := k.PruneRetries.Remove(ctx, retry.Key); err != nil {
+			k.logger.Error("end block", "error", err)
+			continue
+		}
+		k.pruneExpiredAccount(ctx, params, retry.Key.K2(), retry.Value)
+	}
+
+	if limit != 0 {
+		if uint64(len(retries)) >= limit {
+			return
+		}
+		limit -= uint64(len(retries))
+	}
+	addresses, err := k.GetExpiredAccounts(ctx, limit)
+	if err != nil {
+		k.logger.Error("unable to get expired accounts", "err", err)
+	}
+	for _, address := range addresses {
+		k.pruneExpiredAccount(ctx, params, address, 0)
+	}
+}
+
+// pruneExpiredAccount deregisters the expired AutoCCTP account in isolation, scheduling a
+// retry if it fails after the given number of failed attempts.
+func (k *Keeper) pruneExpiredAccount(ctx context.Context, params types.Params, address string, attempts uint64) {
+	addressBz, err := k.accountKeeper.AddressCodec().StringToBytes(address)
+	if err != nil {
+		k.logger.Error("end block", "error", err)
+		return
+	}
+	account, ok := k.accountKeeper.GetAccount(ctx, addressBz).(*types.Account)
+	if !ok || account.Deregistered {
+		return
+	}
+
+	if err := k.runIsolated(ctx, params.TransferGasLimit, func(ctx context.Context) error {
+		return k.deregisterAccount(ctx, account, types.ClearingReasonExpiration)
+	}); err != nil {
+		k.logger.Error(
+			"unable to prune expired account",
+			"address", account.Address,
+			"fallback_recipient", account.FallbackRecipient,
+			"err", err,
+		)
+		k.handleFailedPrune(ctx, params, account, attempts+1, err)
+		return
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.AccountExpired{
+		Address:           account.Address,
+		DestinationDomain: account.DestinationDomain,
+	}); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+}
+
+// handleFailedPrune removes the expired AutoCCTP account from the expiration indexes and
+// schedules the retry of its pruning, unless it exhausted the maximum number of attempts.
+func (k *Keeper) handleFailedPrune(ctx context.Context, params types.Params, account *types.Account, attempts uint64, pruneErr error) {
+	if err := k.AccountsByExpirationHeight.Remove(ctx, collections.Join(account.ExpirationHeight, account.Address)); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+	if err := k.AccountsByExpirationTime.Remove(ctx, collections.Join(account.GetExpirationTimeOrZero(), account.Address)); err != nil {
+		k.logger.Error("end block", "error", err)
+	}
+
+	if attempts < params.MaxTransferAttempts {
+		height := sdk.UnwrapSDKContext(ctx).BlockHeight() + int64(params.RetryDelay(attempts))
+		if err := k.PruneRetries.Set(ctx, collections.Join(height, account.Address), attempts); err != nil {
+			k.logger.Error("end block", "error", err)
+		}
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.AccountPruneFailed{
+		Address:           account.Address,
+		DestinationDomain: account.DestinationDomain,
+		Error:             pruneErr.Error(),
+		Attempts:          attempts,
+	}); err != nil {
+		k.logger.Error("end block", "error", err)
 	}
 }
@@ -21,15 +21,26 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"autocctp.dev/keeper"
 	"autocctp.dev/testutil"
 	"autocctp.dev/testutil/mocks"
+	"autocctp.dev/types"
 )
 
 func TestExecuteTransfer(t *testing.T) {
@@ -101,7 +112,7 @@ func TestExecuteTransfer(t *testing.T) {
 	assert.Equal(t, uint64(1), numOfTransfers, "expected a different amount of transfers")
 	totalTransferred, err := k.TotalTransferred.Get(ctx, 0)
 	assert.NoError(t, err)
-	assert.Equal(t, uint64(1_000_000), totalTransferred, "expected a different total transferred")
+	assert.Equal(t, math.NewInt(1_000_000), totalTransferred, "expected a different total transferred")
 
 	// ARRANGE: Trigger an error in the CCTP server execution.
 	mocks.ResetTest(t, ctx, k, m)
@@ -131,3 +142,1656 @@ func TestExecuteTransfer(t *testing.T) {
 	_, err = k.TotalTransferred.Get(ctx, 0)
 	assert.Error(t, err, "expected zero total transferred when cctp returns an error")
 }
+
+func TestExecuteTransfers_FailedTransfers(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	mc := m.CCTPServer.MockCounter
+	m.CCTPServer.Failing = true
+
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	params := k.GetParams(ctx)
+	ctx = ctx.WithBlockHeight(100)
+
+	// ACT: The pending transfer fails.
+	k.ExecuteTransfers(ctx)
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+
+	// ASSERT: The failed transfer is recorded and scheduled for a retry.
+	failedTransfer := k.GetFailedTransfer(ctx, acc.Address)
+	require.NotNil(t, failedTransfer, "expected the failed transfer to be recorded")
+	require.Equal(t, uint64(1), failedTransfer.Attempts, "expected one attempt")
+	require.Equal(t, int64(100), failedTransfer.Height, "expected a different height")
+	require.Equal(t, int64(100+params.RetryBaseDelay), failedTransfer.NextRetryHeight, "expected a different retry height")
+	require.Equal(t, int64(1_000_000), failedTransfer.Amount.Int64(), "expected a different amount")
+	require.NotEmpty(t, failedTransfer.Error, "expected the error to be recorded")
+
+	// ACT: The transfer is not retried before the scheduled height.
+	k.ExecuteTransfers(ctx.WithBlockHeight(failedTransfer.NextRetryHeight - 1))
+
+	// ASSERT
+	require.Equal(t, uint64(1), k.GetFailedTransfer(ctx, acc.Address).Attempts, "expected no retry before the scheduled height")
+
+	// ACT: The transfer is retried at the scheduled height and fails again.
+	ctx = ctx.WithBlockHeight(failedTransfer.NextRetryHeight)
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The delay is doubled.
+	failedTransfer = k.GetFailedTransfer(ctx, acc.Address)
+	require.Equal(t, uint64(2), failedTransfer.Attempts, "expected two attempts")
+	require.Equal(t, ctx.BlockHeight()+int64(2*params.RetryBaseDelay), failedTransfer.NextRetryHeight, "expected the delay to be doubled")
+	require.Equal(t, uint64(2), k.GetOutcomeStats(ctx, acc.DestinationDomain).FailedTransfers, "expected every failed attempt to be counted")
+
+	// ACT: The transfer is retried and succeeds.
+	m.CCTPServer.Failing = false
+	ctx = ctx.WithBlockHeight(failedTransfer.NextRetryHeight)
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The failed transfer is removed.
+	require.Equal(t, 1, mc.NumDepositForBurn, "expected one call to the DepositForBurn endpoint")
+	require.Nil(t, k.GetFailedTransfer(ctx, acc.Address), "expected the failed transfer to be removed")
+	numOfTransfers, err := k.NumOfTransfers.Get(ctx, acc.DestinationDomain)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), numOfTransfers, "expected a different amount of transfers")
+}
+
+func TestExecuteTransfers_MaxTransferAttempts(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	m.CCTPServer.Failing = true
+
+	params := k.GetParams(ctx)
+	params.MaxTransferAttempts = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+	failedTransfer := k.GetFailedTransfer(ctx, acc.Address)
+	require.NotNil(t, failedTransfer, "expected the failed transfer to be recorded")
+	k.ExecuteTransfers(ctx.WithBlockHeight(failedTransfer.NextRetryHeight))
+
+	// ASSERT: No automatic retry once the attempts are exhausted.
+	require.Equal(t, uint64(1), k.GetFailedTransfer(ctx, acc.Address).Attempts, "expected no retry after max attempts")
+	retries, err := k.GetRetryableTransfers(ctx.WithBlockHeight(failedTransfer.NextRetryHeight))
+	require.NoError(t, err, "expected no error getting the retryable transfers")
+	require.Empty(t, retries, "expected the exhausted transfer not to be scheduled")
+	var exhausted int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "noble.autocctp.v1.TransferRetriesExhausted" {
+			exhausted++
+		}
+	}
+	require.Equal(t, 1, exhausted, "expected a transfer retries exhausted event")
+
+	// ARRANGE: The funds are cleared in a different way.
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins()
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT
+	require.Nil(t, k.GetFailedTransfer(ctx, acc.Address), "expected the failed transfer to be removed without balance")
+}
+
+func TestSweepFailedTransfers(t *testing.T) {
+	testCases := []struct {
+		name           string
+		policy         *types.FallbackPolicy
+		attempts       uint64
+		blocksElapsed  int64
+		timeElapsed    time.Duration
+		expectedReason types.ClearingReason
+	}{
+		{
+			name:           "no clearing without a fallback policy",
+			policy:         nil,
+			attempts:       100,
+			expectedReason: types.ClearingReasonUnspecified,
+		},
+		{
+			name:           "no clearing when the policy conditions are not met",
+			policy:         &types.FallbackPolicy{MaxAttempts: 3, TimeoutBlocks: 100, Timeout: time.Hour},
+			attempts:       2,
+			blocksElapsed:  99,
+			timeElapsed:    time.Hour - time.Second,
+			expectedReason: types.ClearingReasonUnspecified,
+		},
+		{
+			name:           "clearing when the max attempts are reached",
+			policy:         &types.FallbackPolicy{MaxAttempts: 3},
+			attempts:       3,
+			expectedReason: types.ClearingReasonMaxAttempts,
+		},
+		{
+			name:           "clearing when the blocks timeout is reached",
+			policy:         &types.FallbackPolicy{TimeoutBlocks: 100},
+			attempts:       1,
+			blocksElapsed:  100,
+			expectedReason: types.ClearingReasonTimeoutBlocks,
+		},
+		{
+			name:           "clearing when the time timeout is reached",
+			policy:         &types.FallbackPolicy{Timeout: time.Hour},
+			attempts:       1,
+			timeElapsed:    time.Hour,
+			expectedReason: types.ClearingReasonTimeout,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			// ARRANGE
+			m, k, ctx := mocks.AutoCCTPKeeper(t)
+			start := time.Unix(1_700_000_000, 0).UTC()
+			ctx = ctx.WithBlockHeight(10).WithBlockTime(start)
+
+			acc := testutil.AutoCCTPAccount(false)
+			acc.FallbackPolicy = tC.policy
+			m.AccountKeeper.Accounts[acc.Address] = &acc
+			m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+
+			for range tC.attempts {
+				require.NoError(t, k.SetFailedTransfer(ctx, acc.Address, math.NewInt(1_000_000), errors.New("error")))
+			}
+			ctx = ctx.WithBlockHeight(10 + tC.blocksElapsed).WithBlockTime(start.Add(tC.timeElapsed))
+
+			// ACT
+			k.SweepFailedTransfers(ctx)
+
+			// ASSERT
+			fallbackBalance := m.BankKeeper.Balances[acc.FallbackRecipient].AmountOf("uusdc")
+			if tC.expectedReason == types.ClearingReasonUnspecified {
+				require.True(t, fallbackBalance.IsZero(), "expected no funds sent to the fallback recipient")
+				require.NotNil(t, k.GetFailedTransfer(ctx, acc.Address), "expected the failed transfer to be kept")
+				return
+			}
+
+			require.Equal(t, int64(1_000_000), fallbackBalance.Int64(), "expected the funds to be sent to the fallback recipient")
+			require.Nil(t, k.GetFailedTransfer(ctx, acc.Address), "expected the failed transfer to be removed")
+
+			events := ctx.EventManager().Events()
+			require.NotEmpty(t, events, "expected the account cleared event")
+			reason, found := events[len(events)-1].GetAttribute("reason")
+			require.True(t, found, "expected the reason attribute")
+			require.Contains(t, reason.Value, tC.expectedReason.String(), "expected a different clearing reason")
+		})
+	}
+}
+
+func TestExecuteTransfers_AwaitingTransfers(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.SetPaused(ctx, true, acc.DestinationDomain))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The deferred transfer is awaiting.
+	has, err := k.AwaitingTransfers.Has(ctx, acc.Address)
+	require.NoError(t, err)
+	require.True(t, has, "expected the deferred transfer to be awaiting")
+
+	// ARRANGE
+	require.NoError(t, k.SetPaused(ctx, false, acc.DestinationDomain))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The executed transfer is no longer awaiting.
+	has, err = k.AwaitingTransfers.Has(ctx, acc.Address)
+	require.NoError(t, err)
+	require.False(t, has, "expected the executed transfer to not be awaiting")
+}
+
+// panickingClearHooks panics after an account is cleared to the fallback recipient.
+type panickingClearHooks struct {
+	*mocks.AutoCCTPHooks
+}
+
+func (panickingClearHooks) AfterAccountCleared(context.Context, types.Account, sdk.Coins, types.ClearingReason) error {
+	panic("account cleared panicked")
+}
+
+func TestSweepFailedTransfers_Isolation(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	k.SetHooks(panickingClearHooks{AutoCCTPHooks: mocks.NewAutoCCTPHooks()})
+
+	acc := testutil.AutoCCTPAccount(false)
+	acc.FallbackPolicy = &types.FallbackPolicy{MaxAttempts: 1}
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.SetFailedTransfer(ctx, acc.Address, math.NewInt(1_000_000), errors.New("error")))
+
+	// ACT
+	require.NotPanics(t, func() { k.SweepFailedTransfers(ctx) }, "expected the panic to be recovered")
+
+	// ASSERT: The writes and events of the failed clearing are discarded.
+	require.NotNil(t, k.GetFailedTransfer(ctx, acc.Address), "expected the failed transfer to be kept")
+	require.Empty(t, ctx.EventManager().Events(), "expected no account cleared event")
+}
+
+func TestSweepFailedTransfers_BatchSize(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	params := k.GetParams(ctx)
+	params.FallbackSweepBatchSize = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for range 3 {
+		acc := testutil.AutoCCTPAccount(false)
+		acc.FallbackPolicy = &types.FallbackPolicy{MaxAttempts: 1}
+		m.AccountKeeper.Accounts[acc.Address] = &acc
+		m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+		require.NoError(t, k.SetFailedTransfer(ctx, acc.Address, math.NewInt(1_000_000), errors.New("error")))
+	}
+
+	// ACT
+	k.SweepFailedTransfers(ctx)
+
+	// ASSERT: Only the failed transfers of the batch are checked.
+	failedTransfers, err := k.GetFailedTransfers(ctx)
+	require.NoError(t, err)
+	require.Len(t, failedTransfers, 1, "expected only the batch to be cleared")
+	cursor, err := k.FallbackSweepCursor.Get(ctx)
+	require.NoError(t, err, "expected the sweep cursor to be set")
+	require.Less(t, cursor, failedTransfers[0].Address, "expected the sweep to resume before the remaining failed transfer")
+
+	// ACT
+	k.SweepFailedTransfers(ctx)
+
+	// ASSERT: The sweep resumes from the cursor and wraps around.
+	failedTransfers, err = k.GetFailedTransfers(ctx)
+	require.NoError(t, err)
+	require.Empty(t, failedTransfers, "expected the remaining failed transfer to be cleared")
+	has, err := k.FallbackSweepCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has, "expected the sweep cursor to be reset")
+}
+
+func TestExecuteTransfers_SplitOversizedTransfers(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	mc := m.CCTPServer.MockCounter
+	m.CCTPServer.MaxTransferAmount = 1_000_000
+
+	params := k.GetParams(ctx)
+	params.SplitOversizedTransfers = true
+	require.NoError(t, k.SetParams(ctx, params))
+
+	acc := testutil.AutoCCTPAccount(true)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 2_500_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The balance is transferred in three chunks.
+	require.Equal(t, 3, mc.NumDepositForBurnWithCaller, "expected one call per chunk")
+	numOfTransfers, err := k.NumOfTransfers.Get(ctx, acc.DestinationDomain)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), numOfTransfers, "expected one transfer per chunk")
+	totalTransferred, err := k.TotalTransferred.Get(ctx, acc.DestinationDomain)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(2_500_000), totalTransferred, "expected the whole balance to be transferred")
+	require.Nil(t, k.GetFailedTransfer(ctx, acc.Address), "expected no failed transfer")
+
+	// ARRANGE: The second chunk fails.
+	mocks.ResetTest(t, ctx, k, m)
+	require.NoError(t, k.SetParams(ctx, params))
+	m.CCTPServer.FailAfter = 1
+
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 2_500_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The remaining amount is recorded as failed.
+	require.Equal(t, 1, mc.NumDepositForBurnWithCaller, "expected one successful chunk")
+	totalTransferred, err = k.TotalTransferred.Get(ctx, acc.DestinationDomain)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1_000_000), totalTransferred, "expected only the first chunk to be transferred")
+	failedTransfer := k.GetFailedTransfer(ctx, acc.Address)
+	require.NotNil(t, failedTransfer, "expected the failed transfer to be recorded")
+	require.Equal(t, int64(1_500_000), failedTransfer.Amount.Int64(), "expected the remaining amount to be recorded")
+}
+
+func TestExecuteTransfers_Events(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+
+	acc := testutil.AutoCCTPAccount(true)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1, "expected one event")
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err, "expected a typed event")
+	executed, ok := event.(*types.TransferExecuted)
+	require.True(t, ok, "expected a transfer executed event")
+	require.Equal(t, types.TransferExecuted{
+		Address:           acc.Address,
+		DestinationDomain: acc.DestinationDomain,
+		MintRecipient:     acc.MintRecipient,
+		DestinationCaller: acc.DestinationCaller,
+		Amount:            math.NewInt(1_000_000),
+		Denom:             "uusdc",
+		Nonce:             1,
+	}, *executed, "expected a different event")
+
+	// ARRANGE
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	m.CCTPServer.Failing = true
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT
+	events = ctx.EventManager().Events()
+	require.Len(t, events, 1, "expected one event")
+	event, err = sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err, "expected a typed event")
+	failed, ok := event.(*types.TransferFailed)
+	require.True(t, ok, "expected a transfer failed event")
+	require.Equal(t, acc.Address, failed.Address, "expected a different address")
+	require.Equal(t, int64(1_000_000), failed.Amount.Int64(), "expected a different amount")
+	require.Equal(t, "uusdc", failed.Denom, "expected a different denom")
+	require.Equal(t, "error calling deposit for burn with caller api", failed.Error, "expected a different error")
+}
+
+func TestExecuteTransfers_Order(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+
+	accounts := make([]types.Account, 3)
+	for i := range accounts {
+		accounts[i] = testutil.AutoCCTPAccount(false)
+	}
+	// Requests in reverse lexicographic order of the addresses.
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Address > accounts[j].Address })
+	for i := range accounts {
+		m.AccountKeeper.Accounts[accounts[i].Address] = &accounts[i]
+		m.BankKeeper.Balances[accounts[i].Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+		require.NoError(t, k.AddPendingTransfer(ctx, accounts[i]))
+	}
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The transfers are executed in the order of the requests.
+	events := ctx.EventManager().Events()
+	require.Len(t, events, len(accounts), "expected one event per transfer")
+	for i, e := range events {
+		event, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(t, err, "expected a typed event")
+		executed, ok := event.(*types.TransferExecuted)
+		require.True(t, ok, "expected a transfer executed event")
+		require.Equal(t, accounts[i].Address, executed.Address, "expected a different execution order")
+		require.Equal(t, uint64(i), executed.Sequence, "expected a different sequence")
+	}
+}
+
+func TestExecuteTransfers_Paused(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	mc := m.CCTPServer.MockCounter
+	server := keeper.NewMsgServer(k)
+
+	ethAcc := testutil.AutoCCTPAccount(false)
+	ethAcc.DestinationDomain = uint32(types.ETHEREUM)
+	baseAcc := testutil.AutoCCTPAccount(false)
+	baseAcc.DestinationDomain = uint32(types.BASE)
+	for _, acc := range []*types.Account{&ethAcc, &baseAcc} {
+		m.AccountKeeper.Accounts[acc.Address] = acc
+		m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+		require.NoError(t, k.SetAccountIndexes(ctx, acc))
+		require.NoError(t, k.AddPendingTransfer(ctx, *acc))
+	}
+
+	_, err := server.SetPaused(ctx, &types.MsgSetPaused{Authority: mocks.Authority, Paused: true})
+	require.NoError(t, err)
+	_, err = server.SetPaused(ctx, &types.MsgSetPaused{
+		Authority:          mocks.Authority,
+		Paused:             true,
+		DestinationDomains: []uint32{uint32(types.BASE)},
+	})
+	require.NoError(t, err)
+
+	// ACT: Transfers are deferred while globally paused.
+	k.ExecuteTransfers(ctx)
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+
+	// ASSERT
+	require.Equal(t, 0, mc.NumDepositForBurn, "expected no transfers while paused")
+
+	// ACT: Unpause globally, while Base remains paused. The deferred transfers are resumed
+	// at the beginning of the next block.
+	_, err = server.SetPaused(ctx, &types.MsgSetPaused{Authority: mocks.Authority, Paused: false})
+	require.NoError(t, err)
+	k.SweepAccounts(ctx)
+	k.ExecuteTransfers(ctx)
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+	m.BankKeeper.Balances[ethAcc.Address] = sdk.NewCoins()
+
+	// ASSERT: Only the deferred transfer to Ethereum is executed.
+	require.Equal(t, 1, mc.NumDepositForBurn, "expected only the ethereum transfer to be executed")
+
+	// ACT: Unpause Base.
+	_, err = server.SetPaused(ctx, &types.MsgSetPaused{
+		Authority:          mocks.Authority,
+		Paused:             false,
+		DestinationDomains: []uint32{uint32(types.BASE)},
+	})
+	require.NoError(t, err)
+	k.SweepAccounts(ctx)
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: Only the deferred transfer to Base is executed.
+	require.Equal(t, 2, mc.NumDepositForBurn, "expected the base transfer to be executed")
+}
+
+func TestExecuteTransfers_PausedFailedTransfer(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	mc := m.CCTPServer.MockCounter
+	server := keeper.NewMsgServer(k)
+
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	ctx = ctx.WithBlockHeight(10)
+	failedTransfer := types.NewFailedTransfer(acc.Address, math.NewInt(1_000_000), errors.New("error"), 10, 1, 10)
+	require.NoError(t, k.StoreFailedTransfer(ctx, failedTransfer))
+	_, err := server.SetPaused(ctx, &types.MsgSetPaused{
+		Authority:          mocks.Authority,
+		Paused:             true,
+		DestinationDomains: []uint32{acc.DestinationDomain},
+	})
+	require.NoError(t, err)
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The retry is postponed without counting an attempt, so that it is not due
+	// again at the next block.
+	require.Equal(t, 0, mc.NumDepositForBurn, "expected no transfers while the domain is paused")
+	deferred, err := k.FailedTransfers.Get(ctx, acc.Address)
+	require.NoError(t, err, "expected the failed transfer to be kept")
+	require.Equal(t, uint64(1), deferred.Attempts, "expected no attempt to be counted")
+	require.Greater(t, deferred.NextRetryHeight, int64(10), "expected the retry to be postponed")
+	retries, err := k.GetRetryableTransfers(ctx.WithBlockHeight(11))
+	require.NoError(t, err)
+	require.Empty(t, retries, "expected no retryable transfers at the next block")
+}
+
+func TestExecuteTransfers_TokenPaused(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	mc := m.CCTPServer.MockCounter
+
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.SetAccountIndexes(ctx, &acc))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+	m.FTFKeeper.Paused = true
+
+	// ACT: Transfers are deferred while the token is paused.
+	k.ExecuteTransfers(ctx)
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+
+	// ASSERT
+	require.Equal(t, 0, mc.NumDepositForBurn, "expected no transfers while the token is paused")
+	_, err := k.FailedTransfers.Get(ctx, acc.Address)
+	require.Error(t, err, "expected the deferred transfer to not be recorded as failed")
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1, "expected one event")
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err, "expected a typed event")
+	blocked, ok := event.(*types.TransferBlocked)
+	require.True(t, ok, "expected a transfer blocked event")
+	require.Equal(t, types.TransferBlocked{
+		Address:           acc.Address,
+		DestinationDomain: acc.DestinationDomain,
+		Amount:            math.NewInt(1_000_000),
+		Denom:             "uusdc",
+		Reason:            types.ComplianceReasonTokenPaused,
+	}, *blocked, "expected a different event")
+
+	// ACT: Unpause the token, the deferred transfer is resumed in the next block.
+	m.FTFKeeper.Paused = false
+	k.ExecuteTransfers(ctx)
+	require.Equal(t, 0, mc.NumDepositForBurn, "expected the deferred transfer to wait for the next block")
+	k.SweepAccounts(ctx)
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The deferred transfer is executed.
+	require.Equal(t, 1, mc.NumDepositForBurn, "expected the deferred transfer to be executed")
+}
+
+func TestExecuteTransfers_Blacklisted(t *testing.T) {
+	tc := []struct {
+		name        string
+		blacklisted func(types.Account) string
+		reason      types.ComplianceReason
+	}{
+		{
+			name:        "blacklisted account",
+			blacklisted: func(acc types.Account) string { return acc.Address },
+			reason:      types.ComplianceReasonAccountBlacklisted,
+		},
+		{
+			name:        "blacklisted fallback recipient",
+			blacklisted: func(acc types.Account) string { return acc.FallbackRecipient },
+			reason:      types.ComplianceReasonFallbackBlacklisted,
+		},
+	}
+
+	for _, c := range tc {
+		t.Run(c.name, func(t *testing.T) {
+			// ARRANGE
+			m, k, ctx := mocks.AutoCCTPKeeper(t)
+			mc := m.CCTPServer.MockCounter
+
+			acc := testutil.AutoCCTPAccount(false)
+			m.AccountKeeper.Accounts[acc.Address] = &acc
+			m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+			require.NoError(t, k.AddPendingTransfer(ctx, acc))
+			ctx = ctx.WithBlockHeight(10)
+			failedTransfer := types.NewFailedTransfer(acc.Address, math.NewInt(1_000_000), errors.New("error"), 10, 1, 10)
+			require.NoError(t, k.StoreFailedTransfer(ctx, failedTransfer))
+			m.FTFKeeper.Blacklisted[c.blacklisted(acc)] = true
+
+			// ACT
+			k.ExecuteTransfers(ctx)
+
+			// ASSERT: The refused transfer keeps its failed transfer and its retry is postponed
+			// without counting an attempt.
+			require.Equal(t, 0, mc.NumDepositForBurn, "expected no transfers")
+			deferred, err := k.FailedTransfers.Get(ctx, acc.Address)
+			require.NoError(t, err, "expected the refused transfer to be retried")
+			require.Equal(t, uint64(1), deferred.Attempts, "expected no attempt to be counted")
+			require.Greater(t, deferred.NextRetryHeight, int64(10), "expected the retry to be postponed")
+			has, err := k.AwaitingTransfers.Has(ctx, acc.Address)
+			require.NoError(t, err)
+			require.True(t, has, "expected the refused transfer to be awaiting")
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1, "expected one event")
+			event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+			require.NoError(t, err, "expected a typed event")
+			blocked, ok := event.(*types.TransferBlocked)
+			require.True(t, ok, "expected a transfer blocked event")
+			require.Equal(t, c.reason, blocked.Reason, "expected a different reason")
+		})
+	}
+}
+
+func TestExecuteTransfers_RateLimit(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	mc := m.CCTPServer.MockCounter
+	ctx = ctx.WithBlockHeight(1)
+
+	domain := types.NewDomainConfig(types.ETHEREUM, "Ethereum", types.AddressEncodingHex)
+	domain.RateLimit = &types.RateLimit{Window: 10, MaxTransfers: 1, MaxAmount: math.ZeroInt()}
+	require.NoError(t, k.SetDomain(ctx, domain))
+
+	accounts := make([]types.Account, 2)
+	for i := range accounts {
+		accounts[i] = testutil.AutoCCTPAccount(false)
+		accounts[i].DestinationDomain = uint32(types.ETHEREUM)
+		m.AccountKeeper.Accounts[accounts[i].Address] = &accounts[i]
+		m.BankKeeper.Balances[accounts[i].Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+		require.NoError(t, k.AddPendingTransfer(ctx, accounts[i]))
+	}
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+
+	// ASSERT: The transfer exceeding the cap is deferred, not failed.
+	require.Equal(t, 1, mc.NumDepositForBurn, "expected only one transfer to be executed")
+	deferred, err := k.GetRateLimitedAddresses(ctx)
+	require.NoError(t, err)
+	require.Len(t, deferred, 1, "expected one deferred transfer")
+	failedTransfers, err := k.GetFailedTransfers(ctx)
+	require.NoError(t, err)
+	require.Empty(t, failedTransfers, "expected no failed transfers")
+	var rateLimited int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "noble.autocctp.v1.TransferRateLimited" {
+			rateLimited++
+		}
+	}
+	require.Equal(t, 1, rateLimited, "expected a transfer rate limited event")
+
+	// ACT: The window still contains the executed transfer.
+	k.ExecuteTransfers(ctx.WithBlockHeight(10))
+
+	// ASSERT: The transfer is deferred again, from the transfer queue, without a new event.
+	require.Equal(t, 1, mc.NumDepositForBurn, "expected the transfer to be deferred again")
+	require.Len(t, queuedAddresses(t, k, ctx), 1, "expected the deferred transfer to be queued")
+	rateLimited = 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "noble.autocctp.v1.TransferRateLimited" {
+			rateLimited++
+		}
+	}
+	require.Equal(t, 1, rateLimited, "expected no new event for the transfer deferred again")
+	capacity, err := k.GetRateLimitCapacity(ctx.WithBlockHeight(10), uint32(types.ETHEREUM))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), capacity.RemainingTransfers, "expected no capacity left")
+
+	// ACT: The executed transfer is out of the window.
+	k.ExecuteTransfers(ctx.WithBlockHeight(11))
+
+	// ASSERT
+	require.Equal(t, 2, mc.NumDepositForBurn, "expected the deferred transfer to be executed")
+	deferred, err = k.GetRateLimitedAddresses(ctx)
+	require.NoError(t, err)
+	require.Empty(t, deferred, "expected no deferred transfers")
+	require.Empty(t, queuedAddresses(t, k, ctx), "expected no queued transfers")
+}
+
+func TestExecuteTransfers_RateLimitBudget(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	mc := m.CCTPServer.MockCounter
+	ctx = ctx.WithBlockHeight(1)
+
+	domain := types.NewDomainConfig(types.ETHEREUM, "Ethereum", types.AddressEncodingHex)
+	domain.RateLimit = &types.RateLimit{Window: 10, MaxTransfers: 1, MaxAmount: math.ZeroInt()}
+	require.NoError(t, k.SetDomain(ctx, domain))
+	params := k.GetParams(ctx)
+	params.MaxTransfersPerBlock = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	accounts := make([]types.Account, 4)
+	for i := range accounts {
+		accounts[i] = testutil.AutoCCTPAccount(false)
+		accounts[i].DestinationDomain = uint32(types.ETHEREUM)
+		m.AccountKeeper.Accounts[accounts[i].Address] = &accounts[i]
+		m.BankKeeper.Balances[accounts[i].Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+		require.NoError(t, k.AddPendingTransfer(ctx, accounts[i]))
+	}
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+
+	// ASSERT: One transfer is executed and the others are deferred to the transfer queue.
+	require.Equal(t, 1, mc.NumDepositForBurn, "expected only one transfer to be executed")
+	require.Len(t, queuedAddresses(t, k, ctx), 3, "expected the deferred transfers to be queued")
+
+	// ACT: The window still contains the executed transfer.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.ExecuteTransfers(ctx.WithBlockHeight(2))
+
+	// ASSERT: Only the maximum number of transfers per block is evaluated again, and moved to
+	// the end of the queue without a new event.
+	require.Equal(t, 1, mc.NumDepositForBurn, "expected the transfers to be deferred again")
+	require.Equal(t, []string{accounts[3].Address, accounts[1].Address, accounts[2].Address}, queuedAddresses(t, k, ctx), "expected the evaluated transfers to be moved to the end of the queue")
+	require.Empty(t, ctx.EventManager().Events(), "expected no new events")
+}
+
+func TestExecuteTransfers_RateLimitCarryOver(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	mc := m.CCTPServer.MockCounter
+	ctx = ctx.WithBlockHeight(1)
+	feeRecipient := testutil.NobleAddress()
+
+	domain := types.NewDomainConfig(types.ETHEREUM, "Ethereum", types.AddressEncodingHex)
+	domain.RateLimit = &types.RateLimit{Window: 10, MaxAmount: math.NewInt(600_000)}
+	require.NoError(t, k.SetDomain(ctx, domain))
+	params := k.GetParams(ctx)
+	params.FeeRecipient = feeRecipient
+	params.TransferFees = []types.TransferFee{
+		{DestinationDomain: uint32(types.ETHEREUM), FlatAmount: math.NewInt(100)},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	acc := testutil.AutoCCTPAccount(false)
+	acc.DestinationDomain = uint32(types.ETHEREUM)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+
+	// ASSERT: The capacity left is transferred and the remaining amount is carried over.
+	require.Equal(t, 1, mc.NumDepositForBurn, "expected the capacity left to be transferred")
+	totalTransferred, err := k.TotalTransferred.Get(ctx, acc.DestinationDomain)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(600_000), totalTransferred, "expected the transfer to be capped")
+	require.Nil(t, k.GetFailedTransfer(ctx, acc.Address), "expected the transfer to not be recorded as failed")
+	require.True(t, m.BankKeeper.Balances[feeRecipient].IsZero(), "expected the fee to not be collected yet")
+	deferred, err := k.GetRateLimitedAddresses(ctx)
+	require.NoError(t, err)
+	require.Len(t, deferred, 1, "expected the remaining amount to be deferred")
+	var rateLimited *types.TransferRateLimited
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "noble.autocctp.v1.TransferRateLimited" {
+			typed, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err, "expected a typed event")
+			rateLimited = typed.(*types.TransferRateLimited)
+		}
+	}
+	require.NotNil(t, rateLimited, "expected a transfer rate limited event")
+	require.Equal(t, math.NewInt(399_900), rateLimited.Amount, "expected the amount after the fee to be carried over")
+
+	// ACT: The burned amount leaves the account and the window moves past the transfer.
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 400_000))
+	k.ExecuteTransfers(ctx.WithBlockHeight(11))
+
+	// ASSERT: The remaining amount is transferred and the fee is collected once.
+	require.Equal(t, 2, mc.NumDepositForBurn, "expected the remaining amount to be transferred")
+	totalTransferred, err = k.TotalTransferred.Get(ctx, acc.DestinationDomain)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(999_900), totalTransferred, "expected the whole amount after the fee to be transferred")
+	require.Equal(t, int64(100), m.BankKeeper.Balances[feeRecipient].AmountOf("uusdc").Int64(), "expected the fee to be collected once")
+	deferred, err = k.GetRateLimitedAddresses(ctx)
+	require.NoError(t, err)
+	require.Empty(t, deferred, "expected no deferred transfers")
+}
+
+func TestExecuteTransfers_MaxTransfersPerBlock(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	mc := m.CCTPServer.MockCounter
+
+	params := k.GetParams(ctx)
+	params.MaxTransfersPerBlock = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	accounts := make([]types.Account, 3)
+	for i := range accounts {
+		accounts[i] = testutil.AutoCCTPAccount(false)
+		m.AccountKeeper.Accounts[accounts[i].Address] = &accounts[i]
+		m.BankKeeper.Balances[accounts[i].Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+		require.NoError(t, k.AddPendingTransfer(ctx, accounts[i]))
+	}
+
+	// ACT
+	k.ExecuteTransfers(ctx.WithBlockHeight(1))
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+
+	// ASSERT: The transfers exceeding the limit are queued.
+	require.Equal(t, 1, mc.NumDepositForBurn, "expected only one transfer to be executed")
+	queued := queuedAddresses(t, k, ctx)
+	require.Len(t, queued, 2, "expected two queued transfers")
+
+	// ARRANGE: A new transfer is requested while the queue is not empty.
+	late := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[late.Address] = &late
+	m.BankKeeper.Balances[late.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, late))
+
+	// ACT
+	k.ExecuteTransfers(ctx.WithBlockHeight(2))
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+
+	// ASSERT: The queued transfers are executed first.
+	require.Equal(t, 2, mc.NumDepositForBurn, "expected the first queued transfer to be executed")
+	require.Equal(t, []string{queued[1], late.Address}, queuedAddresses(t, k, ctx), "expected the new transfer at the end of the queue")
+
+	// ACT
+	k.ExecuteTransfers(ctx.WithBlockHeight(3))
+	k.ExecuteTransfers(ctx.WithBlockHeight(4))
+
+	// ASSERT
+	require.Equal(t, 4, mc.NumDepositForBurn, "expected all the transfers to be executed")
+	require.Empty(t, queuedAddresses(t, k, ctx), "expected an empty queue")
+}
+
+func TestExecuteTransfers_MaxTransfersPerBlockCountsBurns(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	mc := m.CCTPServer.MockCounter
+	m.CCTPServer.MaxTransferAmount = 1_000_000
+
+	params := k.GetParams(ctx)
+	params.MaxTransfersPerBlock = 2
+	params.SplitOversizedTransfers = true
+	require.NoError(t, k.SetParams(ctx, params))
+
+	accounts := make([]types.Account, 3)
+	balances := []int64{0, 2_500_000, 1_000_000}
+	for i := range accounts {
+		accounts[i] = testutil.AutoCCTPAccount(false)
+		m.AccountKeeper.Accounts[accounts[i].Address] = &accounts[i]
+		m.BankKeeper.Balances[accounts[i].Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", balances[i]))
+		require.NoError(t, k.AddPendingTransfer(ctx, accounts[i]))
+	}
+
+	// ACT
+	k.ExecuteTransfers(ctx.WithBlockHeight(1))
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+
+	// ASSERT: The empty account does not count, and the remaining chunk is queued.
+	require.Equal(t, 2, mc.NumDepositForBurn, "expected the maximum number of burns to be executed")
+	require.Equal(t, []string{accounts[1].Address, accounts[2].Address}, queuedAddresses(t, k, ctx), "expected the remaining transfers to be queued")
+	require.Nil(t, k.GetFailedTransfer(ctx, accounts[1].Address), "expected the queued chunk to not be recorded as failed")
+
+	// ACT: The burned chunks leave the account.
+	m.BankKeeper.Balances[accounts[1].Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 500_000))
+	k.ExecuteTransfers(ctx.WithBlockHeight(2))
+
+	// ASSERT
+	require.Equal(t, 4, mc.NumDepositForBurn, "expected the queued transfers to be executed")
+	require.Empty(t, queuedAddresses(t, k, ctx), "expected an empty queue")
+}
+
+// queuedAddresses returns the addresses of the queued transfers, in order.
+func queuedAddresses(t *testing.T, k *keeper.Keeper, ctx sdk.Context) []string {
+	t.Helper()
+
+	addresses := []string{}
+	err := k.TransferQueue.Walk(ctx, nil, func(_ uint64, queuedTransfer types.QueuedTransfer) (bool, error) {
+		addresses = append(addresses, queuedTransfer.Address)
+		return false, nil
+	})
+	require.NoError(t, err, "expected no error walking the transfer queue")
+
+	return addresses
+}
+
+func TestExecuteTransfers_TransferFees(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	feeRecipient := testutil.NobleAddress()
+
+	params := k.GetParams(ctx)
+	params.FeeRecipient = feeRecipient
+	params.TransferFees = []types.TransferFee{
+		{DestinationDomain: uint32(types.ETHEREUM), FlatAmount: math.NewInt(100), BasisPoints: 10, MaxAmount: math.NewInt(500)},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	acc := testutil.AutoCCTPAccount(false)
+	acc.DestinationDomain = uint32(types.ETHEREUM)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The fee is capped at the max amount and the remaining balance is transferred.
+	require.Equal(t, int64(500), m.BankKeeper.Balances[feeRecipient].AmountOf("uusdc").Int64(), "expected the fee to be sent to the fee recipient")
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2, "expected two events")
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err, "expected a typed event")
+	require.Equal(t, int64(999_500), event.(*types.TransferExecuted).Amount.Int64(), "expected the fee to be deducted")
+	event, err = sdk.ParseTypedEvent(abci.Event(events[1]))
+	require.NoError(t, err, "expected a typed event")
+	require.Equal(t, &types.TransferFeeCollected{
+		Address:           acc.Address,
+		DestinationDomain: acc.DestinationDomain,
+		Recipient:         feeRecipient,
+		Amount:            math.NewInt(500),
+		Denom:             "uusdc",
+	}, event, "expected a different fee event")
+
+	totalFees, err := k.TotalFees.Get(ctx, acc.DestinationDomain)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(500), totalFees, "expected a different total fees")
+	totalTransferred, err := k.TotalTransferred.Get(ctx, acc.DestinationDomain)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(999_500), totalTransferred, "expected a different total transferred")
+
+	// ARRANGE: The fee is sent to the module account if the fee recipient is not set.
+	params.FeeRecipient = ""
+	require.NoError(t, k.SetParams(ctx, params))
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName).String()
+	require.Equal(t, int64(101), m.BankKeeper.Balances[moduleAddress].AmountOf("uusdc").Int64(), "expected the fee to be sent to the module account")
+
+	// ARRANGE: The balance does not cover the fee.
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The transfer is deferred without being recorded as failed.
+	require.Equal(t, int64(101), m.BankKeeper.Balances[moduleAddress].AmountOf("uusdc").Int64(), "expected no fee to be collected")
+	require.Nil(t, k.GetFailedTransfer(ctx, acc.Address), "expected the transfer to not be recorded as failed")
+	require.Zero(t, k.GetOutcomeStats(ctx, acc.DestinationDomain).FailedTransfers, "expected no failed transfers to be counted")
+}
+
+func TestExecuteTransfers_TransferFeesRetried(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	m.CCTPServer.Failing = true
+	feeRecipient := testutil.NobleAddress()
+
+	params := k.GetParams(ctx)
+	params.FeeRecipient = feeRecipient
+	params.TransferFees = []types.TransferFee{
+		{DestinationDomain: uint32(types.ETHEREUM), FlatAmount: math.NewInt(100)},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	acc := testutil.AutoCCTPAccount(false)
+	acc.DestinationDomain = uint32(types.ETHEREUM)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+	ctx = ctx.WithBlockHeight(100)
+
+	// ACT: The transfer fails, then its retry fails again.
+	k.ExecuteTransfers(ctx)
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+	ctx = ctx.WithBlockHeight(k.GetFailedTransfer(ctx, acc.Address).NextRetryHeight)
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: No fee is charged for the failed attempts.
+	require.Equal(t, uint64(2), k.GetFailedTransfer(ctx, acc.Address).Attempts, "expected two attempts")
+	require.True(t, m.BankKeeper.Balances[feeRecipient].IsZero(), "expected no fee to be collected")
+	require.Equal(t, int64(1_000_000), m.BankKeeper.Balances[acc.Address].AmountOf("uusdc").Int64(), "expected the balance to be left untouched")
+	_, err := k.TotalFees.Get(ctx, acc.DestinationDomain)
+	require.Error(t, err, "expected no total fees")
+
+	// ACT: The retry succeeds.
+	m.CCTPServer.Failing = false
+	ctx = ctx.WithBlockHeight(k.GetFailedTransfer(ctx, acc.Address).NextRetryHeight)
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The fee is charged once.
+	require.Nil(t, k.GetFailedTransfer(ctx, acc.Address), "expected the failed transfer to be removed")
+	require.Equal(t, int64(100), m.BankKeeper.Balances[feeRecipient].AmountOf("uusdc").Int64(), "expected the fee to be collected once")
+	totalFees, err := k.TotalFees.Get(ctx, acc.DestinationDomain)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), totalFees, "expected a different total fees")
+}
+
+func TestExecuteTransfers_TransferFeesOwed(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	m.CCTPServer.MaxTransferAmount = 1_000_000
+	m.CCTPServer.FailAfter = 1
+	feeRecipient := testutil.NobleAddress()
+
+	params := k.GetParams(ctx)
+	params.FeeRecipient = feeRecipient
+	params.SplitOversizedTransfers = true
+	params.TransferFees = []types.TransferFee{
+		{DestinationDomain: uint32(types.ETHEREUM), BasisPoints: 100},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	acc := testutil.AutoCCTPAccount(false)
+	acc.DestinationDomain = uint32(types.ETHEREUM)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 2_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+	ctx = ctx.WithBlockHeight(100)
+
+	// ACT: The second chunk fails.
+	k.ExecuteTransfers(ctx)
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+
+	// ASSERT: The fee computed on the whole deposit is kept as owed.
+	require.True(t, m.BankKeeper.Balances[feeRecipient].IsZero(), "expected no fee to be collected")
+	owedFee, err := k.OwedFees.Get(ctx, acc.Address)
+	require.NoError(t, err, "expected the fee to be owed")
+	require.Equal(t, math.NewInt(20_000), owedFee.Amount, "expected a different owed fee")
+	require.Equal(t, math.NewInt(1_000_000), owedFee.AssessedAmount, "expected the burned chunk to be deducted")
+
+	// ACT: The burned chunk leaves the account, and the retry succeeds.
+	m.CCTPServer.FailAfter = 0
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	ctx = ctx.WithBlockHeight(k.GetFailedTransfer(ctx, acc.Address).NextRetryHeight)
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The fee is not computed again on the amount left by the partial transfer.
+	require.Equal(t, int64(20_000), m.BankKeeper.Balances[feeRecipient].AmountOf("uusdc").Int64(), "expected the whole fee to be collected")
+	_, err = k.OwedFees.Get(ctx, acc.Address)
+	require.Error(t, err, "expected the owed fee to be removed")
+
+	// ACT: The fee of a new deposit cannot be collected.
+	m.BankKeeper.Failing = true
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+	k.ExecuteTransfers(ctx)
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+
+	// ASSERT: The fee is kept as owed.
+	require.Equal(t, int64(20_000), m.BankKeeper.Balances[feeRecipient].AmountOf("uusdc").Int64(), "expected no fee to be collected")
+	owedFee, err = k.OwedFees.Get(ctx, acc.Address)
+	require.NoError(t, err, "expected the fee to be owed")
+	require.Equal(t, math.NewInt(10_000), owedFee.Amount, "expected a different owed fee")
+	require.Equal(t, math.NewInt(10_000), owedFee.AssessedAmount, "expected only the fee to be left")
+
+	// ACT: The owed fee is collected along with the fee of the next deposit.
+	m.BankKeeper.Failing = false
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 510_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT
+	require.Equal(t, int64(35_000), m.BankKeeper.Balances[feeRecipient].AmountOf("uusdc").Int64(), "expected both fees to be collected")
+	_, err = k.OwedFees.Get(ctx, acc.Address)
+	require.Error(t, err, "expected the owed fee to be removed")
+	totalFees, err := k.TotalFees.Get(ctx, acc.DestinationDomain)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(35_000), totalFees, "expected a different total fees")
+}
+
+func TestForwardOtherDenoms(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+
+	acc := testutil.AutoCCTPAccount(false)
+	acc.ForwardOtherDenoms = true
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(
+		sdk.NewInt64Coin("uusdc", 1_000_000),
+		sdk.NewInt64Coin("uatom", 1_000),
+		sdk.NewInt64Coin("ueure", 2_000),
+	)
+	require.NoError(t, k.PendingForwards.Set(ctx, acc.Address, acc))
+
+	// ACT
+	k.ForwardOtherDenoms(ctx)
+
+	// ASSERT: Only the other denoms are forwarded to the fallback recipient.
+	expected := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000), sdk.NewInt64Coin("ueure", 2_000))
+	require.Equal(t, expected, m.BankKeeper.Balances[acc.FallbackRecipient], "expected the other denoms to be forwarded")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000)), m.BankKeeper.Balances[acc.Address], "expected the minting denom to remain")
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1, "expected one event")
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err, "expected a typed event")
+	require.Equal(t, &types.OtherDenomsForwarded{
+		Address:  acc.Address,
+		Receiver: acc.FallbackRecipient,
+		Coins:    expected,
+	}, event, "expected a different event")
+
+	records, err := k.GetTransferHistory(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 2, "expected a record for every forwarded denom")
+	require.Equal(t, types.TransferOutcomeFallback, records[0].Outcome, "expected a fallback record")
+}
+
+func TestForwardOtherDenoms_Retry(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+	m.BankKeeper.Failing = true
+	params := k.GetParams(ctx)
+
+	acc := testutil.AutoCCTPAccount(false)
+	acc.ForwardOtherDenoms = true
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000))
+	require.NoError(t, k.PendingForwards.Set(ctx, acc.Address, acc))
+
+	// ACT: The forward fails.
+	k.ForwardOtherDenoms(ctx)
+
+	// ASSERT: The failed forward is recorded and scheduled for a retry.
+	failedForward := k.GetFailedForward(ctx, acc.Address)
+	require.NotNil(t, failedForward, "expected the failed forward to be recorded")
+	require.Equal(t, uint64(1), failedForward.Attempts, "expected one attempt")
+	require.Equal(t, int64(10+params.RetryBaseDelay), failedForward.NextRetryHeight, "expected a different retry height")
+	require.NotEmpty(t, failedForward.Error, "expected the error to be recorded")
+
+	// ACT: The forward is not retried before the scheduled height.
+	require.NoError(t, k.PendingForwards.Clear(ctx, nil))
+	m.BankKeeper.Failing = false
+	k.ForwardOtherDenoms(ctx.WithBlockHeight(failedForward.NextRetryHeight - 1))
+
+	// ASSERT
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000)), m.BankKeeper.Balances[acc.Address], "expected no retry before the scheduled height")
+
+	// ACT: The forward is retried at the scheduled height and succeeds.
+	k.ForwardOtherDenoms(ctx.WithBlockHeight(failedForward.NextRetryHeight))
+
+	// ASSERT
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000)), m.BankKeeper.Balances[acc.FallbackRecipient], "expected the other denoms to be forwarded")
+	require.Nil(t, k.GetFailedForward(ctx, acc.Address), "expected the failed forward to be removed")
+	retryable, err := k.GetRetryableForwards(ctx.WithBlockHeight(failedForward.NextRetryHeight))
+	require.NoError(t, err)
+	require.Empty(t, retryable, "expected the retry to be unscheduled")
+}
+
+func TestPruneExpiredAccounts(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(now)
+
+	byHeight := testutil.AutoCCTPAccount(false)
+	byHeight.ExpirationHeight = 10
+	byTime := testutil.AutoCCTPAccount(false)
+	byTime.ExpirationTime = types.NewExpirationTime(now)
+	notExpired := testutil.AutoCCTPAccount(false)
+	notExpired.ExpirationHeight = 11
+	notExpired.ExpirationTime = types.NewExpirationTime(now.Add(time.Second))
+
+	for _, acc := range []*types.Account{&byHeight, &byTime, &notExpired} {
+		m.AccountKeeper.Accounts[acc.Address] = acc
+		require.NoError(t, k.SetAccountIndexes(ctx, acc))
+		require.NoError(t, k.IncrementNumOfAccounts(ctx, acc.DestinationDomain))
+	}
+	m.BankKeeper.Balances[byHeight.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+
+	// ACT
+	k.PruneExpiredAccounts(ctx)
+
+	// ASSERT: Only the expired accounts are deregistered.
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000)), m.BankKeeper.Balances[byHeight.FallbackRecipient], "expected the balance to be sent to the fallback")
+	for _, acc := range []types.Account{byHeight, byTime} {
+		account := m.AccountKeeper.Accounts[acc.Address].(*types.Account)
+		require.True(t, account.Deregistered, "expected the expired account to be deregistered")
+	}
+	account := m.AccountKeeper.Accounts[notExpired.Address].(*types.Account)
+	require.False(t, account.Deregistered, "expected the account to not be expired")
+
+	expired, err := k.GetExpiredAccounts(ctx, 0)
+	require.NoError(t, err)
+	require.Empty(t, expired, "expected the expired accounts to be removed from the indexes")
+	numOfAccounts, err := k.GetNumOfAccountPerDestination(ctx)
+	require.NoError(t, err)
+	total := uint64(0)
+	for _, count := range numOfAccounts {
+		total += count
+	}
+	require.Equal(t, uint64(1), total, "expected the number of accounts to be decremented")
+
+	var expiredEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "noble.autocctp.v1.AccountExpired" {
+			expiredEvents++
+		}
+	}
+	require.Equal(t, 2, expiredEvents, "expected an event for every expired account")
+
+	// ACT: The account expires in the next block.
+	k.PruneExpiredAccounts(ctx.WithBlockHeight(11))
+
+	// ASSERT
+	account = m.AccountKeeper.Accounts[notExpired.Address].(*types.Account)
+	require.True(t, account.Deregistered, "expected the account to be expired")
+}
+
+func TestPruneExpiredAccounts_Limit(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	params := k.GetParams(ctx)
+	params.MaxPrunedAccountsPerBlock = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for i := 0; i < 3; i++ {
+		acc := testutil.AutoCCTPAccount(false)
+		acc.ExpirationHeight = 10
+		m.AccountKeeper.Accounts[acc.Address] = &acc
+		require.NoError(t, k.SetAccountIndexes(ctx, &acc))
+	}
+
+	// ACT
+	k.PruneExpiredAccounts(ctx)
+
+	// ASSERT: The accounts exceeding the limit are pruned in the following block.
+	expired, err := k.GetExpiredAccounts(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, expired, 1, "expected only the maximum number of accounts to be pruned")
+
+	// ACT
+	k.PruneExpiredAccounts(ctx.WithBlockHeight(11))
+
+	// ASSERT
+	expired, err = k.GetExpiredAccounts(ctx, 0)
+	require.NoError(t, err)
+	require.Empty(t, expired, "expected all the expired accounts to be pruned")
+}
+
+func TestPruneExpiredAccounts_Retries(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+	m.BankKeeper.Failing = true
+
+	params := k.GetParams(ctx)
+	params.MaxTransferAttempts = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	acc := testutil.AutoCCTPAccount(false)
+	acc.ExpirationHeight = 10
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.SetAccountIndexes(ctx, &acc))
+
+	// ACT: The pruning fails.
+	k.PruneExpiredAccounts(ctx)
+
+	// ASSERT: The account leaves the expiration indexes and its pruning is scheduled.
+	expired, err := k.GetExpiredAccounts(ctx, 0)
+	require.NoError(t, err)
+	require.Empty(t, expired, "expected the failing account to not be retried at every block")
+	retryHeight := ctx.BlockHeight() + int64(params.RetryDelay(1))
+	attempts, err := k.PruneRetries.Get(ctx, collections.Join(retryHeight, acc.Address))
+	require.NoError(t, err, "expected the pruning to be retried")
+	require.Equal(t, uint64(1), attempts, "expected one failed attempt")
+	var failed int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "noble.autocctp.v1.AccountPruneFailed" {
+			failed++
+		}
+	}
+	require.Equal(t, 1, failed, "expected an account prune failed event")
+
+	// ACT: The retry fails again and exhausts the attempts.
+	k.PruneExpiredAccounts(ctx.WithBlockHeight(retryHeight))
+
+	// ASSERT
+	retries, err := k.GetPruneRetries(ctx.WithBlockHeight(retryHeight+int64(params.RetryMaxDelay)), 0)
+	require.NoError(t, err)
+	require.Empty(t, retries, "expected no more retries once the attempts are exhausted")
+	account := m.AccountKeeper.Accounts[acc.Address].(*types.Account)
+	require.False(t, account.Deregistered, "expected the account to not be deregistered")
+}
+
+func TestExecuteTransfers_Hooks(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	mc := m.CCTPServer.MockCounter
+	hooks := mocks.NewAutoCCTPHooks()
+	hooks.VetoErr = errors.New("vetoed")
+	k.SetHooks(hooks)
+
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT: The transfer is vetoed by the hooks.
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT
+	require.Equal(t, 0, mc.NumDepositForBurn, "expected no transfer when vetoed")
+	require.Nil(t, k.GetFailedTransfer(ctx, acc.Address), "expected the vetoed transfer to not be recorded as failed")
+	has, err := k.AwaitingTransfers.Has(ctx, acc.Address)
+	require.NoError(t, err)
+	require.True(t, has, "expected the vetoed transfer to be awaiting")
+	require.Zero(t, k.GetOutcomeStats(ctx, acc.DestinationDomain).FailedTransfers, "expected the veto to not count as a failed transfer")
+	require.Equal(t, 1, hooks.Calls["BeforeTransferExecuted"], "expected the before hook to be called")
+	require.Zero(t, hooks.Calls["AfterTransferFailed"], "expected the failed hook to not be called")
+	require.Zero(t, hooks.Calls["AfterTransferExecuted"], "expected the executed hook to not be called")
+
+	// ARRANGE
+	hooks.VetoErr = nil
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT
+	require.Equal(t, 1, mc.NumDepositForBurn, "expected the transfer to be executed")
+	require.Equal(t, 2, hooks.Calls["BeforeTransferExecuted"], "expected the before hook to be called")
+	require.Equal(t, 1, hooks.Calls["AfterTransferExecuted"], "expected the executed hook to be called")
+}
+
+func TestExecuteTransfers_Isolation(t *testing.T) {
+	tc := []struct {
+		name        string
+		setup       func(*mocks.Mocks)
+		errContains string
+	}{
+		{
+			name:        "panic in the cctp server",
+			setup:       func(m *mocks.Mocks) { m.CCTPServer.Panicking = true },
+			errContains: "recovered from panic: deposit for burn panicked",
+		},
+		{
+			name:        "gas limit exceeded",
+			setup:       func(m *mocks.Mocks) { m.CCTPServer.GasConsumed = types.DefaultTransferGasLimit + 1 },
+			errContains: "out of gas",
+		},
+	}
+
+	for _, c := range tc {
+		t.Run(c.name, func(t *testing.T) {
+			// ARRANGE
+			m, k, ctx := mocks.AutoCCTPKeeper(t)
+			c.setup(m)
+
+			acc := testutil.AutoCCTPAccount(false)
+			m.AccountKeeper.Accounts[acc.Address] = &acc
+			m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+			require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+			// ACT
+			require.NotPanics(t, func() { k.ExecuteTransfers(ctx) }, "expected the panic to be recovered")
+
+			// ASSERT
+			failedTransfer := k.GetFailedTransfer(ctx, acc.Address)
+			require.NotNil(t, failedTransfer, "expected the transfer to be recorded as failed")
+			require.Contains(t, failedTransfer.Error, c.errContains, "expected a different error")
+		})
+	}
+}
+
+// panickingTransferHooks panics after a transfer is executed or failed.
+type panickingTransferHooks struct {
+	*mocks.AutoCCTPHooks
+}
+
+func (panickingTransferHooks) AfterTransferExecuted(context.Context, types.Account, sdk.Coin, uint64) error {
+	panic("transfer executed panicked")
+}
+
+func (panickingTransferHooks) AfterTransferFailed(context.Context, types.Account, sdk.Coin, error) error {
+	panic("transfer failed panicked")
+}
+
+func TestExecuteTransfers_HooksIsolation(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	mc := m.CCTPServer.MockCounter
+	k.SetHooks(panickingTransferHooks{AutoCCTPHooks: mocks.NewAutoCCTPHooks()})
+
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	require.NotPanics(t, func() { k.ExecuteTransfers(ctx) }, "expected the panic to be recovered")
+
+	// ASSERT: The transfer is executed regardless of the hook.
+	require.Equal(t, 1, mc.NumDepositForBurn, "expected the transfer to be executed")
+	require.Nil(t, k.GetFailedTransfer(ctx, acc.Address), "expected the transfer to not be recorded as failed")
+
+	// ARRANGE
+	m.CCTPServer.Failing = true
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	require.NotPanics(t, func() { k.ExecuteTransfers(ctx) }, "expected the panic to be recovered")
+
+	// ASSERT: The transfer is recorded as failed regardless of the hook.
+	require.NotNil(t, k.GetFailedTransfer(ctx, acc.Address), "expected the transfer to be recorded as failed")
+}
+
+// writingHooks writes to the module state before vetoing the transfers.
+type writingHooks struct {
+	*mocks.AutoCCTPHooks
+	k *keeper.Keeper
+}
+
+func (h writingHooks) BeforeTransferExecuted(ctx context.Context, _ types.Account, _ sdk.Coin) error {
+	if err := h.k.PausedDomains.Set(ctx, uint32(types.BASE)); err != nil {
+		return err
+	}
+	return errors.New("vetoed")
+}
+
+func TestExecuteTransfers_IsolationRollback(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	k.SetHooks(writingHooks{AutoCCTPHooks: mocks.NewAutoCCTPHooks(), k: k})
+
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc))
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT: The writes of the vetoed execution are discarded.
+	require.Nil(t, k.GetFailedTransfer(ctx, acc.Address), "expected the vetoed transfer to not be recorded as failed")
+	require.False(t, k.IsPaused(ctx, uint32(types.BASE)), "expected the writes of the failed execution to be discarded")
+}
+
+func TestSweepAccounts(t *testing.T) {
+	// ARRANGE: Funds credited without going through the send restriction.
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	params := k.GetParams(ctx)
+	params.SweepBatchSize = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	accounts := make([]types.Account, 3)
+	for i := range accounts {
+		accounts[i] = testutil.AutoCCTPAccount(false)
+		accounts[i].DestinationDomain = uint32(types.ETHEREUM)
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Address < accounts[j].Address })
+	for i := range accounts {
+		m.AccountKeeper.Accounts[accounts[i].Address] = &accounts[i]
+		m.BankKeeper.Balances[accounts[i].Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+		require.NoError(t, k.SetAccountIndexes(ctx, &accounts[i]))
+	}
+	failedTransfer := types.NewFailedTransfer(accounts[1].Address, math.NewInt(1_000_000), errors.New("error"), 0, 1, 100)
+	require.NoError(t, k.StoreFailedTransfer(ctx, failedTransfer))
+
+	// ACT
+	k.SweepAccounts(ctx)
+
+	// ASSERT: The accounts are checked in batches.
+	pending, err := k.GetPendingTransfers(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1, "expected one account to be checked")
+	require.Equal(t, accounts[0].Address, pending[0].Address, "expected the first account to be marked")
+
+	// ACT
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+	k.SweepAccounts(ctx)
+
+	// ASSERT: Failed transfers are retried according to their schedule.
+	pending, err = k.GetPendingTransfers(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pending, "expected the account with a failed transfer to be skipped")
+
+	// ACT: The last sweep reaches the end of the accounts and resets the cursor.
+	k.SweepAccounts(ctx)
+	k.SweepAccounts(ctx)
+	k.SweepAccounts(ctx)
+
+	// ASSERT: The sweep restarts from the first account.
+	pending, err = k.GetPendingTransfers(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2, "expected the last and the first account to be marked")
+	require.Equal(t, accounts[2].Address, pending[0].Address, "expected the last account to be marked")
+	require.Equal(t, accounts[0].Address, pending[1].Address, "expected the first account to be marked again")
+}
+
+func TestSweepAccounts_DirtyAccounts(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	params := k.GetParams(ctx)
+	params.SweepBatchSize = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+
+	// ACT: The deposit is executed after the end block of the module, so the pending transfer
+	// is lost with the transient store.
+	k.ExecuteTransfers(ctx)
+	_, err := k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), coins)
+	require.NoError(t, err)
+	m.BankKeeper.Balances[acc.Address] = coins
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+	require.NoError(t, k.TransfersExecuted.Remove(ctx))
+	k.SweepAccounts(ctx)
+
+	// ASSERT
+	has, err := k.HasPendingTransfer(ctx, acc.Address)
+	require.NoError(t, err)
+	require.True(t, has, "expected the dirty account to be marked for clearing")
+	has, err = k.DirtyAccounts.Has(ctx, acc.Address)
+	require.NoError(t, err)
+	require.False(t, has, "expected the dirty marker to be removed")
+
+	// ACT: Deposits executed before the end block are handled by the transfers execution.
+	_, err = k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), coins)
+	require.NoError(t, err)
+
+	// ASSERT
+	has, err = k.DirtyAccounts.Has(ctx, acc.Address)
+	require.NoError(t, err)
+	require.False(t, has, "expected the account to not be marked as dirty before the end block")
+}
+
+func TestSweepAccounts_ResumeAwaitingTransfers(t *testing.T) {
+	// ARRANGE: Three transfers are deferred while paused.
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewMsgServer(k)
+	params := k.GetParams(ctx)
+	params.SweepBatchSize = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	accounts := make([]types.Account, 3)
+	for i := range accounts {
+		accounts[i] = testutil.AutoCCTPAccount(false)
+		m.AccountKeeper.Accounts[accounts[i].Address] = &accounts[i]
+		m.BankKeeper.Balances[accounts[i].Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+		require.NoError(t, k.AwaitingTransfers.Set(ctx, accounts[i].Address))
+	}
+
+	// ACT
+	_, err := server.SetPaused(ctx, &types.MsgSetPaused{Authority: mocks.Authority, Paused: false})
+	require.NoError(t, err)
+	k.SweepAccounts(ctx)
+
+	// ASSERT: The first batch of awaiting transfers is resumed.
+	pending, err := k.GetPendingTransfers(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2, "expected the first batch to be resumed")
+	has, err := k.ResumeCursor.Has(ctx)
+	require.NoError(t, err)
+	require.True(t, has, "expected the resumption to be in progress")
+
+	// ACT
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+	k.SweepAccounts(ctx)
+
+	// ASSERT: The remaining awaiting transfer is resumed in the next block.
+	pending, err = k.GetPendingTransfers(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1, "expected the remaining transfer to be resumed")
+	has, err = k.ResumeCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has, "expected the resumption to be completed")
+}
+
+func TestExecuteTransfers_StatsHistory(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	day := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(day.Add(time.Hour))
+
+	acc := testutil.AutoCCTPAccount(false)
+	acc.DestinationDomain = uint32(types.ETHEREUM)
+	m.AccountKeeper.Accounts[acc.Address] = &acc
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc), "expected no error adding the pending transfer")
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT
+	bucket, err := k.StatsHistory.Get(ctx, collections.Join(acc.DestinationDomain, day))
+	require.NoError(t, err, "expected the transfer to be recorded in the daily bucket")
+	require.Equal(t, uint64(1), bucket.Transfers, "expected one transfer in the bucket")
+	require.Equal(t, math.NewInt(1_000_000), bucket.Amount, "expected the amount transferred in the bucket")
+
+	// ARRANGE: The history is not recorded if the bucket duration is zero.
+	require.NoError(t, k.StatsHistory.Clear(ctx, nil), "expected no error clearing the stats history")
+	params := k.GetParams(ctx)
+	params.StatsBucketDuration = 0
+	require.NoError(t, k.SetParams(ctx, params), "expected no error setting the params")
+	m.BankKeeper.Balances[acc.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	require.NoError(t, k.AddPendingTransfer(ctx, acc), "expected no error adding the pending transfer")
+
+	// ACT
+	k.ExecuteTransfers(ctx)
+
+	// ASSERT
+	buckets, err := k.GetAllStatsHistory(ctx)
+	require.NoError(t, err, "expected no error getting the stats history")
+	require.Empty(t, buckets, "expected the stats history to not be recorded")
+}
+
+func TestPruneStatsHistory(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	params := k.GetParams(ctx)
+	params.StatsBucketDuration = time.Hour
+	params.StatsHistoryRetention = 2 * time.Hour
+	require.NoError(t, k.SetParams(ctx, params), "expected no error setting the params")
+
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range 4 {
+		blockCtx := ctx.WithBlockTime(start.Add(time.Duration(i) * time.Hour))
+		require.NoError(t, k.AddStatsHistory(blockCtx, uint32(types.ETHEREUM), math.NewInt(100)), "expected no error adding the stats history")
+		require.NoError(t, k.AddStatsHistory(blockCtx, uint32(types.BASE), math.NewInt(100)), "expected no error adding the stats history")
+	}
+
+	// ACT
+	k.PruneStatsHistory(ctx.WithBlockTime(start.Add(4*time.Hour + 30*time.Minute)))
+
+	// ASSERT: Only the buckets ended before the retention period are pruned, for every
+	// destination domain.
+	buckets, err := k.GetAllStatsHistory(ctx)
+	require.NoError(t, err, "expected no error getting the stats history")
+	require.Len(t, buckets, 4, "expected the two oldest buckets of each domain to be pruned")
+	for _, bucket := range buckets {
+		require.False(t, bucket.StartTime.Before(start.Add(2*time.Hour)), "expected the bucket overlapping the retention period to be kept")
+	}
+
+	// ARRANGE: The buckets are never pruned if the retention is zero.
+	params.StatsHistoryRetention = 0
+	require.NoError(t, k.SetParams(ctx, params), "expected no error setting the params")
+
+	// ACT
+	k.PruneStatsHistory(ctx.WithBlockTime(start.Add(24 * time.Hour)))
+
+	// ASSERT
+	buckets, err = k.GetAllStatsHistory(ctx)
+	require.NoError(t, err, "expected no error getting the stats history")
+	require.Len(t, buckets, 4, "expected no bucket to be pruned")
+}
@@ -22,11 +22,130 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 
 	"autocctp.dev/types"
 )
 
 func (k *Keeper) InitGenesis(ctx context.Context, genesis types.GenesisState) {
+	if err := k.SetParams(ctx, genesis.Params); err != nil {
+		panic(err)
+	}
+	for _, domain := range genesis.Domains {
+		if err := k.SetDomain(ctx, domain); err != nil {
+			panic(err)
+		}
+	}
+	// The account indexes are derived from the accounts stored in the auth module, that
+	// are imported before this module.
+	if err := k.RebuildAccountIndexes(ctx); err != nil {
+		panic(err)
+	}
+	if err := k.SetPaused(ctx, genesis.Paused); err != nil {
+		panic(err)
+	}
+	if len(genesis.PausedDestinationDomains) != 0 {
+		if err := k.SetPaused(ctx, true, genesis.PausedDestinationDomains...); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.TokenPaused.Set(ctx, genesis.TokenPaused); err != nil {
+		panic(err)
+	}
+	for _, failedForward := range genesis.FailedForwards {
+		if err := k.StoreFailedForward(ctx, failedForward); err != nil {
+			panic(err)
+		}
+	}
+	for _, owedFee := range genesis.OwedFees {
+		if err := k.OwedFees.Set(ctx, owedFee.Address, owedFee); err != nil {
+			panic(err)
+		}
+	}
+	for _, failedTransfer := range genesis.FailedTransfers {
+		if err := k.StoreFailedTransfer(ctx, failedTransfer); err != nil {
+			panic(err)
+		}
+	}
+	for _, address := range genesis.AwaitingTransfers {
+		if err := k.AwaitingTransfers.Set(ctx, address); err != nil {
+			panic(err)
+		}
+	}
+	if genesis.ResumeCursor != nil {
+		if err := k.ResumeCursor.Set(ctx, genesis.ResumeCursor.Address); err != nil {
+			panic(err)
+		}
+	}
+	for _, address := range genesis.DirtyAccounts {
+		if err := k.DirtyAccounts.Set(ctx, address); err != nil {
+			panic(err)
+		}
+	}
+	if genesis.FallbackSweepCursor != "" {
+		if err := k.FallbackSweepCursor.Set(ctx, genesis.FallbackSweepCursor); err != nil {
+			panic(err)
+		}
+	}
+	if genesis.SweepCursor != nil {
+		if err := k.SweepCursor.Set(ctx, collections.Join(genesis.SweepCursor.DestinationDomain, genesis.SweepCursor.Address)); err != nil {
+			panic(err)
+		}
+	}
+	for key, value := range genesis.RateLimitUsage {
+		if err := k.RateLimitUsage.Set(ctx, key, value); err != nil {
+			panic(err)
+		}
+	}
+	for _, bucket := range genesis.RateLimitBuckets {
+		if err := k.RateLimitBuckets.Set(ctx, collections.Join(bucket.DestinationDomain, bucket.Height), bucket.Usage); err != nil {
+			panic(err)
+		}
+	}
+	for _, address := range genesis.RateLimitedTransfers {
+		if err := k.RateLimitedTransfers.Set(ctx, address); err != nil {
+			panic(err)
+		}
+	}
+	for _, queuedTransfer := range genesis.TransferQueue {
+		if err := k.TransferQueue.Set(ctx, queuedTransfer.Id, queuedTransfer); err != nil {
+			panic(err)
+		}
+		if err := k.TransferQueueByAddress.Set(ctx, queuedTransfer.Address, queuedTransfer.Id); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.TransferQueueSequence.Set(ctx, genesis.TransferQueueSequence); err != nil {
+		panic(err)
+	}
+	for _, retry := range genesis.PruneRetries {
+		if err := k.PruneRetries.Set(ctx, collections.Join(retry.Height, retry.Address), retry.Attempts); err != nil {
+			panic(err)
+		}
+	}
+	nextTransferRecordID := uint64(0)
+	for _, record := range genesis.TransferHistory {
+		if err := k.TransferHistory.Set(ctx, collections.Join(record.Address, record.Id), record); err != nil {
+			panic(err)
+		}
+		nextTransferRecordID = max(nextTransferRecordID, record.Id+1)
+	}
+	if err := k.TransferHistorySequence.Set(ctx, nextTransferRecordID); err != nil {
+		panic(err)
+	}
+	for _, bucket := range genesis.StatsHistory {
+		if err := k.StatsHistory.Set(ctx, collections.Join(bucket.DestinationDomain, bucket.StartTime), bucket); err != nil {
+			panic(err)
+		}
+	}
+	for key, value := range genesis.OutcomeStats {
+		if err := k.OutcomeStats.Set(ctx, key, value); err != nil {
+			panic(err)
+		}
+	}
 	for key, value := range genesis.NumOfAccounts {
 		if err := k.NumOfAccounts.Set(ctx, key, value); err != nil {
 			panic(err)
@@ -37,8 +156,21 @@ func (k *Keeper) InitGenesis(ctx context.Context, genesis types.GenesisState) {
 			panic(err)
 		}
 	}
-	for key, value := range genesis.TotalTransferred {
-		if err := k.TotalTransferred.Set(ctx, key, value); err != nil {
+	for key, value := range genesis.TotalTransferredAmount {
+		amount, ok := math.NewIntFromString(value)
+		if !ok {
+			panic(fmt.Sprintf("invalid total transferred for destination domain %d: %s", key, value))
+		}
+		if err := k.TotalTransferred.Set(ctx, key, amount); err != nil {
+			panic(err)
+		}
+	}
+	for key, value := range genesis.TotalFees {
+		amount, ok := math.NewIntFromString(value)
+		if !ok {
+			panic(fmt.Sprintf("invalid total fees for destination domain %d: %s", key, value))
+		}
+		if err := k.TotalFees.Set(ctx, key, amount); err != nil {
 			panic(err)
 		}
 	}
@@ -49,10 +181,71 @@ func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	numOfAccount, _ := k.GetNumOfAccountPerDestination(ctx)
 	numOfTransfers, _ := k.GetNumOfTransfersPerDestination(ctx)
 	totTransferred, _ := k.GetTotalTransferredPerDestination(ctx)
+	totFees, _ := k.GetTotalFeesPerDestination(ctx)
+	outcomeStats, _ := k.GetOutcomeStatsPerDestination(ctx)
+	domains, _ := k.GetDomains(ctx)
+	failedTransfers, _ := k.GetFailedTransfers(ctx)
+	failedForwards, _ := k.GetFailedForwards(ctx)
+	owedFees, _ := k.GetOwedFees(ctx)
+	awaitingTransfers, _ := k.GetAwaitingTransfers(ctx)
+	transferHistory, _ := k.GetTransferHistory(ctx)
+	statsHistory, _ := k.GetAllStatsHistory(ctx)
+	rateLimitUsage, _ := k.GetRateLimitUsagePerDestination(ctx)
+	rateLimitBuckets, _ := k.GetRateLimitBuckets(ctx)
+	rateLimitedTransfers, _ := k.GetRateLimitedAddresses(ctx)
+	transferQueue, _ := k.GetTransferQueue(ctx)
+	transferQueueSequence, _ := k.TransferQueueSequence.Peek(ctx)
+	pruneRetries, _ := k.GetAllPruneRetries(ctx)
+	paused, _ := k.Paused.Get(ctx)
+	tokenPaused, _ := k.TokenPaused.Get(ctx)
+	var resumeCursor *types.ResumeCursor
+	if cursor, err := k.ResumeCursor.Get(ctx); err == nil {
+		resumeCursor = &types.ResumeCursor{Address: cursor}
+	}
+	dirtyAccounts, _ := k.GetDirtyAccounts(ctx)
+	fallbackSweepCursor, _ := k.FallbackSweepCursor.Get(ctx)
+	var sweepCursor *types.SweepCursor
+	if cursor, err := k.SweepCursor.Get(ctx); err == nil {
+		sweepCursor = &types.SweepCursor{DestinationDomain: cursor.K1(), Address: cursor.K2()}
+	}
+	pausedDomains, _ := k.GetPausedDomains(ctx)
 
 	return &types.GenesisState{
-		NumOfAccounts:    numOfAccount,
-		NumOfTransfers:   numOfTransfers,
-		TotalTransferred: totTransferred,
+		NumOfAccounts:            numOfAccount,
+		NumOfTransfers:           numOfTransfers,
+		TotalTransferredAmount:   formatAmounts(totTransferred),
+		Params:                   k.GetParams(ctx),
+		Domains:                  domains,
+		FailedTransfers:          failedTransfers,
+		TransferHistory:          transferHistory,
+		Paused:                   paused,
+		PausedDestinationDomains: pausedDomains,
+		TotalFees:                formatAmounts(totFees),
+		StatsHistory:             statsHistory,
+		OutcomeStats:             outcomeStats,
+		AwaitingTransfers:        awaitingTransfers,
+		ResumeCursor:             resumeCursor,
+		TokenPaused:              tokenPaused,
+		RateLimitUsage:           rateLimitUsage,
+		RateLimitBuckets:         rateLimitBuckets,
+		RateLimitedTransfers:     rateLimitedTransfers,
+		TransferQueue:            transferQueue,
+		TransferQueueSequence:    transferQueueSequence,
+		PruneRetries:             pruneRetries,
+		DirtyAccounts:            dirtyAccounts,
+		SweepCursor:              sweepCursor,
+		FailedForwards:           failedForwards,
+		FallbackSweepCursor:      fallbackSweepCursor,
+		OwedFees:                 owedFees,
 	}
 }
+
+// formatAmounts returns the amounts keyed by destination domain as decimal strings.
+func formatAmounts(amounts map[uint32]math.Int) map[uint32]string {
+	formatted := make(map[uint32]string, len(amounts))
+	for key, value := range amounts {
+		formatted[key] = value.String()
+	}
+
+	return formatted
+}
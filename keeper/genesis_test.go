@@ -21,13 +21,20 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	"autocctp.dev/keeper"
+	"autocctp.dev/testutil"
 	"autocctp.dev/testutil/mocks"
+	"autocctp.dev/types"
 )
 
 func TestExportGenesis(t *testing.T) {
@@ -76,20 +83,219 @@ func TestExportGenesis(t *testing.T) {
 	err = k.IncrementTotalTransferred(ctx, 2, math.NewInt(1_000))
 	require.NoError(t, err)
 
+	// Add total fees
+	err = k.IncrementTotalFees(ctx, 0, math.NewInt(10))
+	require.NoError(t, err)
+
+	// Add stats history
+	err = k.AddStatsHistory(ctx, 0, math.NewInt(1_000))
+	require.NoError(t, err)
+
+	// Add outcome stats
+	err = k.IncrementFailedTransfers(ctx, 1)
+	require.NoError(t, err)
+
+	// Update params
+	params := types.NewParams(math.NewInt(1_000_000), 3, 20, 200, true, 10)
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// Add failed transfer
+	failedTransfer := types.NewFailedTransfer(testutil.NobleAddress(), math.NewInt(1_000_000), errors.New("error"), 1, 1, 21)
+	err = k.StoreFailedTransfer(ctx, failedTransfer)
+	require.NoError(t, err)
+
+	// Add transfer record
+	err = k.AddTransferRecord(ctx, failedTransfer.Address, sdk.NewInt64Coin("uusdc", 1_000_000), 0, types.TransferOutcomeFailed, errors.New("error"))
+	require.NoError(t, err)
+
+	// Add awaiting transfer
+	err = k.AwaitingTransfers.Set(ctx, failedTransfer.Address)
+	require.NoError(t, err)
+
+	// Pause the transfers
+	err = k.SetPaused(ctx, true, uint32(types.BASE))
+	require.NoError(t, err)
+
 	genesis := k.ExportGenesis(ctx)
+	require.Equal(t, params, genesis.Params, "expected the updated params")
+	require.False(t, genesis.Paused, "expected the transfers to not be globally paused")
+	require.Equal(t, []uint32{uint32(types.BASE)}, genesis.PausedDestinationDomains, "expected base to be paused")
+	require.Equal(t, []types.FailedTransfer{failedTransfer}, genesis.FailedTransfers, "expected the failed transfer")
+	require.Equal(t, []string{failedTransfer.Address}, genesis.AwaitingTransfers, "expected the awaiting transfer")
+	require.Len(t, genesis.TransferHistory, 1, "expected the transfer record")
+	require.Len(t, genesis.StatsHistory, 1, "expected the stats bucket")
+	require.Equal(t, map[uint32]types.OutcomeStats{1: {FailedTransfers: 1, FallbackAmount: math.ZeroInt()}}, genesis.OutcomeStats, "expected the outcome stats")
 	require.Len(t, genesis.NumOfAccounts, 3, "expected 3 destination domain for the accounts")
 	require.Len(t, genesis.NumOfTransfers, 3, "expected 3 destination domain for the num of transfers")
-	require.Len(t, genesis.TotalTransferred, 3, "expected 3 destination domain for the total transferred")
+	require.Len(t, genesis.TotalTransferredAmount, 3, "expected 3 destination domain for the total transferred")
 
 	require.Equal(t, uint64(3), genesis.NumOfAccounts[0])
 	require.Equal(t, uint64(3), genesis.NumOfTransfers[0])
-	require.Equal(t, uint64(3_000), genesis.TotalTransferred[0])
+	require.Equal(t, "3000", genesis.TotalTransferredAmount[0])
+	require.Equal(t, map[uint32]string{0: "10"}, genesis.TotalFees)
 
 	require.Equal(t, uint64(2), genesis.NumOfAccounts[1])
 	require.Equal(t, uint64(2), genesis.NumOfTransfers[1])
-	require.Equal(t, uint64(2_000), genesis.TotalTransferred[1])
+	require.Equal(t, "2000", genesis.TotalTransferredAmount[1])
 
 	require.Equal(t, uint64(1), genesis.NumOfAccounts[2])
 	require.Equal(t, uint64(1), genesis.NumOfTransfers[2])
-	require.Equal(t, uint64(1_000), genesis.TotalTransferred[2])
+	require.Equal(t, "1000", genesis.TotalTransferredAmount[2])
+}
+
+func TestExportGenesis_DeregisteredAccounts(t *testing.T) {
+	tc := []struct {
+		name       string
+		deregister func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, acc types.Account)
+	}{
+		{
+			name: "deregistered by the fallback recipient",
+			deregister: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, acc types.Account) {
+				server := keeper.NewMsgServer(k)
+				_, err := server.DeregisterAccount(ctx, &types.MsgDeregisterAccount{Signer: acc.FallbackRecipient, Address: acc.Address})
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "pruned once expired",
+			deregister: func(t *testing.T, k *keeper.Keeper, ctx sdk.Context, acc types.Account) {
+				k.PruneExpiredAccounts(ctx.WithBlockHeight(int64(acc.ExpirationHeight)))
+			},
+		},
+	}
+
+	for _, c := range tc {
+		t.Run(c.name, func(t *testing.T) {
+			// ARRANGE: The only account of the destination domain received a transfer.
+			m, k, ctx := mocks.AutoCCTPKeeper(t)
+			acc := testutil.AutoCCTPAccount(false)
+			acc.ExpirationHeight = 10
+			m.AccountKeeper.Accounts[acc.Address] = &acc
+			require.NoError(t, k.SetAccountIndexes(ctx, &acc))
+			require.NoError(t, k.IncrementNumOfAccounts(ctx, acc.DestinationDomain))
+			require.NoError(t, k.IncrementNumOfTransfers(ctx, acc.DestinationDomain))
+			require.NoError(t, k.IncrementTotalTransferred(ctx, acc.DestinationDomain, math.NewInt(1_000)))
+
+			// ACT
+			c.deregister(t, k, ctx, acc)
+			exported := k.ExportGenesis(ctx)
+
+			// ASSERT: The exported genesis keeps the transfers without registered accounts.
+			account := m.AccountKeeper.Accounts[acc.Address].(*types.Account)
+			require.True(t, account.Deregistered, "expected the account to be deregistered")
+			require.Equal(t, uint64(0), exported.NumOfAccounts[acc.DestinationDomain], "expected no registered accounts")
+			require.Equal(t, uint64(1), exported.NumOfTransfers[acc.DestinationDomain], "expected the transfer to be kept")
+			require.NoError(t, exported.Validate(), "expected the exported genesis to be valid")
+
+			// ACT: The exported genesis is imported.
+			_, imported, importedCtx := mocks.AutoCCTPKeeper(t)
+			imported.InitGenesis(importedCtx, *exported)
+
+			// ASSERT
+			reexported := imported.ExportGenesis(importedCtx)
+			require.Equal(t, exported.NumOfAccounts, reexported.NumOfAccounts, "expected the number of accounts to be imported")
+			require.Equal(t, exported.NumOfTransfers, reexported.NumOfTransfers, "expected the number of transfers to be imported")
+			require.Equal(t, exported.TotalTransferredAmount, reexported.TotalTransferredAmount, "expected the total transferred to be imported")
+		})
+	}
+}
+
+func TestInitGenesis_TransferHistory(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	address := testutil.NobleAddress()
+	genesis := types.DefaultGenesisState()
+	genesis.TransferHistory = []types.TransferRecord{
+		{Address: address, Id: 4, Amount: math.NewInt(1), Denom: "uusdc", Outcome: types.TransferOutcomeExecuted},
+	}
+
+	// ACT
+	k.InitGenesis(ctx, *genesis)
+	err := k.AddTransferRecord(ctx, address, sdk.NewInt64Coin("uusdc", 2), 0, types.TransferOutcomeExecuted, nil)
+	require.NoError(t, err)
+
+	// ASSERT: New records do not overwrite the imported ones.
+	records, err := k.GetTransferHistory(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 2, "expected the imported and the new record")
+	require.Equal(t, uint64(5), records[1].Id, "expected the sequence to continue from the imported records")
+}
+
+func TestInitGenesis_AccountIndexes(t *testing.T) {
+	// ARRANGE: Accounts are imported by the auth module before this module.
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	account := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[account.Address] = &account
+	baseAccount := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(testutil.AddressBytes()))
+	m.AccountKeeper.Accounts[baseAccount.Address] = baseAccount
+
+	// ACT
+	k.InitGenesis(ctx, *types.DefaultGenesisState())
+
+	// ASSERT
+	has, err := k.AccountsByDestinationDomain.Has(ctx, collections.Join(account.DestinationDomain, account.Address))
+	require.NoError(t, err)
+	require.True(t, has, "expected the account to be indexed by destination domain")
+	has, err = k.AccountsByMintRecipient.Has(ctx, collections.Join(account.MintRecipient, account.Address))
+	require.NoError(t, err)
+	require.True(t, has, "expected the account to be indexed by mint recipient")
+	has, err = k.AccountsByFallbackRecipient.Has(ctx, collections.Join(account.FallbackRecipient, account.Address))
+	require.NoError(t, err)
+	require.True(t, has, "expected the account to be indexed by fallback recipient")
+
+	keys, err := k.AccountsByDestinationDomain.Iterate(ctx, nil)
+	require.NoError(t, err)
+	indexed, err := keys.Keys()
+	require.NoError(t, err)
+	require.Len(t, indexed, 1, "expected only AutoCCTP accounts to be indexed")
+}
+
+func TestInitGenesis_ExecutionState(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	genesis := types.DefaultGenesisState()
+	genesis.AwaitingTransfers = []string{testutil.NobleAddress()}
+	genesis.ResumeCursor = &types.ResumeCursor{Address: genesis.AwaitingTransfers[0]}
+	genesis.TokenPaused = true
+	genesis.RateLimitUsage = map[uint32]types.RateLimitUsage{0: types.NewRateLimitUsage(2, math.NewInt(1_000))}
+	genesis.RateLimitBuckets = []types.RateLimitBucket{
+		{DestinationDomain: 0, Height: 1, Usage: types.NewRateLimitUsage(1, math.NewInt(400))},
+		{DestinationDomain: 0, Height: 2, Usage: types.NewRateLimitUsage(1, math.NewInt(600))},
+	}
+	genesis.RateLimitedTransfers = []string{testutil.NobleAddress()}
+	genesis.TransferQueue = []types.QueuedTransfer{
+		{Id: 3, Address: testutil.NobleAddress(), DestinationDomain: 0, Height: 1},
+		{Id: 5, Address: testutil.NobleAddress(), DestinationDomain: 6, Height: 2},
+	}
+	genesis.TransferQueueSequence = 6
+	genesis.PruneRetries = []types.PruneRetry{{Address: testutil.NobleAddress(), Height: 10, Attempts: 1}}
+	genesis.DirtyAccounts = []string{testutil.NobleAddress()}
+	genesis.SweepCursor = &types.SweepCursor{DestinationDomain: 6, Address: testutil.NobleAddress()}
+	genesis.FallbackSweepCursor = testutil.NobleAddress()
+	genesis.FailedForwards = []types.FailedForward{{Address: testutil.NobleAddress(), Error: "error", Attempts: 1, NextRetryHeight: 10}}
+	genesis.OwedFees = []types.OwedFee{{Address: testutil.NobleAddress(), Amount: math.NewInt(100), AssessedAmount: math.NewInt(1_000)}}
+
+	// ACT
+	k.InitGenesis(ctx, *genesis)
+
+	// ASSERT: The state of the deferred transfers is exported as imported.
+	exported := k.ExportGenesis(ctx)
+	require.Equal(t, genesis.AwaitingTransfers, exported.AwaitingTransfers, "expected the awaiting transfers to be imported")
+	require.Equal(t, genesis.ResumeCursor, exported.ResumeCursor, "expected the resume cursor to be imported")
+	require.True(t, exported.TokenPaused, "expected the token pause state to be imported")
+	require.Equal(t, genesis.RateLimitUsage, exported.RateLimitUsage, "expected the rate limit usage to be imported")
+	require.Equal(t, genesis.RateLimitBuckets, exported.RateLimitBuckets, "expected the rate limit buckets to be imported")
+	require.Equal(t, genesis.RateLimitedTransfers, exported.RateLimitedTransfers, "expected the rate limited transfers to be imported")
+	require.Equal(t, genesis.TransferQueue, exported.TransferQueue, "expected the transfer queue to be imported")
+	require.Equal(t, genesis.TransferQueueSequence, exported.TransferQueueSequence, "expected the transfer queue sequence to be imported")
+	require.Equal(t, genesis.PruneRetries, exported.PruneRetries, "expected the prune retries to be imported")
+	require.Equal(t, genesis.DirtyAccounts, exported.DirtyAccounts, "expected the dirty accounts to be imported")
+	require.Equal(t, genesis.SweepCursor, exported.SweepCursor, "expected the sweep cursor to be imported")
+	require.Equal(t, genesis.FallbackSweepCursor, exported.FallbackSweepCursor, "expected the fallback sweep cursor to be imported")
+	require.Equal(t, genesis.FailedForwards, exported.FailedForwards, "expected the failed forwards to be imported")
+	require.Equal(t, genesis.OwedFees, exported.OwedFees, "expected the owed fees to be imported")
+	id, err := k.TransferQueueByAddress.Get(ctx, genesis.TransferQueue[1].Address)
+	require.NoError(t, err, "expected the queued transfers to be indexed by address")
+	require.Equal(t, uint64(5), id, "expected a different queued transfer")
 }
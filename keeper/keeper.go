@@ -24,10 +24,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
@@ -43,23 +45,127 @@ import (
 
 type Keeper struct {
 	logger       log.Logger
+	storeService store.KVStoreService
 	eventService event.Service
 
+	// authority is the address allowed to update the module parameters.
+	authority string
+
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	ftfKeeper     types.FiatTokenfactoryKeeper
 
 	cctpService types.CCTPService
 
+	hooks types.AutoCCTPHooks
+
+	// Params contains the governance controlled parameters of the module.
+	Params collections.Item[types.Params]
+	// Domains is the registry of the destination domains supported by the module.
+	Domains collections.Map[uint32, types.DomainConfig]
+
+	// Paused indicates whether the automatic transfers are paused for all destination domains.
+	Paused collections.Item[bool]
+	// PausedDomains contains the destination domains for which the automatic transfers are paused.
+	PausedDomains collections.KeySet[uint32]
+	// TokenPaused indicates whether the minting token was paused in the fiat-tokenfactory when
+	// the transfers were last executed, to resume the deferred transfers once unpaused.
+	TokenPaused collections.Item[bool]
+
 	// NumOfAccounts keeps track of the number of accounts registered per destination domain.
 	NumOfAccounts collections.Map[uint32, uint64]
 	// NumOfTransfers keeps track of the number of transfers executed per destination domain.
 	NumOfTransfers collections.Map[uint32, uint64]
 	// TotalTransferred keeps track of the total value transferred per destination domain.
-	TotalTransferred collections.Map[uint32, uint64]
-
-	// PendingTransfers is a transient map that keeps track of the pending transfers for the current block.
-	PendingTransfers collections.Map[string, types.Account]
+	TotalTransferred collections.Map[uint32, math.Int]
+	// TotalFees keeps track of the total value of the transfer fees collected per destination domain.
+	TotalFees collections.Map[uint32, math.Int]
+	// StatsHistory aggregates the transfers executed per destination domain in time buckets,
+	// keyed by the destination domain and the start time of the bucket.
+	StatsHistory collections.Map[collections.Pair[uint32, time.Time], types.StatsBucket]
+	// OutcomeStats keeps track of the outcomes of the transfers and registrations per destination domain.
+	OutcomeStats collections.Map[uint32, types.OutcomeStats]
+
+	// AccountsByDestinationDomain indexes the registered AutoCCTP accounts by destination domain.
+	AccountsByDestinationDomain collections.Map[collections.Pair[uint32, string], collections.NoValue]
+	// AccountsByMintRecipient indexes the registered AutoCCTP accounts by mint recipient.
+	AccountsByMintRecipient collections.Map[collections.Pair[[]byte, string], collections.NoValue]
+	// AccountsByFallbackRecipient indexes the registered AutoCCTP accounts by fallback recipient.
+	AccountsByFallbackRecipient collections.Map[collections.Pair[string, string], collections.NoValue]
+	// AccountsByExpirationHeight indexes the registered AutoCCTP accounts by expiration height.
+	AccountsByExpirationHeight collections.Map[collections.Pair[uint64, string], collections.NoValue]
+	// AccountsByExpirationTime indexes the registered AutoCCTP accounts by expiration time.
+	AccountsByExpirationTime collections.Map[collections.Pair[time.Time, string], collections.NoValue]
+	// PruneRetries schedules the pruning of the expired AutoCCTP accounts that failed to be
+	// pruned by retry height, keeping track of the failed attempts.
+	PruneRetries collections.Map[collections.Pair[int64, string], uint64]
+
+	// FailedTransfers keeps track of the automatic transfers that failed and are scheduled to be retried.
+	FailedTransfers collections.Map[string, types.FailedTransfer]
+	// FailedTransfersByRetryHeight indexes the failed transfers which have not exhausted their
+	// attempts by the block height starting from which they are retried.
+	FailedTransfersByRetryHeight collections.Map[collections.Pair[int64, string], collections.NoValue]
+	// FallbackSweepCursor is the address of the last failed transfer checked against the
+	// fallback policy of its account, from which the next check resumes.
+	FallbackSweepCursor collections.Item[string]
+	// FailedForwards keeps track of the forwards of the denoms other than the minting denom
+	// that failed and are scheduled to be retried.
+	FailedForwards collections.Map[string, types.FailedForward]
+	// FailedForwardsByRetryHeight indexes the failed forwards which have not exhausted their
+	// attempts by the block height starting from which they are retried.
+	FailedForwardsByRetryHeight collections.Map[collections.Pair[int64, string], collections.NoValue]
+	// OwedFees keeps track of the transfer fees owed by the AutoCCTP accounts whose funds have
+	// not been entirely transferred yet.
+	OwedFees collections.Map[string, types.OwedFee]
+	// RateLimitUsage keeps track of the transfers counted against the rate limit per
+	// destination domain in the current rolling window.
+	RateLimitUsage collections.Map[uint32, types.RateLimitUsage]
+	// RateLimitBuckets keeps track of the transfers counted against the rate limit per
+	// destination domain and block, to remove them from the usage once out of the window.
+	RateLimitBuckets collections.Map[collections.Pair[uint32, int64], types.RateLimitUsage]
+	// RateLimitedTransfers contains the accounts whose transfer was deferred because it exceeded
+	// the rate limit of the destination domain.
+	RateLimitedTransfers collections.KeySet[string]
+	// TransferQueue contains the automatic transfers exceeding the maximum number of transfers
+	// processed in a block, keyed by the order in which they were queued.
+	TransferQueue collections.Map[uint64, types.QueuedTransfer]
+	// TransferQueueByAddress indexes the queued transfers by account address.
+	TransferQueueByAddress collections.Map[string, uint64]
+	// TransferQueueSequence is the identifier assigned to the next queued transfer.
+	TransferQueueSequence collections.Sequence
+	// TransferHistory keeps track of the most recent transfers of every AutoCCTP account.
+	TransferHistory collections.Map[collections.Pair[string, uint64], types.TransferRecord]
+	// TransferHistorySequence is the identifier assigned to the next transfer record.
+	TransferHistorySequence collections.Sequence
+
+	// AwaitingTransfers contains the AutoCCTP accounts holding at least the minimum transfer
+	// amount whose automatic transfer was deferred, or did not complete, at the end of a block.
+	AwaitingTransfers collections.KeySet[string]
+	// ResumeCursor is the address of the last awaiting transfer resumed after the automatic
+	// transfers are unpaused, or empty to resume from the first one. It is set only while the
+	// resumption is in progress.
+	ResumeCursor collections.Item[string]
+
+	// DirtyAccounts contains the AutoCCTP accounts which received funds after the end block of
+	// the module, so that they are marked for clearing in the next block.
+	DirtyAccounts collections.KeySet[string]
+	// SweepCursor is the key of the account destination domain index from which the next
+	// sweep of the AutoCCTP accounts balances resumes.
+	SweepCursor collections.Item[collections.Pair[uint32, string]]
+
+	// PendingTransfers is a transient map that keeps track of the pending transfers for the current
+	// block, keyed by the order in which they were requested.
+	PendingTransfers collections.Map[uint64, types.Account]
+	// PendingTransfersByAddress is a transient index of the pending transfers by account address.
+	PendingTransfersByAddress collections.Map[string, uint64]
+	// PendingTransfersSequence is the transient identifier assigned to the next pending transfer.
+	PendingTransfersSequence collections.Sequence
+	// PendingForwards is a transient map that keeps track of the accounts which received denoms
+	// other than the minting denom in the current block.
+	PendingForwards collections.Map[string, types.Account]
+	// TransfersExecuted is a transient flag set once the transfers of the current block are
+	// executed, after which the received funds are marked as dirty.
+	TransfersExecuted collections.Item[bool]
 }
 
 func NewKeeper(
@@ -68,6 +174,7 @@ func NewKeeper(
 	storeService store.KVStoreService,
 	transientService store.TransientStoreService,
 	eventService event.Service,
+	authority string,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	ftfKeeper types.FiatTokenfactoryKeeper,
@@ -78,19 +185,100 @@ func NewKeeper(
 
 	keeper := &Keeper{
 		logger:       logger.With("module", types.ModuleName),
+		storeService: storeService,
 		eventService: eventService,
 
+		authority: authority,
+
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		ftfKeeper:     ftfKeeper,
 
 		cctpService: cctpService,
 
+		Params:  collections.NewItem(builder, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Domains: collections.NewMap(builder, types.DomainsPrefix, "domains", collections.Uint32Key, codec.CollValue[types.DomainConfig](cdc)),
+
+		Paused:        collections.NewItem(builder, types.PausedKey, "paused", collections.BoolValue),
+		PausedDomains: collections.NewKeySet(builder, types.PausedDomainsPrefix, "paused_domains", collections.Uint32Key),
+		TokenPaused:   collections.NewItem(builder, types.TokenPausedKey, "token_paused", collections.BoolValue),
+
 		NumOfAccounts:    collections.NewMap(builder, types.NumOfAccountsPrefix, "num_of_accounts", collections.Uint32Key, collections.Uint64Value),
 		NumOfTransfers:   collections.NewMap(builder, types.NumOfTransfersPrefix, "num_of_transfers", collections.Uint32Key, collections.Uint64Value),
-		TotalTransferred: collections.NewMap(builder, types.TotalTransferredPrefix, "total_transferred", collections.Uint32Key, collections.Uint64Value),
-
-		PendingTransfers: collections.NewMap(transientBuilder, types.PendingTransfersPrefix, "pending_transfers", collections.StringKey, codec.CollValue[types.Account](cdc)),
+		TotalTransferred: collections.NewMap(builder, types.TotalTransferredPrefix, "total_transferred", collections.Uint32Key, sdk.IntValue),
+		TotalFees:        collections.NewMap(builder, types.TotalFeesPrefix, "total_fees", collections.Uint32Key, sdk.IntValue),
+		OutcomeStats:     collections.NewMap(builder, types.OutcomeStatsPrefix, "outcome_stats", collections.Uint32Key, codec.CollValue[types.OutcomeStats](cdc)),
+		StatsHistory: collections.NewMap(
+			builder, types.StatsHistoryPrefix, "stats_history",
+			collections.PairKeyCodec(collections.Uint32Key, sdk.TimeKey), codec.CollValue[types.StatsBucket](cdc),
+		),
+
+		AccountsByDestinationDomain: collections.NewMap(
+			builder, types.AccountsByDestinationDomainPrefix, "accounts_by_destination_domain",
+			collections.PairKeyCodec(collections.Uint32Key, collections.StringKey), collections.NoValue{},
+		),
+		AccountsByMintRecipient: collections.NewMap(
+			builder, types.AccountsByMintRecipientPrefix, "accounts_by_mint_recipient",
+			collections.PairKeyCodec(collections.BytesKey, collections.StringKey), collections.NoValue{},
+		),
+		AccountsByFallbackRecipient: collections.NewMap(
+			builder, types.AccountsByFallbackRecipientPrefix, "accounts_by_fallback_recipient",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.NoValue{},
+		),
+		AccountsByExpirationHeight: collections.NewMap(
+			builder, types.AccountsByExpirationHeightPrefix, "accounts_by_expiration_height",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.NoValue{},
+		),
+		AccountsByExpirationTime: collections.NewMap(
+			builder, types.AccountsByExpirationTimePrefix, "accounts_by_expiration_time",
+			collections.PairKeyCodec(sdk.TimeKey, collections.StringKey), collections.NoValue{},
+		),
+		PruneRetries: collections.NewMap(
+			builder, types.PruneRetriesPrefix, "prune_retries",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey), collections.Uint64Value,
+		),
+
+		FailedTransfers: collections.NewMap(builder, types.FailedTransfersPrefix, "failed_transfers", collections.StringKey, codec.CollValue[types.FailedTransfer](cdc)),
+		FailedTransfersByRetryHeight: collections.NewMap(
+			builder, types.FailedTransfersByRetryHeightPrefix, "retries_by_height",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey), collections.NoValue{},
+		),
+		FallbackSweepCursor: collections.NewItem(builder, types.FallbackSweepCursorKey, "fallback_sweep_cursor", collections.StringValue),
+		FailedForwards:      collections.NewMap(builder, types.FailedForwardsPrefix, "failed_forwards", collections.StringKey, codec.CollValue[types.FailedForward](cdc)),
+		FailedForwardsByRetryHeight: collections.NewMap(
+			builder, types.FailedForwardsByRetryHeightPrefix, "forward_retries_by_height",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey), collections.NoValue{},
+		),
+		OwedFees:       collections.NewMap(builder, types.OwedFeesPrefix, "owed_fees", collections.StringKey, codec.CollValue[types.OwedFee](cdc)),
+		RateLimitUsage: collections.NewMap(builder, types.RateLimitUsagePrefix, "rate_limit_usage", collections.Uint32Key, codec.CollValue[types.RateLimitUsage](cdc)),
+		RateLimitBuckets: collections.NewMap(
+			builder, types.RateLimitBucketsPrefix, "rate_limit_buckets",
+			collections.PairKeyCodec(collections.Uint32Key, collections.Int64Key), codec.CollValue[types.RateLimitUsage](cdc),
+		),
+		RateLimitedTransfers:   collections.NewKeySet(builder, types.RateLimitedTransfersPrefix, "rate_limited_transfers", collections.StringKey),
+		TransferQueue:          collections.NewMap(builder, types.TransferQueuePrefix, "transfer_queue", collections.Uint64Key, codec.CollValue[types.QueuedTransfer](cdc)),
+		TransferQueueByAddress: collections.NewMap(builder, types.TransferQueueByAddressPrefix, "queued_transfers_by_address", collections.StringKey, collections.Uint64Value),
+		TransferQueueSequence:  collections.NewSequence(builder, types.TransferQueueSequenceKey, "next_queued_transfer_id"),
+		TransferHistory: collections.NewMap(
+			builder, types.TransferHistoryPrefix, "transfer_history",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.TransferRecord](cdc),
+		),
+		TransferHistorySequence: collections.NewSequence(builder, types.TransferHistorySequenceKey, "next_transfer_record_id"),
+
+		AwaitingTransfers: collections.NewKeySet(builder, types.AwaitingTransfersPrefix, "awaiting_transfers", collections.StringKey),
+		ResumeCursor:      collections.NewItem(builder, types.ResumeCursorKey, "resume_cursor", collections.StringValue),
+
+		DirtyAccounts: collections.NewKeySet(builder, types.DirtyAccountsPrefix, "dirty_accounts", collections.StringKey),
+		SweepCursor: collections.NewItem(
+			builder, types.SweepCursorKey, "sweep_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(collections.Uint32Key, collections.StringKey)),
+		),
+
+		PendingTransfers:          collections.NewMap(transientBuilder, types.PendingTransfersPrefix, "pending_transfers", collections.Uint64Key, codec.CollValue[types.Account](cdc)),
+		PendingTransfersByAddress: collections.NewMap(transientBuilder, types.PendingTransfersByAddressPrefix, "pending_transfers_by_address", collections.StringKey, collections.Uint64Value),
+		PendingTransfersSequence:  collections.NewSequence(transientBuilder, types.PendingTransfersSequenceKey, "next_pending_transfer_id"),
+		PendingForwards:           collections.NewMap(transientBuilder, types.PendingForwardsPrefix, "pending_forwards", collections.StringKey, codec.CollValue[types.Account](cdc)),
+		TransfersExecuted:         collections.NewItem(transientBuilder, types.TransfersExecutedKey, "transfers_executed", collections.BoolValue),
 	}
 
 	if _, err := builder.Build(); err != nil {
@@ -106,8 +294,38 @@ func (k *Keeper) SetCCTPService(cctpMsgServer types.CCTPMsgServer, cctpQueryServ
 	k.cctpService = types.NewCCTPServer(cctpMsgServer, cctpQueryServer)
 }
 
+// SetHooks sets the hooks called on the lifecycle of the AutoCCTP accounts and transfers.
+// It panics if the hooks have already been set.
+func (k *Keeper) SetHooks(hooks types.AutoCCTPHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set autocctp hooks twice")
+	}
+
+	k.hooks = hooks
+	return k
+}
+
+// Hooks returns the hooks of the keeper, or a no-op implementation if not set.
+func (k *Keeper) Hooks() types.AutoCCTPHooks {
+	if k.hooks == nil {
+		return types.MultiAutoCCTPHooks{}
+	}
+
+	return k.hooks
+}
+
+// GetAuthority returns the address allowed to update the module parameters.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // ValidateAccountProperties returns an error if any account properties is not valid.
-func (k *Keeper) ValidateAccountProperties(accountProperties types.AccountProperties) error {
+func (k *Keeper) ValidateAccountProperties(ctx context.Context, accountProperties types.AccountProperties) error {
+	domain := k.GetDomain(ctx, accountProperties.DestinationDomain)
+	if err := types.ValidateDestinationDomain(accountProperties.DestinationDomain, domain); err != nil {
+		return types.ErrInvalidDestinationDomain.Wrap(err.Error())
+	}
+
 	if err := types.ValidateMintRecipient(accountProperties.MintRecipient); err != nil {
 		return types.ErrInvalidMintRecipient.Wrap(err.Error())
 	}
@@ -120,6 +338,32 @@ func (k *Keeper) ValidateAccountProperties(accountProperties types.AccountProper
 	if err := types.ValidateDestinationCaller(accountProperties.DestinationCaller); err != nil {
 		return types.ErrInvalidDestinationCaller.Wrap(err.Error())
 	}
+	if len(accountProperties.DestinationCaller) != 0 && !domain.DestinationCallerAllowed {
+		return types.ErrInvalidDestinationCaller.Wrapf("not allowed for destination domain %d", accountProperties.DestinationDomain)
+	}
+
+	if err := types.ValidateAddressDerivation(accountProperties.AddressVersion, accountProperties.Salt); err != nil {
+		return types.ErrInvalidAddressDerivation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// ValidateAccountExpiration returns an error if the optional expiration of the account
+// properties is not supported by their address version, or has already been reached in
+// the current block.
+func (k *Keeper) ValidateAccountExpiration(ctx context.Context, accountProperties types.AccountProperties) error {
+	if err := types.ValidateExpirationSupport(accountProperties.AddressVersion, accountProperties.ExpirationHeight, accountProperties.ExpirationTime); err != nil {
+		return types.ErrInvalidExpiration.Wrap(err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if accountProperties.ExpirationHeight != 0 && int64(accountProperties.ExpirationHeight) <= sdkCtx.BlockHeight() {
+		return types.ErrInvalidExpiration.Wrapf("expiration height must be after the current height %d", sdkCtx.BlockHeight())
+	}
+	if !accountProperties.ExpirationTime.IsZero() && !accountProperties.ExpirationTime.After(sdkCtx.BlockTime()) {
+		return types.ErrInvalidExpiration.Wrapf("expiration time must be after the current time %s", sdkCtx.BlockTime())
+	}
 
 	return nil
 }
@@ -139,42 +383,100 @@ func (k *Keeper) SendRestrictionFn(ctx context.Context, _, toAddr sdk.AccAddress
 
 	// Send validation
 
-	// Check coins contains only the minting denom.
-	mintingDenom := k.ftfKeeper.GetMintingDenom(ctx).Denom
-	if len(coins) != 1 || coins[0].Denom != mintingDenom {
-		return toAddr, fmt.Errorf("autocctp accounts can only receive %s coins", mintingDenom)
+	// Deregistered accounts are inert and cannot receive funds.
+	if account.Deregistered {
+		return toAddr, types.ErrAccountDeregistered.Wrapf("cannot send funds to %s", account.Address)
 	}
 
-	mintingDenomAmount := coins[0].Amount
+	// Expired accounts cannot receive funds, even before being pruned at the end of the block.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if account.IsExpired(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+		return toAddr, types.ErrAccountExpired.Wrapf("cannot send funds to %s", account.Address)
+	}
 
-	// Check on the minimum transferable amount.
-	if mintingDenomAmount.LT(types.GetMinimumTransferAmount()) {
-		return toAddr, types.ErrInvalidTransferAmount.Wrapf("cannot be lower than %s", types.GetMinimumTransferAmount().String())
+	// Check the compliance of the parties involved in the transfer to avoid locking funds.
+	if reason := k.blacklistReason(ctx, *account); reason != types.ComplianceReasonUnspecified {
+		return toAddr, types.ErrBlacklisted.Wrapf("cannot send funds to %s: %s", account.Address, reason)
 	}
 
-	// Check on maximum transferable amount.
-	maxTransferAmount, err := k.getMaxTransferAmount(ctx, mintingDenom)
-	if err != nil {
-		return toAddr, fmt.Errorf("error retrieving the max transfer amount: %w", err)
+	// Check the destination domain can still be used to avoid locking funds.
+	if err := types.ValidateDestinationDomain(account.DestinationDomain, k.GetDomain(ctx, account.DestinationDomain)); err != nil {
+		return toAddr, types.ErrInvalidDestinationDomain.Wrap(err.Error())
+	}
+
+	// Check coins contains only the minting denom, unless the account forwards the other
+	// denoms to the fallback recipient.
+	mintingDenom := k.ftfKeeper.GetMintingDenom(ctx).Denom
+	found, mintingCoin := coins.Find(mintingDenom)
+	hasOtherDenoms := len(coins) > 1 || (len(coins) == 1 && !found)
+	if len(coins) == 0 || (hasOtherDenoms && !account.ForwardOtherDenoms) {
+		return toAddr, fmt.Errorf("autocctp accounts can only receive %s coins", mintingDenom)
 	}
-	finalBalance := k.bankKeeper.GetBalance(ctx, toAddr, mintingDenom).AddAmount(mintingDenomAmount)
-	if finalBalance.Amount.GT(maxTransferAmount) {
-		return toAddr, types.ErrInvalidTransferAmount.Wrapf("resulting balance cannot exceed %s", maxTransferAmount)
+
+	if found {
+		if err := k.validateDeposit(ctx, toAddr, mintingCoin); err != nil {
+			return toAddr, err
+		}
 	}
 
 	// State transition
 
-	if err = k.PendingTransfers.Set(ctx, account.Address, *account); err != nil {
-		k.logger.Error(`unable to set account for pending transfer`,
-			"account", account.Address,
-			"amount", coins.AmountOf(mintingDenom).String(),
-			"error", err,
-		)
+	if found {
+		if err = k.AddPendingTransfer(ctx, *account); err != nil {
+			k.logger.Error(`unable to set account for pending transfer`,
+				"account", account.Address,
+				"amount", mintingCoin.Amount.String(),
+				"error", err,
+			)
+		}
+		// The pending transfers are lost if the deposit is executed after the end block of
+		// the module, so the account is also marked to be checked in the next block.
+		if executed, _ := k.TransfersExecuted.Get(ctx); executed {
+			if err = k.DirtyAccounts.Set(ctx, account.Address); err != nil {
+				k.logger.Error(`unable to mark account as dirty`,
+					"account", account.Address,
+					"error", err,
+				)
+			}
+		}
+	}
+
+	if hasOtherDenoms {
+		if err = k.PendingForwards.Set(ctx, account.Address, *account); err != nil {
+			k.logger.Error(`unable to set account for pending forward`,
+				"account", account.Address,
+				"error", err,
+			)
+		}
 	}
 
 	return toAddr, nil
 }
 
+// validateDeposit returns an error if the deposit of the minting denom coin to the AutoCCTP
+// account cannot be transferred via CCTP.
+func (k *Keeper) validateDeposit(ctx context.Context, toAddr sdk.AccAddress, coin sdk.Coin) error {
+	// Check on the minimum transferable amount.
+	minimumTransferAmount := k.GetMinimumTransferAmount(ctx)
+	if coin.Amount.LT(minimumTransferAmount) {
+		return types.ErrInvalidTransferAmount.Wrapf("cannot be lower than %s", minimumTransferAmount.String())
+	}
+
+	// Check on maximum transferable amount, unless the balance can be transferred in chunks.
+	if !k.GetParams(ctx).SplitOversizedTransfers {
+		maxTransferAmount, err := k.getMaxTransferAmount(ctx, coin.Denom)
+		if err != nil {
+			return fmt.Errorf("error retrieving the max transfer amount: %w", err)
+		}
+		finalBalance := k.bankKeeper.GetBalance(ctx, toAddr, coin.Denom).Add(coin)
+		if finalBalance.Amount.GT(maxTransferAmount) {
+			return types.ErrInvalidTransferAmount.Wrapf("resulting balance cannot exceed %s", maxTransferAmount)
+		}
+	}
+
+	return nil
+}
+
 func (k *Keeper) getMaxTransferAmount(ctx context.Context, denom string) (math.Int, error) {
 	resp, err := k.cctpService.PerMessageBurnLimit(ctx, &cctptypes.QueryGetPerMessageBurnLimitRequest{
 		Denom: denom,
@@ -186,10 +488,15 @@ func (k *Keeper) getMaxTransferAmount(ctx context.Context, denom string) (math.I
 	return resp.BurnLimit.Amount, nil
 }
 
-// registerAccount handles the AutoCCTP account registration given certain properties.
+// registerAccount handles the AutoCCTP account registration given certain properties, counting
+// whether the account has been registered signerlessly.
 //
 // CONTRACT: The function assumes properties have already been validated.
-func (k Keeper) registerAccount(ctx context.Context, accountProperties types.AccountProperties) (string, error) {
+func (k Keeper) registerAccount(ctx context.Context, accountProperties types.AccountProperties, signerlessly bool) (string, error) {
+	if k.isBlacklisted(ctx, accountProperties.FallbackRecipient) {
+		return "", types.ErrBlacklisted.Wrapf("fallback recipient %s", accountProperties.FallbackRecipient)
+	}
+
 	address := types.GenerateAddress(accountProperties)
 
 	if k.accountKeeper.HasAccount(ctx, address) {
@@ -204,9 +511,18 @@ func (k Keeper) registerAccount(ctx context.Context, accountProperties types.Acc
 			rawAccount = types.NewAccount(account, accountProperties)
 			k.accountKeeper.SetAccount(ctx, rawAccount)
 
+			if err := k.SetAccountIndexes(ctx, rawAccount.(*types.Account)); err != nil {
+				return "", err
+			}
 			if err := k.IncrementNumOfAccounts(ctx, accountProperties.DestinationDomain); err != nil {
 				return "", err
 			}
+			if err := k.IncrementRegistrations(ctx, accountProperties.DestinationDomain, signerlessly); err != nil {
+				return "", err
+			}
+			if err := k.Hooks().AfterAccountRegistered(ctx, *rawAccount.(*types.Account)); err != nil {
+				return "", err
+			}
 		case *types.Account:
 			return "", errors.New("account has already been registered")
 		default:
@@ -215,9 +531,9 @@ func (k Keeper) registerAccount(ctx context.Context, accountProperties types.Acc
 
 		mintingToken := k.ftfKeeper.GetMintingDenom(ctx)
 		accountBalance := k.bankKeeper.GetBalance(ctx, address, mintingToken.Denom)
-		if accountBalance.Amount.GTE(types.GetMinimumTransferAmount()) {
+		if accountBalance.Amount.GTE(k.GetMinimumTransferAmount(ctx)) {
 			account, _ := rawAccount.(*types.Account)
-			if err := k.PendingTransfers.Set(ctx, address.String(), *account); err != nil {
+			if err := k.AddPendingTransfer(ctx, *account); err != nil {
 				k.logger.Error("error registering pending transfer for address %s", address.String())
 			}
 		}
@@ -231,24 +547,41 @@ func (k Keeper) registerAccount(ctx context.Context, accountProperties types.Acc
 	account := types.NewAccount(baseAccount, accountProperties)
 
 	k.accountKeeper.SetAccount(ctx, account)
+	if err := k.SetAccountIndexes(ctx, account); err != nil {
+		return "", err
+	}
 	if err := k.IncrementNumOfAccounts(ctx, accountProperties.DestinationDomain); err != nil {
 		return "", err
 	}
+	if err := k.IncrementRegistrations(ctx, accountProperties.DestinationDomain, signerlessly); err != nil {
+		return "", err
+	}
+	if err := k.Hooks().AfterAccountRegistered(ctx, *account); err != nil {
+		return "", err
+	}
 
 	return address.String(), nil
 }
 
 // clearAccount handles the clearing of an account's balance either by marking it for
 // clearing via a CCTP transfer or directly sending the funds to the fallback address.
+// The reason is reported in the emitted event when the funds are sent to the fallback address.
 //
 // Returns an error if the marking or transfer fails.
-func (k Keeper) clearAccount(ctx context.Context, account *types.Account, coins sdk.Coins, isFallbackTransfer bool) error {
+func (k Keeper) clearAccount(ctx context.Context, account *types.Account, coins sdk.Coins, isFallbackTransfer bool, reason types.ClearingReason) error {
 	if !isFallbackTransfer {
-		if err := k.PendingTransfers.Set(ctx, account.Address, *account); err != nil {
+		if err := k.AddPendingTransfer(ctx, *account); err != nil {
 			return errorsmod.Wrap(err, "failed registering the address into pending transfers")
 		}
-		// No event emitted here because the pending transfer clearing can still fail.
-		return nil
+		// The outcome of the transfer is reported by the TransferExecuted or TransferFailed
+		// event emitted when executing the transfer at the end of the block.
+		mintingToken := k.ftfKeeper.GetMintingDenom(ctx)
+		return k.eventService.EventManager(ctx).Emit(ctx, &types.TransferRetryRequested{
+			Address:           account.Address,
+			DestinationDomain: account.DestinationDomain,
+			Amount:            coins.AmountOf(mintingToken.Denom),
+			Denom:             mintingToken.Denom,
+		})
 	}
 
 	fallbackRecipientBz, err := k.accountKeeper.AddressCodec().StringToBytes(account.FallbackRecipient)
@@ -264,8 +597,140 @@ func (k Keeper) clearAccount(ctx context.Context, account *types.Account, coins
 		return errorsmod.Wrap(err, "failed to clear balance to fallback account")
 	}
 
+	if err := k.RemoveFailedTransfer(ctx, account.Address); err != nil {
+		return err
+	}
+	if err := k.OwedFees.Remove(ctx, account.Address); err != nil {
+		return errorsmod.Wrap(err, "failed removing the owed transfer fee")
+	}
+	if err := k.updateAwaitingTransfer(ctx, account.Address); err != nil {
+		return err
+	}
+
+	for _, coin := range coins {
+		if err := k.AddTransferRecord(ctx, account.Address, coin, 0, types.TransferOutcomeFallback, nil); err != nil {
+			return err
+		}
+	}
+	mintingToken := k.ftfKeeper.GetMintingDenom(ctx)
+	if err := k.IncrementFallbackClears(ctx, account.DestinationDomain, coins.AmountOf(mintingToken.Denom)); err != nil {
+		return err
+	}
+	if err := k.Hooks().AfterAccountCleared(ctx, *account, coins, reason); err != nil {
+		return err
+	}
+
 	return k.eventService.EventManager(ctx).Emit(ctx, &types.AccountCleared{
 		Address:  account.Address,
 		Receiver: account.FallbackRecipient,
+		Reason:   reason,
 	})
 }
+
+// deregisterAccount sends all the balances of the AutoCCTP account to the fallback recipient
+// and replaces the account with an inert record, which is removed from the indexes and cannot
+// receive funds nor be registered again. The reason is reported in the clearing event.
+func (k Keeper) deregisterAccount(ctx context.Context, account *types.Account, reason types.ClearingReason) error {
+	addressBz, err := k.accountKeeper.AddressCodec().StringToBytes(account.Address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("failed to decode autocctp address: %s", err)
+	}
+
+	balances := k.bankKeeper.GetAllBalances(ctx, addressBz)
+	if !balances.IsZero() {
+		if err := k.clearAccount(ctx, account, balances, true, reason); err != nil {
+			return err
+		}
+	}
+
+	if err := k.RemoveFailedTransfer(ctx, account.Address); err != nil {
+		return err
+	}
+	if err := k.RemovePendingTransfer(ctx, account.Address); err != nil {
+		return errorsmod.Wrap(err, "failed removing the address from pending transfers")
+	}
+	if err := k.PendingForwards.Remove(ctx, account.Address); err != nil {
+		return errorsmod.Wrap(err, "failed removing the address from pending forwards")
+	}
+	if err := k.RemoveFailedForward(ctx, account.Address); err != nil {
+		return err
+	}
+	if err := k.OwedFees.Remove(ctx, account.Address); err != nil {
+		return errorsmod.Wrap(err, "failed removing the owed transfer fee")
+	}
+	if err := k.RateLimitedTransfers.Remove(ctx, account.Address); err != nil {
+		return errorsmod.Wrap(err, "failed removing the address from rate limited transfers")
+	}
+	if err := k.AwaitingTransfers.Remove(ctx, account.Address); err != nil {
+		return errorsmod.Wrap(err, "failed removing the address from awaiting transfers")
+	}
+	if err := k.RemoveQueuedTransfer(ctx, account.Address); err != nil {
+		return err
+	}
+
+	account.Deregistered = true
+	account.FallbackPolicy = nil
+	account.ForwardOtherDenoms = false
+	k.accountKeeper.SetAccount(ctx, account)
+
+	if err := k.RemoveAccountIndexes(ctx, account); err != nil {
+		return err
+	}
+
+	return k.DecrementNumOfAccounts(ctx, account.DestinationDomain)
+}
+
+// isBlacklisted returns true if the address is blacklisted in the fiat-tokenfactory.
+func (k Keeper) isBlacklisted(ctx context.Context, address string) bool {
+	addressBz, err := k.accountKeeper.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return false
+	}
+
+	_, found := k.ftfKeeper.GetBlacklisted(ctx, addressBz)
+	return found
+}
+
+// blacklistReason returns the reason why the transfers of the AutoCCTP account are refused
+// because one of the parties is blacklisted in the fiat-tokenfactory, or
+// ComplianceReasonUnspecified if none is blacklisted.
+func (k Keeper) blacklistReason(ctx context.Context, account types.Account) types.ComplianceReason {
+	switch {
+	case k.isBlacklisted(ctx, account.Address):
+		return types.ComplianceReasonAccountBlacklisted
+	case k.isBlacklisted(ctx, account.FallbackRecipient):
+		return types.ComplianceReasonFallbackBlacklisted
+	default:
+		return types.ComplianceReasonUnspecified
+	}
+}
+
+// resumeAwaitingTransfers starts the resumption, from the first awaiting transfer, of the
+// transfers deferred while the automatic transfers were paused. The awaiting transfers are
+// marked for clearing in batches at the beginning of the following blocks.
+func (k Keeper) resumeAwaitingTransfers(ctx context.Context) error {
+	if err := k.ResumeCursor.Set(ctx, ""); err != nil {
+		return fmt.Errorf("error resuming the awaiting transfers: %w", err)
+	}
+
+	return nil
+}
+
+// markAwaitingTransfer marks for clearing the AutoCCTP account if it holds at least the
+// minimum transfer amount and its destination domain is not paused, returning true if marked.
+func (k Keeper) markAwaitingTransfer(ctx context.Context, address string) (bool, error) {
+	addressBz, err := k.accountKeeper.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return false, err
+	}
+	account, ok := k.accountKeeper.GetAccount(ctx, addressBz).(*types.Account)
+	if !ok || account.Deregistered || k.IsPaused(ctx, account.DestinationDomain) {
+		return false, nil
+	}
+	denom := k.ftfKeeper.GetMintingDenom(ctx).Denom
+	if k.bankKeeper.GetBalance(ctx, addressBz, denom).Amount.LT(k.GetMinimumTransferAmount(ctx)) {
+		return false, nil
+	}
+
+	return true, k.AddPendingTransfer(ctx, *account)
+}
@@ -25,26 +25,49 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"autocctp.dev/keeper"
 	"autocctp.dev/testutil"
 	"autocctp.dev/testutil/mocks"
 	"autocctp.dev/types"
 )
 
 func TestValidateAccountProperties(t *testing.T) {
-	_, k, _ := mocks.AutoCCTPKeeper(t)
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
 	validProperties := testutil.ValidProperties(false)
 	validPropertiesWithCaller := testutil.ValidProperties(true)
 
 	invalidFallbackRecipient := "cosmos1y5azhw4a99s4tm4kwzfwus52tjlvsaywuq3q3m"
 
+	// Destination domain 1 does not allow the destination caller.
+	domain := k.GetDomain(ctx, 1)
+	domain.DestinationCallerAllowed = false
+	require.NoError(t, k.SetDomain(ctx, *domain))
+
 	testCases := []struct {
 		name        string
 		setup       func(*types.AccountProperties)
 		withCaller  bool
 		errContains string
 	}{
+		{
+			name: "fail when the destination domain is not registered",
+			setup: func(ap *types.AccountProperties) {
+				ap.DestinationDomain = 11
+			},
+			withCaller:  false,
+			errContains: types.ErrInvalidDestinationDomain.Error(),
+		},
+		{
+			name: "fail when the destination domain is noble",
+			setup: func(ap *types.AccountProperties) {
+				ap.DestinationDomain = uint32(types.NOBLE)
+			},
+			withCaller:  false,
+			errContains: types.ErrInvalidDestinationDomain.Error(),
+		},
 		{
 			name: "fail when the mint recipient is not valid",
 			setup: func(ap *types.AccountProperties) {
@@ -77,6 +100,14 @@ func TestValidateAccountProperties(t *testing.T) {
 			withCaller:  true,
 			errContains: types.ErrInvalidDestinationCaller.Error(),
 		},
+		{
+			name: "fail when the destination caller is not allowed for the domain",
+			setup: func(ap *types.AccountProperties) {
+				ap.DestinationDomain = 1
+			},
+			withCaller:  true,
+			errContains: types.ErrInvalidDestinationCaller.Error(),
+		},
 		{
 			name:        "success with valid properties no destination caller",
 			setup:       func(ap *types.AccountProperties) {},
@@ -99,7 +130,7 @@ func TestValidateAccountProperties(t *testing.T) {
 			}
 			tC.setup(&properties)
 
-			err := k.ValidateAccountProperties(properties)
+			err := k.ValidateAccountProperties(ctx, properties)
 			if tC.errContains == "" {
 				require.NoError(t, err, "expected no error executing the validation")
 			} else {
@@ -171,7 +202,7 @@ func TestSendRestrictionFn(t *testing.T) {
 			setup: func(m *mocks.Mocks) {
 				m.AccountKeeper.Accounts[acc.GetAddress().String()] = &acc
 			},
-			coins:              sdk.NewCoins(sdk.NewInt64Coin("uusdc", types.GetMinimumTransferAmount().Int64()-1)),
+			coins:              sdk.NewCoins(sdk.NewInt64Coin("uusdc", types.DefaultParams().MinimumTransferAmount.Int64()-1)),
 			expPendingTransfer: false,
 			errContains:        types.ErrInvalidTransferAmount.Error(),
 		},
@@ -194,12 +225,23 @@ func TestSendRestrictionFn(t *testing.T) {
 			expPendingTransfer: false,
 			errContains:        types.ErrInvalidTransferAmount.Error(),
 		},
+		{
+			name: "invalid when the account is deregistered",
+			setup: func(m *mocks.Mocks) {
+				deregistered := acc
+				deregistered.Deregistered = true
+				m.AccountKeeper.Accounts[acc.GetAddress().String()] = &deregistered
+			},
+			coins:              sdk.NewCoins(sdk.NewInt64Coin("uusdc", types.DefaultParams().MinimumTransferAmount.Int64())),
+			expPendingTransfer: false,
+			errContains:        types.ErrAccountDeregistered.Error(),
+		},
 		{
 			name: "valid when correct denom and amount",
 			setup: func(m *mocks.Mocks) {
 				m.AccountKeeper.Accounts[acc.GetAddress().String()] = &acc
 			},
-			coins:              sdk.NewCoins(sdk.NewInt64Coin("uusdc", types.GetMinimumTransferAmount().Int64())),
+			coins:              sdk.NewCoins(sdk.NewInt64Coin("uusdc", types.DefaultParams().MinimumTransferAmount.Int64())),
 			expPendingTransfer: true,
 			errContains:        "",
 		},
@@ -223,7 +265,7 @@ func TestSendRestrictionFn(t *testing.T) {
 				require.ErrorContains(t, err, tC.errContains, "expected a different error")
 			}
 
-			_, err = k.PendingTransfers.Get(ctx, acc.GetAddress().String())
+			_, err = k.GetPendingTransfer(ctx, acc.GetAddress().String())
 			if tC.expPendingTransfer {
 				require.NoError(t, err, "expected no error retrieving pending transfer")
 			} else {
@@ -232,3 +274,207 @@ func TestSendRestrictionFn(t *testing.T) {
 		})
 	}
 }
+
+func TestSendRestrictionFn_MinimumTransferAmountParam(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.GetAddress().String()] = &acc
+
+	err := k.SetParams(ctx, types.NewParams(math.NewInt(1_000_000), types.DefaultMaxTransferAttempts, types.DefaultRetryBaseDelay, types.DefaultRetryMaxDelay, false, types.DefaultMaxTransferHistory))
+	require.NoError(t, err, "expected no error setting the params")
+
+	// ACT: The default minimum is no more enough.
+	_, err = k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("uusdc", 999_999)))
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the amount is lower than the param")
+	require.ErrorContains(t, err, types.ErrInvalidTransferAmount.Error(), "expected a different error")
+
+	// ACT
+	_, err = k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000)))
+
+	// ASSERT
+	require.NoError(t, err, "expected no error when the amount is equal to the param")
+	_, err = k.GetPendingTransfer(ctx, acc.Address)
+	require.NoError(t, err, "expected the account to be marked for a pending transfer")
+}
+
+func TestSendRestrictionFn_Expiration(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	acc := testutil.AutoCCTPAccount(false)
+	acc.ExpirationHeight = 10
+	m.AccountKeeper.Accounts[acc.GetAddress().String()] = &acc
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", types.DefaultParams().MinimumTransferAmount.Int64()))
+
+	// ACT
+	_, err := k.SendRestrictionFn(ctx.WithBlockHeight(9), sdk.AccAddress{}, acc.GetAddress(), coins)
+
+	// ASSERT
+	require.NoError(t, err, "expected no error before the expiration")
+
+	// ACT: The account is expired but not yet pruned.
+	_, err = k.SendRestrictionFn(ctx.WithBlockHeight(10), sdk.AccAddress{}, acc.GetAddress(), coins)
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the account is expired")
+	require.ErrorContains(t, err, types.ErrAccountExpired.Error(), "expected a different error")
+}
+
+func TestSendRestrictionFn_Blacklisted(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.GetAddress().String()] = &acc
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", types.DefaultParams().MinimumTransferAmount.Int64()))
+	m.FTFKeeper.Blacklisted[acc.Address] = true
+
+	// ACT
+	_, err := k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), coins)
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the account is blacklisted")
+	require.ErrorContains(t, err, types.ComplianceReasonAccountBlacklisted.String(), "expected a different reason")
+
+	// ARRANGE
+	delete(m.FTFKeeper.Blacklisted, acc.Address)
+	m.FTFKeeper.Blacklisted[acc.FallbackRecipient] = true
+
+	// ACT
+	_, err = k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), coins)
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the fallback recipient is blacklisted")
+	require.ErrorContains(t, err, types.ComplianceReasonFallbackBlacklisted.String(), "expected a different reason")
+	has, err := k.HasPendingTransfer(ctx, acc.Address)
+	require.NoError(t, err)
+	require.False(t, has, "expected no pending transfer")
+}
+
+func TestSendRestrictionFn_DisabledDomain(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.GetAddress().String()] = &acc
+
+	domain := k.GetDomain(ctx, acc.DestinationDomain)
+	require.NotNil(t, domain, "expected the account domain to be registered")
+	domain.Enabled = false
+	require.NoError(t, k.SetDomain(ctx, *domain))
+
+	// ACT
+	_, err := k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000)))
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the destination domain is disabled")
+	require.ErrorContains(t, err, types.ErrInvalidDestinationDomain.Error(), "expected a different error")
+	_, err = k.GetPendingTransfer(ctx, acc.Address)
+	require.Error(t, err, "expected no pending transfer")
+}
+
+func TestSendRestrictionFn_SplitOversizedTransfersParam(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.GetAddress().String()] = &acc
+	oversized := sdk.NewCoins(sdk.NewInt64Coin("uusdc", m.CCTPServer.MaxTransferAmount+1))
+
+	// ACT: Oversized deposits are rejected by default.
+	_, err := k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), oversized)
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the amount exceeds the burn limit")
+	require.ErrorContains(t, err, types.ErrInvalidTransferAmount.Error(), "expected a different error")
+
+	// ARRANGE
+	params := k.GetParams(ctx)
+	params.SplitOversizedTransfers = true
+	require.NoError(t, k.SetParams(ctx, params), "expected no error setting the params")
+
+	// ACT
+	_, err = k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), oversized)
+
+	// ASSERT
+	require.NoError(t, err, "expected no error when oversized transfers are split")
+	_, err = k.GetPendingTransfer(ctx, acc.Address)
+	require.NoError(t, err, "expected the account to be marked for a pending transfer")
+}
+
+func TestSendRestrictionFn_ForwardOtherDenoms(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	acc := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[acc.GetAddress().String()] = &acc
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000), sdk.NewInt64Coin("uatom", 1_000))
+
+	// ACT: Other denoms are rejected by default.
+	_, err := k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), coins)
+
+	// ASSERT
+	require.Error(t, err, "expected an error when receiving other denoms")
+	require.ErrorContains(t, err, "can only receive uusdc coins", "expected a different error")
+
+	// ARRANGE
+	acc.ForwardOtherDenoms = true
+
+	// ACT
+	_, err = k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), coins)
+
+	// ASSERT: The account is marked both for the transfer and for the forward.
+	require.NoError(t, err, "expected no error when the account forwards other denoms")
+	has, err := k.HasPendingTransfer(ctx, acc.Address)
+	require.NoError(t, err)
+	require.True(t, has, "expected a pending transfer")
+	has, err = k.PendingForwards.Has(ctx, acc.Address)
+	require.NoError(t, err)
+	require.True(t, has, "expected a pending forward")
+
+	// ARRANGE
+	require.NoError(t, k.ClearPendingTransfers(ctx))
+
+	// ACT: Only other denoms are received.
+	_, err = k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+
+	// ASSERT
+	require.NoError(t, err, "expected no error when receiving only other denoms")
+	has, err = k.HasPendingTransfer(ctx, acc.Address)
+	require.NoError(t, err)
+	require.False(t, has, "expected no pending transfer")
+
+	// ACT: The minting denom amount is still validated.
+	_, err = k.SendRestrictionFn(ctx, sdk.AccAddress{}, acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1), sdk.NewInt64Coin("uatom", 1)))
+
+	// ASSERT
+	require.ErrorContains(t, err, types.ErrInvalidTransferAmount.Error(), "expected the minimum transfer amount to be checked")
+}
+
+func TestSetHooks(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	hooks := mocks.NewAutoCCTPHooks()
+	server := keeper.NewMsgServer(k)
+	properties := testutil.ValidProperties(false)
+
+	// ACT
+	k.SetHooks(hooks)
+
+	// ASSERT
+	require.Panics(t, func() { k.SetHooks(hooks) }, "expected a panic when setting the hooks twice")
+
+	// ACT
+	resp, err := server.RegisterAccount(ctx, &types.MsgRegisterAccount{
+		Signer:            testutil.NobleAddress(),
+		DestinationDomain: properties.DestinationDomain,
+		MintRecipient:     properties.MintRecipient,
+		FallbackRecipient: properties.FallbackRecipient,
+	})
+	require.NoError(t, err, "expected no error registering the account")
+	m.BankKeeper.Balances[resp.Address] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000))
+	_, err = server.ClearAccount(ctx, &types.MsgClearAccount{Signer: properties.FallbackRecipient, Address: resp.Address, Fallback: true})
+	require.NoError(t, err, "expected no error clearing the account")
+
+	// ASSERT
+	require.Equal(t, 1, hooks.Calls["AfterAccountRegistered"], "expected the registration hook to be called")
+	require.Equal(t, 1, hooks.Calls["AfterAccountCleared"], "expected the clearing hook to be called")
+}
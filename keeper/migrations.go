@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"autocctp.dev/types"
+)
+
+// Migrator handles the in-place store migrations of the AutoCCTP module.
+type Migrator struct {
+	keeper *Keeper
+}
+
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the module state from consensus version 1 to 2.
+//
+// The registry of supported destination domains is seeded with the default domains if
+// missing, so that the existing accounts keep targeting a supported domain.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	domains, err := m.keeper.GetDomains(ctx)
+	if err != nil {
+		return err
+	}
+	if len(domains) != 0 {
+		return nil
+	}
+
+	for _, domain := range types.DefaultDomains() {
+		if err := m.keeper.SetDomain(ctx, domain); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates the module state from consensus version 2 to 3.
+//
+// The secondary account indexes are backfilled from the accounts stored in the auth module.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.RebuildAccountIndexes(ctx)
+}
+
+// Migrate3to4 migrates the module state from consensus version 3 to 4.
+//
+// The total amounts transferred, previously stored as uint64, are converted to math.Int.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	builder := collections.NewSchemaBuilder(m.keeper.storeService)
+	legacyTotals := collections.NewMap(builder, types.TotalTransferredPrefix, "total_transferred", collections.Uint32Key, collections.Uint64Value)
+	if _, err := builder.Build(); err != nil {
+		return err
+	}
+
+	// The entries are collected first, as they are overwritten in place.
+	iter, err := legacyTotals.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	entries, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := m.keeper.TotalTransferred.Set(ctx, entry.Key, math.NewIntFromUint64(entry.Value)); err != nil {
+			return fmt.Errorf("error migrating the total transferred: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Migrate4to5 migrates the module state from consensus version 4 to 5.
+//
+// The awaiting transfers are backfilled with the registered accounts holding at least the
+// minimum transfer amount.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	iter, err := m.keeper.AccountsByDestinationDomain.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := m.keeper.updateAwaitingTransfer(ctx, key.K2()); err != nil {
+			return fmt.Errorf("error migrating the awaiting transfers: %w", err)
+		}
+	}
+
+	return nil
+}
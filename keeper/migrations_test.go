@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"autocctp.dev/keeper"
+	"autocctp.dev/testutil"
+	"autocctp.dev/testutil/mocks"
+	"autocctp.dev/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	// ARRANGE: Set up the state of consensus version 1.
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	require.NoError(t, k.Domains.Clear(ctx, nil), "expected no error removing the domains")
+
+	// ACT
+	err := keeper.NewMigrator(k).Migrate1to2(ctx)
+
+	// ASSERT
+	require.NoError(t, err, "expected no error migrating the state")
+	domains, err := k.GetDomains(ctx)
+	require.NoError(t, err, "expected no error getting the domains")
+	require.Equal(t, types.DefaultDomains(), domains, "expected the default domains to be stored")
+}
+
+func TestMigrate1to2_KeepsExistingDomains(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	require.NoError(t, k.Domains.Clear(ctx, nil), "expected no error removing the domains")
+	domain := types.NewDomainConfig(types.ETHEREUM, "Ethereum", types.AddressEncodingHex)
+	require.NoError(t, k.SetDomain(ctx, domain), "expected no error setting the domain")
+
+	// ACT
+	err := keeper.NewMigrator(k).Migrate1to2(ctx)
+
+	// ASSERT
+	require.NoError(t, err, "expected no error migrating the state")
+	domains, err := k.GetDomains(ctx)
+	require.NoError(t, err, "expected no error getting the domains")
+	require.Equal(t, []types.DomainConfig{domain}, domains, "expected the domains to be kept")
+}
+
+func TestMigrate2to3(t *testing.T) {
+	// ARRANGE: Set up the state of consensus version 2.
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	account := testutil.AutoCCTPAccount(false)
+	m.AccountKeeper.Accounts[account.Address] = &account
+
+	// ACT
+	err := keeper.NewMigrator(k).Migrate2to3(ctx)
+
+	// ASSERT
+	require.NoError(t, err, "expected no error migrating the state")
+	has, err := k.AccountsByDestinationDomain.Has(ctx, collections.Join(account.DestinationDomain, account.Address))
+	require.NoError(t, err, "expected no error checking the account index")
+	require.True(t, has, "expected the account indexes to be backfilled")
+}
+
+func TestMigrate3to4(t *testing.T) {
+	// ARRANGE: Set up the state of consensus version 3.
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+
+	builder := collections.NewSchemaBuilder(m.StoreService)
+	totalTransferred := collections.NewMap(builder, types.TotalTransferredPrefix, "total_transferred", collections.Uint32Key, collections.Uint64Value)
+	_, err := builder.Build()
+	require.NoError(t, err, "expected no error building the legacy schema")
+	require.NoError(t, totalTransferred.Set(ctx, 0, 3_000), "expected no error setting the legacy total transferred")
+	require.NoError(t, totalTransferred.Set(ctx, 1, 2_000), "expected no error setting the legacy total transferred")
+
+	// ACT
+	err = keeper.NewMigrator(k).Migrate3to4(ctx)
+
+	// ASSERT
+	require.NoError(t, err, "expected no error migrating the state")
+
+	totals, err := k.GetTotalTransferredPerDestination(ctx)
+	require.NoError(t, err, "expected no error getting the total transferred")
+	require.Equal(t, map[uint32]math.Int{0: math.NewInt(3_000), 1: math.NewInt(2_000)}, totals, "expected the total transferred to be converted")
+
+	// ACT: Totals above the uint64 range are accumulated after the migration.
+	err = k.IncrementTotalTransferred(ctx, 0, math.NewIntFromUint64(^uint64(0)))
+
+	// ASSERT
+	require.NoError(t, err, "expected no error incrementing the total transferred")
+	total, err := k.TotalTransferred.Get(ctx, 0)
+	require.NoError(t, err, "expected no error getting the total transferred")
+	require.Equal(t, "18446744073709554615", total.String(), "expected the total to exceed the uint64 range")
+}
+
+func TestMigrate4to5(t *testing.T) {
+	// ARRANGE: Set up the state of consensus version 4.
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	minimumTransferAmount := k.GetMinimumTransferAmount(ctx)
+	accounts := make([]types.Account, 2)
+	for i, balance := range []math.Int{minimumTransferAmount.SubRaw(1), minimumTransferAmount} {
+		accounts[i] = testutil.AutoCCTPAccount(false)
+		m.AccountKeeper.Accounts[accounts[i].Address] = &accounts[i]
+		m.BankKeeper.Balances[accounts[i].Address] = sdk.NewCoins(sdk.NewCoin("uusdc", balance))
+		require.NoError(t, k.SetAccountIndexes(ctx, &accounts[i]), "expected no error indexing the account")
+	}
+
+	// ACT
+	err := keeper.NewMigrator(k).Migrate4to5(ctx)
+
+	// ASSERT
+	require.NoError(t, err, "expected no error migrating the state")
+	awaiting, err := k.GetAwaitingTransfers(ctx)
+	require.NoError(t, err, "expected no error getting the awaiting transfers")
+	require.Equal(t, []string{accounts[1].Address}, awaiting, "expected only the account holding the minimum transfer amount")
+}
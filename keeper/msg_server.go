@@ -44,12 +44,15 @@ func NewMsgServer(keeper *Keeper) types.MsgServer {
 func (ms msgServer) RegisterAccount(ctx context.Context, msg *types.MsgRegisterAccount) (*types.MsgRegisterAccountResponse, error) {
 	// Message inputs validation.
 	accountProperties := msg.GetAccountProperties()
-	if err := ms.ValidateAccountProperties(accountProperties); err != nil {
+	if err := ms.ValidateAccountProperties(ctx, accountProperties); err != nil {
+		return nil, types.ErrInvalidAccountProperties.Wrap(err.Error())
+	}
+	if err := ms.ValidateAccountExpiration(ctx, accountProperties); err != nil {
 		return nil, types.ErrInvalidAccountProperties.Wrap(err.Error())
 	}
 
 	// State transition logic.
-	address, err := ms.registerAccount(ctx, accountProperties)
+	address, err := ms.registerAccount(ctx, accountProperties, false)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to register the account")
 	}
@@ -60,6 +63,10 @@ func (ms msgServer) RegisterAccount(ctx context.Context, msg *types.MsgRegisterA
 		MintRecipient:     msg.MintRecipient,
 		FallbackRecipient: msg.FallbackRecipient,
 		DestinationCaller: msg.DestinationCaller,
+		ExpirationHeight:  msg.ExpirationHeight,
+		ExpirationTime:    msg.ExpirationTime,
+		AddressVersion:    msg.AddressVersion,
+		Salt:              msg.Salt,
 		Signerlessly:      false,
 	})
 }
@@ -73,12 +80,15 @@ func (ms msgServer) RegisterAccountSignerlessly(ctx context.Context, msg *types.
 	}
 
 	accountProperties := msg.GetAccountProperties()
-	if err := ms.ValidateAccountProperties(accountProperties); err != nil {
+	if err := ms.ValidateAccountProperties(ctx, accountProperties); err != nil {
+		return nil, types.ErrInvalidAccountProperties.Wrap(err.Error())
+	}
+	if err := ms.ValidateAccountExpiration(ctx, accountProperties); err != nil {
 		return nil, types.ErrInvalidAccountProperties.Wrap(err.Error())
 	}
 
 	// State transition logic.
-	address, err := ms.registerAccount(ctx, accountProperties)
+	address, err := ms.registerAccount(ctx, accountProperties, true)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to register then account signerlessly")
 	}
@@ -89,6 +99,10 @@ func (ms msgServer) RegisterAccountSignerlessly(ctx context.Context, msg *types.
 		MintRecipient:     msg.MintRecipient,
 		FallbackRecipient: msg.FallbackRecipient,
 		DestinationCaller: msg.DestinationCaller,
+		ExpirationHeight:  msg.ExpirationHeight,
+		ExpirationTime:    msg.ExpirationTime,
+		AddressVersion:    msg.AddressVersion,
+		Salt:              msg.Salt,
 		Signerlessly:      true,
 	})
 }
@@ -114,6 +128,9 @@ func (ms msgServer) ClearAccount(ctx context.Context, msg *types.MsgClearAccount
 	if !ok {
 		return nil, types.ErrInvalidClearingAccount.Wrapf("account is not an autocctp account")
 	}
+	if account.Deregistered {
+		return nil, types.ErrAccountDeregistered
+	}
 
 	if msg.Fallback && msg.Signer != account.FallbackRecipient {
 		return nil, errorstypes.ErrUnauthorized.Wrapf("msg sender must be fallback account: %s != %s", msg.Signer, account.FallbackRecipient)
@@ -126,10 +143,284 @@ func (ms msgServer) ClearAccount(ctx context.Context, msg *types.MsgClearAccount
 	}
 
 	// State transition logic.
-	err = ms.clearAccount(ctx, account, sdk.NewCoins(balance), msg.Fallback)
+	err = ms.clearAccount(ctx, account, sdk.NewCoins(balance), msg.Fallback, types.ClearingReasonManual)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to clear the account")
 	}
+	if !msg.Fallback {
+		if err := ms.IncrementManualRetries(ctx, account.DestinationDomain); err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.MsgClearAccountResponse{}, nil
 }
+
+// SetFallbackPolicy is the server entrypoint for the fallback recipient of an AutoCCTP account to
+// set, or remove, the policy used to automatically clear the account to the fallback address.
+func (ms msgServer) SetFallbackPolicy(ctx context.Context, msg *types.MsgSetFallbackPolicy) (*types.MsgSetFallbackPolicyResponse, error) {
+	// Message inputs validation
+	if msg == nil {
+		return nil, errorstypes.ErrInvalidRequest.Wrapf("msg to set a fallback policy cannot be nil")
+	}
+
+	address, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, errorstypes.ErrInvalidAddress.Wrapf("failed to decode autocctp address: %s", err.Error())
+	}
+
+	rawAccount := ms.accountKeeper.GetAccount(ctx, address)
+	if rawAccount == nil {
+		return nil, errorstypes.ErrNotFound.Wrapf("account does not exist")
+	}
+	account, ok := rawAccount.(*types.Account)
+	if !ok {
+		return nil, errorstypes.ErrInvalidType.Wrapf("account is not an autocctp account")
+	}
+	if account.Deregistered {
+		return nil, types.ErrAccountDeregistered
+	}
+
+	if msg.Signer != account.FallbackRecipient {
+		return nil, errorstypes.ErrUnauthorized.Wrapf("msg sender must be fallback account: %s != %s", msg.Signer, account.FallbackRecipient)
+	}
+
+	if msg.FallbackPolicy != nil {
+		if err := msg.FallbackPolicy.Validate(ms.GetParams(ctx).MaxTransferAttempts); err != nil {
+			return nil, types.ErrInvalidFallbackPolicy.Wrap(err.Error())
+		}
+	}
+
+	// State transition logic.
+	account.FallbackPolicy = msg.FallbackPolicy
+	ms.accountKeeper.SetAccount(ctx, account)
+
+	return &types.MsgSetFallbackPolicyResponse{}, ms.eventService.EventManager(ctx).Emit(ctx, &types.FallbackPolicyUpdated{
+		Address:        account.Address,
+		FallbackPolicy: msg.FallbackPolicy,
+	})
+}
+
+// SetForwardOtherDenoms is the server entrypoint for the fallback recipient to allow, or
+// disallow, an AutoCCTP account to receive denoms other than the minting denom.
+func (ms msgServer) SetForwardOtherDenoms(ctx context.Context, msg *types.MsgSetForwardOtherDenoms) (*types.MsgSetForwardOtherDenomsResponse, error) {
+	// Message inputs validation
+	if msg == nil {
+		return nil, errorstypes.ErrInvalidRequest.Wrapf("msg to set the forwarding of other denoms cannot be nil")
+	}
+
+	address, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, errorstypes.ErrInvalidAddress.Wrapf("failed to decode autocctp address: %s", err.Error())
+	}
+
+	rawAccount := ms.accountKeeper.GetAccount(ctx, address)
+	if rawAccount == nil {
+		return nil, errorstypes.ErrNotFound.Wrapf("account does not exist")
+	}
+	account, ok := rawAccount.(*types.Account)
+	if !ok {
+		return nil, errorstypes.ErrInvalidType.Wrapf("account is not an autocctp account")
+	}
+	if account.Deregistered {
+		return nil, types.ErrAccountDeregistered
+	}
+
+	if msg.Signer != account.FallbackRecipient {
+		return nil, errorstypes.ErrUnauthorized.Wrapf("msg sender must be fallback account: %s != %s", msg.Signer, account.FallbackRecipient)
+	}
+
+	// State transition logic.
+	account.ForwardOtherDenoms = msg.ForwardOtherDenoms
+	ms.accountKeeper.SetAccount(ctx, account)
+
+	return &types.MsgSetForwardOtherDenomsResponse{}, ms.eventService.EventManager(ctx).Emit(ctx, &types.ForwardOtherDenomsUpdated{
+		Address:            account.Address,
+		ForwardOtherDenoms: msg.ForwardOtherDenoms,
+	})
+}
+
+// DeregisterAccount is the server entrypoint for the fallback recipient to deregister an
+// AutoCCTP account, sending its balance to the fallback recipient.
+func (ms msgServer) DeregisterAccount(ctx context.Context, msg *types.MsgDeregisterAccount) (*types.MsgDeregisterAccountResponse, error) {
+	// Message inputs validation
+	if msg == nil {
+		return nil, errorstypes.ErrInvalidRequest.Wrapf("msg to deregister an account cannot be nil")
+	}
+
+	address, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, errorstypes.ErrInvalidAddress.Wrapf("failed to decode autocctp address: %s", err.Error())
+	}
+
+	rawAccount := ms.accountKeeper.GetAccount(ctx, address)
+	if rawAccount == nil {
+		return nil, errorstypes.ErrNotFound.Wrapf("account does not exist")
+	}
+	account, ok := rawAccount.(*types.Account)
+	if !ok {
+		return nil, errorstypes.ErrInvalidType.Wrapf("account is not an autocctp account")
+	}
+	if account.Deregistered {
+		return nil, types.ErrAccountDeregistered
+	}
+
+	if msg.Signer != account.FallbackRecipient {
+		return nil, errorstypes.ErrUnauthorized.Wrapf("msg sender must be fallback account: %s != %s", msg.Signer, account.FallbackRecipient)
+	}
+
+	// State transition logic.
+	if err := ms.deregisterAccount(ctx, account, types.ClearingReasonDeregistration); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to deregister the account")
+	}
+
+	return &types.MsgDeregisterAccountResponse{}, ms.eventService.EventManager(ctx).Emit(ctx, &types.AccountDeregistered{
+		Address:           account.Address,
+		DestinationDomain: account.DestinationDomain,
+	})
+}
+
+// UpdateParams is the server entrypoint for the authority to update the module parameters.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	// Message inputs validation
+	if msg == nil {
+		return nil, errorstypes.ErrInvalidRequest.Wrapf("msg to update params cannot be nil")
+	}
+
+	if msg.Authority != ms.authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, types.ErrInvalidParams.Wrap(err.Error())
+	}
+
+	// State transition logic.
+	// The buckets of the statistics history are not aligned to a different duration, so the
+	// history is restarted when the duration changes.
+	if ms.GetParams(ctx).StatsBucketDuration != msg.Params.StatsBucketDuration {
+		if err := ms.StatsHistory.Clear(ctx, nil); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to clear the stats history")
+		}
+	}
+	if err := ms.SetParams(ctx, msg.Params); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to update the params")
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// AddDomain is the server entrypoint for the authority to add a new destination domain to
+// the registry.
+func (ms msgServer) AddDomain(ctx context.Context, msg *types.MsgAddDomain) (*types.MsgAddDomainResponse, error) {
+	// Message inputs validation
+	if msg == nil {
+		return nil, errorstypes.ErrInvalidRequest.Wrapf("msg to add a domain cannot be nil")
+	}
+
+	if msg.Authority != ms.authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Domain.Validate(); err != nil {
+		return nil, types.ErrInvalidDestinationDomain.Wrap(err.Error())
+	}
+
+	if ms.GetDomain(ctx, msg.Domain.DestinationDomain) != nil {
+		return nil, types.ErrInvalidDestinationDomain.Wrapf("destination domain %d is already registered", msg.Domain.DestinationDomain)
+	}
+
+	// State transition logic.
+	if err := ms.SetDomain(ctx, msg.Domain); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to add the domain")
+	}
+
+	return &types.MsgAddDomainResponse{}, nil
+}
+
+// UpdateDomain is the server entrypoint for the authority to update an existing
+// destination domain of the registry.
+func (ms msgServer) UpdateDomain(ctx context.Context, msg *types.MsgUpdateDomain) (*types.MsgUpdateDomainResponse, error) {
+	// Message inputs validation
+	if msg == nil {
+		return nil, errorstypes.ErrInvalidRequest.Wrapf("msg to update a domain cannot be nil")
+	}
+
+	if msg.Authority != ms.authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Domain.Validate(); err != nil {
+		return nil, types.ErrInvalidDestinationDomain.Wrap(err.Error())
+	}
+
+	if ms.GetDomain(ctx, msg.Domain.DestinationDomain) == nil {
+		return nil, types.ErrInvalidDestinationDomain.Wrapf("destination domain %d is not registered", msg.Domain.DestinationDomain)
+	}
+
+	// State transition logic.
+	if err := ms.SetDomain(ctx, msg.Domain); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to update the domain")
+	}
+
+	return &types.MsgUpdateDomainResponse{}, nil
+}
+
+// DisableDomain is the server entrypoint for the authority to disable an existing
+// destination domain of the registry.
+func (ms msgServer) DisableDomain(ctx context.Context, msg *types.MsgDisableDomain) (*types.MsgDisableDomainResponse, error) {
+	// Message inputs validation
+	if msg == nil {
+		return nil, errorstypes.ErrInvalidRequest.Wrapf("msg to disable a domain cannot be nil")
+	}
+
+	if msg.Authority != ms.authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	domain := ms.GetDomain(ctx, msg.DestinationDomain)
+	if domain == nil {
+		return nil, types.ErrInvalidDestinationDomain.Wrapf("destination domain %d is not registered", msg.DestinationDomain)
+	}
+
+	// State transition logic.
+	domain.Enabled = false
+	if err := ms.SetDomain(ctx, *domain); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to disable the domain")
+	}
+
+	return &types.MsgDisableDomainResponse{}, nil
+}
+
+// SetPaused is the server entrypoint for the authority to pause, or unpause, the automatic
+// transfers globally or for specific destination domains.
+func (ms msgServer) SetPaused(ctx context.Context, msg *types.MsgSetPaused) (*types.MsgSetPausedResponse, error) {
+	// Message inputs validation
+	if msg == nil {
+		return nil, errorstypes.ErrInvalidRequest.Wrapf("msg to set the pause state cannot be nil")
+	}
+
+	if msg.Authority != ms.authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	for _, destinationDomain := range msg.DestinationDomains {
+		if ms.GetDomain(ctx, destinationDomain) == nil {
+			return nil, types.ErrInvalidDestinationDomain.Wrapf("destination domain %d is not registered", destinationDomain)
+		}
+	}
+
+	// State transition logic.
+	if err := ms.Keeper.SetPaused(ctx, msg.Paused, msg.DestinationDomains...); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to set the pause state")
+	}
+
+	// Transfers deferred while paused are resumed starting from the next block.
+	if !msg.Paused {
+		if err := ms.resumeAwaitingTransfers(ctx); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to resume the deferred transfers")
+		}
+	}
+
+	return &types.MsgSetPausedResponse{}, nil
+}
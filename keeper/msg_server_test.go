@@ -22,11 +22,16 @@ package keeper_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -46,9 +51,10 @@ func TestRegisterAccount_NewAccount(t *testing.T) {
 	invalidFallbackRecipient := "cosmos1y5azhw4a99s4tm4kwzfwus52tjlvsaywuq3q3m"
 
 	testCases := []struct {
-		mode       string
-		msg        func(types.AccountProperties) interface{}
-		serverCall func(types.MsgServer, context.Context, interface{}) (string, error)
+		mode         string
+		msg          func(types.AccountProperties) interface{}
+		serverCall   func(types.MsgServer, context.Context, interface{}) (string, error)
+		signerlessly bool
 	}{
 		{
 			mode: "standard",
@@ -87,6 +93,7 @@ func TestRegisterAccount_NewAccount(t *testing.T) {
 				}
 				return resp.Address, nil
 			},
+			signerlessly: true,
 		},
 	}
 
@@ -118,7 +125,15 @@ func TestRegisterAccount_NewAccount(t *testing.T) {
 			nAccount, _ := k.NumOfAccounts.Get(ctx, accountProperties.DestinationDomain)
 			assert.Equal(t, uint64(1), nAccount, "expected only one account registered")
 
-			_, err = k.PendingTransfers.Get(ctx, customAddress.String())
+			expOutcomes := types.NewOutcomeStats()
+			if tC.signerlessly {
+				expOutcomes.SignerlessRegistrations = 1
+			} else {
+				expOutcomes.SignedRegistrations = 1
+			}
+			assert.Equal(t, expOutcomes, k.GetOutcomeStats(ctx, accountProperties.DestinationDomain), "expected the registration to be counted")
+
+			_, err = k.GetPendingTransfer(ctx, customAddress.String())
 			assert.Error(t, err, "expected no registered pending transfers")
 
 			acc, found := mocks.AccountKeeper.Accounts[customAddress.String()]
@@ -133,6 +148,140 @@ func TestRegisterAccount_NewAccount(t *testing.T) {
 	}
 }
 
+func TestRegisterAccount_Expiration(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	server := keeper.NewMsgServer(k)
+	properties := testutil.ValidProperties(false)
+	msg := &types.MsgRegisterAccount{
+		Signer:            testutil.NobleAddress(),
+		DestinationDomain: properties.DestinationDomain,
+		MintRecipient:     properties.MintRecipient,
+		FallbackRecipient: properties.FallbackRecipient,
+		ExpirationHeight:  11,
+	}
+
+	// ACT
+	_, err := server.RegisterAccount(ctx, msg)
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the address version does not support the expiration")
+	require.ErrorContains(t, err, "expiration is not supported by address version 0", "expected a different error")
+
+	// ARRANGE
+	msg.AddressVersion = types.AddressVersionV1
+	msg.ExpirationHeight = 10
+
+	// ACT
+	_, err = server.RegisterAccount(ctx, msg)
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the expiration height is reached")
+	require.ErrorContains(t, err, types.ErrInvalidExpiration.Error(), "expected a different error")
+
+	// ARRANGE
+	msg.ExpirationHeight = 0
+	msg.ExpirationTime = types.NewExpirationTime(ctx.BlockTime())
+
+	// ACT
+	_, err = server.RegisterAccount(ctx, msg)
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the expiration time is reached")
+	require.ErrorContains(t, err, types.ErrInvalidExpiration.Error(), "expected a different error")
+
+	// ARRANGE
+	msg.ExpirationHeight = 11
+	msg.ExpirationTime = types.NewExpirationTime(ctx.BlockTime().Add(time.Hour))
+
+	// ACT
+	resp, err := server.RegisterAccount(ctx, msg)
+
+	// ASSERT
+	require.NoError(t, err, "expected no error registering an account with a future expiration")
+	properties.ExpirationHeight = msg.ExpirationHeight
+	properties.ExpirationTime = *msg.ExpirationTime
+	properties.AddressVersion = types.AddressVersionV1
+	require.Equal(t, types.GenerateAddress(properties).String(), resp.Address, "expected the address to be derived from the expiration")
+
+	account, ok := m.AccountKeeper.Accounts[resp.Address].(*types.Account)
+	require.True(t, ok, "expected an autocctp account")
+	require.Equal(t, msg.ExpirationHeight, account.ExpirationHeight, "expected the expiration height to be stored")
+	require.Equal(t, msg.ExpirationTime, account.ExpirationTime, "expected the expiration time to be stored")
+	has, err := k.AccountsByExpirationHeight.Has(ctx, collections.Join(msg.ExpirationHeight, resp.Address))
+	require.NoError(t, err)
+	require.True(t, has, "expected the account to be indexed by expiration height")
+	has, err = k.AccountsByExpirationTime.Has(ctx, collections.Join(*msg.ExpirationTime, resp.Address))
+	require.NoError(t, err)
+	require.True(t, has, "expected the account to be indexed by expiration time")
+}
+
+func TestRegisterAccount_BlacklistedFallback(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewMsgServer(k)
+	properties := testutil.ValidProperties(false)
+	m.FTFKeeper.Blacklisted[properties.FallbackRecipient] = true
+	msg := &types.MsgRegisterAccount{
+		Signer:            testutil.NobleAddress(),
+		DestinationDomain: properties.DestinationDomain,
+		MintRecipient:     properties.MintRecipient,
+		FallbackRecipient: properties.FallbackRecipient,
+	}
+
+	// ACT
+	_, err := server.RegisterAccount(ctx, msg)
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the fallback recipient is blacklisted")
+	require.ErrorContains(t, err, types.ErrBlacklisted.Error(), "expected a different error")
+	require.Empty(t, m.AccountKeeper.Accounts, "expected no account to be registered")
+}
+
+func TestRegisterAccount_AddressVersion(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewMsgServer(k)
+	properties := testutil.ValidProperties(false)
+	msg := &types.MsgRegisterAccount{
+		Signer:            testutil.NobleAddress(),
+		DestinationDomain: properties.DestinationDomain,
+		MintRecipient:     properties.MintRecipient,
+		FallbackRecipient: properties.FallbackRecipient,
+		Salt:              []byte("salt"),
+	}
+
+	// ACT
+	_, err := server.RegisterAccount(ctx, msg)
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the salt is used with the address version 0")
+	require.ErrorContains(t, err, types.ErrInvalidAddressDerivation.Error(), "expected a different error")
+
+	// ARRANGE: Register the same properties with two different salts.
+	msg.AddressVersion = types.AddressVersionV1
+	otherMsg := *msg
+	otherMsg.Salt = []byte("other salt")
+
+	// ACT
+	resp, err := server.RegisterAccount(ctx, msg)
+	require.NoError(t, err, "expected no error registering the salted account")
+	otherResp, err := server.RegisterAccount(ctx, &otherMsg)
+	require.NoError(t, err, "expected no error registering the account with a different salt")
+
+	// ASSERT
+	require.NotEqual(t, resp.Address, otherResp.Address, "expected a fresh address for every salt")
+	properties.AddressVersion = msg.AddressVersion
+	properties.Salt = msg.Salt
+	require.Equal(t, types.GenerateAddress(properties).String(), resp.Address, "expected the address to be derived from the salt")
+
+	account, ok := m.AccountKeeper.Accounts[resp.Address].(*types.Account)
+	require.True(t, ok, "expected an autocctp account")
+	require.Equal(t, types.AddressVersionV1, account.AddressVersion, "expected the address version to be stored")
+	require.Equal(t, msg.Salt, account.Salt, "expected the salt to be stored")
+}
+
 func TestRegisterAccount_ExistingAccount(t *testing.T) {
 	// ARRANGE
 	signer := testutil.NobleAddress()
@@ -192,7 +341,7 @@ func TestRegisterAccount_ExistingAccount(t *testing.T) {
 
 			m.AccountKeeper.Accounts[registeredAcc.GetAddress().String()] = registeredAcc
 			m.BankKeeper.Balances[registeredAcc.GetAddress().String()] = sdk.Coins{
-				sdk.NewInt64Coin("uusdc", types.GetMinimumTransferAmount().Int64()),
+				sdk.NewInt64Coin("uusdc", types.DefaultParams().MinimumTransferAmount.Int64()),
 			}
 
 			// ACT
@@ -221,7 +370,7 @@ func TestRegisterAccount_ExistingAccount(t *testing.T) {
 			nAccount, _ := k.NumOfAccounts.Get(ctx, accountProperties.DestinationDomain)
 			assert.Equal(t, uint64(1), nAccount, "expected only one account registered")
 
-			_, err = k.PendingTransfers.Get(ctx, customAddress.String())
+			_, err = k.GetPendingTransfer(ctx, customAddress.String())
 			assert.NoError(t, err, "expected new account added to pending transfers")
 
 			// Verify correct account type update
@@ -234,7 +383,7 @@ func TestRegisterAccount_ExistingAccount(t *testing.T) {
 
 			// ARRANGE: Simulate previous account has been cleared and we are in a new block. It
 			// should not be possible to register again the account.
-			err = k.PendingTransfers.Clear(ctx, nil)
+			err = k.ClearPendingTransfers(ctx)
 			assert.NoError(t, err)
 
 			// ACT: Trying to register again the account fails.
@@ -247,7 +396,7 @@ func TestRegisterAccount_ExistingAccount(t *testing.T) {
 			nAccount, _ = k.NumOfAccounts.Get(ctx, accountProperties.DestinationDomain)
 			assert.Equal(t, uint64(1), nAccount, "expected no change in number of account")
 
-			_, err = k.PendingTransfers.Get(ctx, customAddress.String())
+			_, err = k.GetPendingTransfer(ctx, customAddress.String())
 			assert.Error(t, err, "no account should have been added to pending transfers")
 
 			// ARRANGE: Create a new account but without funding it. It is possible to create the
@@ -268,7 +417,7 @@ func TestRegisterAccount_ExistingAccount(t *testing.T) {
 			nAccount, _ = k.NumOfAccounts.Get(ctx, accountProperties.DestinationDomain)
 			assert.Equal(t, uint64(1), nAccount, "expected only one account registered")
 
-			_, err = k.PendingTransfers.Get(ctx, customAddress.String())
+			_, err = k.GetPendingTransfer(ctx, customAddress.String())
 			assert.Error(t, err, "expected no pending transfers")
 
 			// ARRANGE: Create a new account with not enough funds. It is possible to create the
@@ -280,7 +429,7 @@ func TestRegisterAccount_ExistingAccount(t *testing.T) {
 			m.AccountKeeper.Accounts[registeredAcc.GetAddress().String()] = registeredAcc
 
 			m.BankKeeper.Balances[registeredAcc.GetAddress().String()] = sdk.Coins{
-				sdk.NewInt64Coin("uusdc", types.GetMinimumTransferAmount().Int64()-1),
+				sdk.NewInt64Coin("uusdc", types.DefaultParams().MinimumTransferAmount.Int64()-1),
 			}
 
 			// ACT
@@ -293,7 +442,7 @@ func TestRegisterAccount_ExistingAccount(t *testing.T) {
 			nAccount, _ = k.NumOfAccounts.Get(ctx, accountProperties.DestinationDomain)
 			assert.Equal(t, uint64(1), nAccount, "expected only one account registered")
 
-			_, err = k.PendingTransfers.Get(ctx, customAddress.String())
+			_, err = k.GetPendingTransfer(ctx, customAddress.String())
 			assert.Error(t, err, "expected no pending transfers")
 
 			// ARRANGE: Trying to register as AutoCCTP account an account which type is not the
@@ -446,9 +595,13 @@ func TestClearAccount(t *testing.T) {
 			malleateMsg: func(msg *types.MsgClearAccount) {
 				msg.Fallback = true
 			},
-			postChecks: func(ctx sdk.Context, bk *mocks.BankKeeper, _ *keeper.Keeper) {
+			postChecks: func(ctx sdk.Context, bk *mocks.BankKeeper, k *keeper.Keeper) {
 				fallbackBalance := bk.Balances[accountProperties.FallbackRecipient]
 				require.Equal(t, int64(1_000_000_000), fallbackBalance.AmountOf("uusdc").Int64(), "expected a different final amount for the fallback account")
+				outcomes := k.GetOutcomeStats(ctx, accountProperties.DestinationDomain)
+				require.Equal(t, uint64(1), outcomes.FallbackClears, "expected the fallback clear to be counted")
+				require.Equal(t, math.NewInt(1_000_000_000), outcomes.FallbackAmount, "expected the amount cleared to the fallback to be counted")
+				require.Zero(t, outcomes.ManualRetries, "expected no manual retry")
 			},
 			errContains: "",
 		},
@@ -462,8 +615,21 @@ func TestClearAccount(t *testing.T) {
 			},
 			malleateMsg: func(msg *types.MsgClearAccount) {},
 			postChecks: func(ctx sdk.Context, _ *mocks.BankKeeper, k *keeper.Keeper) {
-				_, err := k.PendingTransfers.Get(ctx, customAddress.String())
+				_, err := k.GetPendingTransfer(ctx, customAddress.String())
 				require.NoError(t, err, "expected no error getting pending transfers")
+				outcomes := k.GetOutcomeStats(ctx, accountProperties.DestinationDomain)
+				require.Equal(t, uint64(1), outcomes.ManualRetries, "expected the manual retry to be counted")
+				require.Zero(t, outcomes.FallbackClears, "expected no fallback clear")
+				events := ctx.EventManager().Events()
+				require.NotEmpty(t, events, "expected an event")
+				event, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-1]))
+				require.NoError(t, err, "expected a typed event")
+				require.Equal(t, &types.TransferRetryRequested{
+					Address:           customAddress.String(),
+					DestinationDomain: accountProperties.DestinationDomain,
+					Amount:            math.NewInt(1_000_000_000),
+					Denom:             "uusdc",
+				}, event, "expected a transfer retry requested event")
 			},
 			errContains: "",
 		},
@@ -494,3 +660,756 @@ func TestClearAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateParams(t *testing.T) {
+	testCases := []struct {
+		name        string
+		msg         *types.MsgUpdateParams
+		errContains string
+	}{
+		{
+			name:        "fail when the msg is nil",
+			msg:         nil,
+			errContains: sdkerrors.ErrInvalidRequest.Error(),
+		},
+		{
+			name: "fail when the signer is not the authority",
+			msg: &types.MsgUpdateParams{
+				Authority: testutil.NobleAddress(),
+				Params:    types.DefaultParams(),
+			},
+			errContains: types.ErrInvalidAuthority.Error(),
+		},
+		{
+			name: "fail when the minimum transfer amount is zero",
+			msg: &types.MsgUpdateParams{
+				Authority: mocks.Authority,
+				Params:    types.NewParams(math.ZeroInt(), types.DefaultMaxTransferAttempts, types.DefaultRetryBaseDelay, types.DefaultRetryMaxDelay, false, types.DefaultMaxTransferHistory),
+			},
+			errContains: types.ErrInvalidParams.Error(),
+		},
+		{
+			name: "fail when the minimum transfer amount is nil",
+			msg: &types.MsgUpdateParams{
+				Authority: mocks.Authority,
+				Params:    types.Params{},
+			},
+			errContains: types.ErrInvalidParams.Error(),
+		},
+		{
+			name: "succeeds when the authority updates the params",
+			msg: &types.MsgUpdateParams{
+				Authority: mocks.Authority,
+				Params:    types.NewParams(math.NewInt(1_000_000), types.DefaultMaxTransferAttempts, types.DefaultRetryBaseDelay, types.DefaultRetryMaxDelay, false, types.DefaultMaxTransferHistory),
+			},
+			errContains: "",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			// ARRANGE
+			_, k, ctx := mocks.AutoCCTPKeeper(t)
+			server := keeper.NewMsgServer(k)
+
+			// ACT
+			resp, err := server.UpdateParams(ctx, tC.msg)
+
+			// ASSERT
+			if tC.errContains == "" {
+				require.NoError(t, err, "expected no error executing the server call")
+				require.Equal(t, tC.msg.Params, k.GetParams(ctx), "expected the params to be updated")
+			} else {
+				require.Error(t, err, "expected an error executing the server call")
+				require.ErrorContains(t, err, tC.errContains, "expected a different error")
+				require.Nil(t, resp, "expected a nil response when error is not nil")
+				require.Equal(t, types.DefaultParams(), k.GetParams(ctx), "expected the params to not change")
+			}
+		})
+	}
+}
+
+func TestUpdateParams_StatsBucketDuration(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewMsgServer(k)
+	ctx = ctx.WithBlockTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, k.AddStatsHistory(ctx, uint32(types.ETHEREUM), math.NewInt(100)))
+
+	// ACT: The params are updated without changing the bucket duration.
+	params := types.DefaultParams()
+	params.StatsHistoryRetention = time.Hour
+	_, err := server.UpdateParams(ctx, &types.MsgUpdateParams{Authority: mocks.Authority, Params: params})
+	require.NoError(t, err)
+
+	// ASSERT
+	buckets, err := k.GetAllStatsHistory(ctx)
+	require.NoError(t, err)
+	require.Len(t, buckets, 1, "expected the stats history to be kept")
+
+	// ACT: The bucket duration is changed.
+	params.StatsBucketDuration = time.Hour
+	_, err = server.UpdateParams(ctx, &types.MsgUpdateParams{Authority: mocks.Authority, Params: params})
+	require.NoError(t, err)
+
+	// ASSERT
+	buckets, err = k.GetAllStatsHistory(ctx)
+	require.NoError(t, err)
+	require.Empty(t, buckets, "expected the misaligned stats history to be cleared")
+}
+
+func TestAddDomain(t *testing.T) {
+	newDomain := types.NewDomainConfig(types.UNICHAIN+1, "New Domain", types.AddressEncodingHex)
+
+	testCases := []struct {
+		name        string
+		msg         *types.MsgAddDomain
+		errContains string
+	}{
+		{
+			name:        "fail when the msg is nil",
+			msg:         nil,
+			errContains: sdkerrors.ErrInvalidRequest.Error(),
+		},
+		{
+			name: "fail when the signer is not the authority",
+			msg: &types.MsgAddDomain{
+				Authority: testutil.NobleAddress(),
+				Domain:    newDomain,
+			},
+			errContains: types.ErrInvalidAuthority.Error(),
+		},
+		{
+			name: "fail when the domain is noble",
+			msg: &types.MsgAddDomain{
+				Authority: mocks.Authority,
+				Domain:    types.NewDomainConfig(types.NOBLE, "Noble", types.AddressEncodingBech32),
+			},
+			errContains: "cannot be source domain",
+		},
+		{
+			name: "fail when the domain is already registered",
+			msg: &types.MsgAddDomain{
+				Authority: mocks.Authority,
+				Domain:    types.NewDomainConfig(types.ETHEREUM, "Ethereum", types.AddressEncodingHex),
+			},
+			errContains: "already registered",
+		},
+		{
+			name: "succeeds when the authority adds a new domain",
+			msg: &types.MsgAddDomain{
+				Authority: mocks.Authority,
+				Domain:    newDomain,
+			},
+			errContains: "",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			// ARRANGE
+			_, k, ctx := mocks.AutoCCTPKeeper(t)
+			server := keeper.NewMsgServer(k)
+
+			// ACT
+			resp, err := server.AddDomain(ctx, tC.msg)
+
+			// ASSERT
+			if tC.errContains == "" {
+				require.NoError(t, err, "expected no error executing the server call")
+				domain := k.GetDomain(ctx, tC.msg.Domain.DestinationDomain)
+				require.NotNil(t, domain, "expected the domain to be registered")
+				require.Equal(t, tC.msg.Domain, *domain, "expected the registered domain to match")
+			} else {
+				require.Error(t, err, "expected an error executing the server call")
+				require.ErrorContains(t, err, tC.errContains, "expected a different error")
+				require.Nil(t, resp, "expected a nil response when error is not nil")
+				require.Nil(t, k.GetDomain(ctx, newDomain.DestinationDomain), "expected the new domain to not be registered")
+			}
+		})
+	}
+}
+
+func TestUpdateDomain(t *testing.T) {
+	updatedDomain := types.NewDomainConfig(types.ETHEREUM, "Ethereum Mainnet", types.AddressEncodingHex)
+	updatedDomain.DestinationCallerAllowed = false
+
+	testCases := []struct {
+		name        string
+		msg         *types.MsgUpdateDomain
+		errContains string
+	}{
+		{
+			name:        "fail when the msg is nil",
+			msg:         nil,
+			errContains: sdkerrors.ErrInvalidRequest.Error(),
+		},
+		{
+			name: "fail when the signer is not the authority",
+			msg: &types.MsgUpdateDomain{
+				Authority: testutil.NobleAddress(),
+				Domain:    updatedDomain,
+			},
+			errContains: types.ErrInvalidAuthority.Error(),
+		},
+		{
+			name: "fail when the domain has no name",
+			msg: &types.MsgUpdateDomain{
+				Authority: mocks.Authority,
+				Domain:    types.NewDomainConfig(types.ETHEREUM, "", types.AddressEncodingHex),
+			},
+			errContains: types.ErrInvalidDestinationDomain.Error(),
+		},
+		{
+			name: "fail when the rate limit is not valid",
+			msg: &types.MsgUpdateDomain{
+				Authority: mocks.Authority,
+				Domain: types.DomainConfig{
+					DestinationDomain: uint32(types.ETHEREUM),
+					Name:              "Ethereum",
+					AddressEncoding:   types.AddressEncodingHex,
+					RateLimit:         &types.RateLimit{Window: 0, MaxTransfers: 1, MaxAmount: math.ZeroInt()},
+				},
+			},
+			errContains: "rate limit",
+		},
+		{
+			name: "fail when the domain is not registered",
+			msg: &types.MsgUpdateDomain{
+				Authority: mocks.Authority,
+				Domain:    types.NewDomainConfig(types.UNICHAIN+1, "Unknown", types.AddressEncodingHex),
+			},
+			errContains: "not registered",
+		},
+		{
+			name: "succeeds when the authority updates a registered domain",
+			msg: &types.MsgUpdateDomain{
+				Authority: mocks.Authority,
+				Domain:    updatedDomain,
+			},
+			errContains: "",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			// ARRANGE
+			_, k, ctx := mocks.AutoCCTPKeeper(t)
+			server := keeper.NewMsgServer(k)
+			original := k.GetDomain(ctx, uint32(types.ETHEREUM))
+			require.NotNil(t, original, "expected ethereum to be registered by default")
+
+			// ACT
+			resp, err := server.UpdateDomain(ctx, tC.msg)
+
+			// ASSERT
+			domain := k.GetDomain(ctx, uint32(types.ETHEREUM))
+			if tC.errContains == "" {
+				require.NoError(t, err, "expected no error executing the server call")
+				require.Equal(t, updatedDomain, *domain, "expected the domain to be updated")
+			} else {
+				require.Error(t, err, "expected an error executing the server call")
+				require.ErrorContains(t, err, tC.errContains, "expected a different error")
+				require.Nil(t, resp, "expected a nil response when error is not nil")
+				require.Equal(t, *original, *domain, "expected the domain to not change")
+			}
+		})
+	}
+}
+
+func TestDisableDomain(t *testing.T) {
+	testCases := []struct {
+		name        string
+		msg         *types.MsgDisableDomain
+		errContains string
+	}{
+		{
+			name:        "fail when the msg is nil",
+			msg:         nil,
+			errContains: sdkerrors.ErrInvalidRequest.Error(),
+		},
+		{
+			name: "fail when the signer is not the authority",
+			msg: &types.MsgDisableDomain{
+				Authority:         testutil.NobleAddress(),
+				DestinationDomain: uint32(types.ETHEREUM),
+			},
+			errContains: types.ErrInvalidAuthority.Error(),
+		},
+		{
+			name: "fail when the domain is not registered",
+			msg: &types.MsgDisableDomain{
+				Authority:         mocks.Authority,
+				DestinationDomain: uint32(types.UNICHAIN + 1),
+			},
+			errContains: "not registered",
+		},
+		{
+			name: "succeeds when the authority disables a registered domain",
+			msg: &types.MsgDisableDomain{
+				Authority:         mocks.Authority,
+				DestinationDomain: uint32(types.ETHEREUM),
+			},
+			errContains: "",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			// ARRANGE
+			_, k, ctx := mocks.AutoCCTPKeeper(t)
+			server := keeper.NewMsgServer(k)
+
+			// ACT
+			resp, err := server.DisableDomain(ctx, tC.msg)
+
+			// ASSERT
+			domain := k.GetDomain(ctx, uint32(types.ETHEREUM))
+			require.NotNil(t, domain, "expected ethereum to remain registered")
+			if tC.errContains == "" {
+				require.NoError(t, err, "expected no error executing the server call")
+				require.False(t, domain.Enabled, "expected the domain to be disabled")
+			} else {
+				require.Error(t, err, "expected an error executing the server call")
+				require.ErrorContains(t, err, tC.errContains, "expected a different error")
+				require.Nil(t, resp, "expected a nil response when error is not nil")
+				require.True(t, domain.Enabled, "expected the domain to remain enabled")
+			}
+		})
+	}
+}
+
+func TestSetFallbackPolicy(t *testing.T) {
+	acc := testutil.AutoCCTPAccount(false)
+	policy := &types.FallbackPolicy{MaxAttempts: 3, Timeout: time.Hour}
+
+	testCases := []struct {
+		name        string
+		setup       func(*mocks.Mocks)
+		msg         *types.MsgSetFallbackPolicy
+		errContains string
+	}{
+		{
+			name:        "fail when the msg is nil",
+			setup:       func(m *mocks.Mocks) {},
+			msg:         nil,
+			errContains: sdkerrors.ErrInvalidRequest.Error(),
+		},
+		{
+			name:  "fail when the address is not valid",
+			setup: func(m *mocks.Mocks) {},
+			msg: &types.MsgSetFallbackPolicy{
+				Signer:         acc.FallbackRecipient,
+				Address:        "invalid",
+				FallbackPolicy: policy,
+			},
+			errContains: sdkerrors.ErrInvalidAddress.Error(),
+		},
+		{
+			name:  "fail when the account is not registered",
+			setup: func(m *mocks.Mocks) {},
+			msg: &types.MsgSetFallbackPolicy{
+				Signer:         acc.FallbackRecipient,
+				Address:        acc.Address,
+				FallbackPolicy: policy,
+			},
+			errContains: "account does not exist",
+		},
+		{
+			name: "fail when the account is base account",
+			setup: func(m *mocks.Mocks) {
+				m.AccountKeeper.Accounts[acc.Address] = acc.BaseAccount
+			},
+			msg: &types.MsgSetFallbackPolicy{
+				Signer:         acc.FallbackRecipient,
+				Address:        acc.Address,
+				FallbackPolicy: policy,
+			},
+			errContains: "account is not an autocctp account",
+		},
+		{
+			name: "fail when the signer is not fallback",
+			setup: func(m *mocks.Mocks) {
+				m.AccountKeeper.Accounts[acc.Address] = &acc
+			},
+			msg: &types.MsgSetFallbackPolicy{
+				Signer:         testutil.NobleAddress(),
+				Address:        acc.Address,
+				FallbackPolicy: policy,
+			},
+			errContains: "unauthorized",
+		},
+		{
+			name: "fail when the policy has no conditions",
+			setup: func(m *mocks.Mocks) {
+				m.AccountKeeper.Accounts[acc.Address] = &acc
+			},
+			msg: &types.MsgSetFallbackPolicy{
+				Signer:         acc.FallbackRecipient,
+				Address:        acc.Address,
+				FallbackPolicy: &types.FallbackPolicy{},
+			},
+			errContains: types.ErrInvalidFallbackPolicy.Error(),
+		},
+		{
+			name: "fail when the max attempts exceed the max transfer attempts",
+			setup: func(m *mocks.Mocks) {
+				m.AccountKeeper.Accounts[acc.Address] = &acc
+			},
+			msg: &types.MsgSetFallbackPolicy{
+				Signer:         acc.FallbackRecipient,
+				Address:        acc.Address,
+				FallbackPolicy: &types.FallbackPolicy{MaxAttempts: types.DefaultMaxTransferAttempts + 1},
+			},
+			errContains: "max attempts cannot exceed the max transfer attempts",
+		},
+		{
+			name: "succeeds when the fallback recipient sets the policy",
+			setup: func(m *mocks.Mocks) {
+				m.AccountKeeper.Accounts[acc.Address] = &acc
+			},
+			msg: &types.MsgSetFallbackPolicy{
+				Signer:         acc.FallbackRecipient,
+				Address:        acc.Address,
+				FallbackPolicy: policy,
+			},
+			errContains: "",
+		},
+		{
+			name: "succeeds when the fallback recipient removes the policy",
+			setup: func(m *mocks.Mocks) {
+				withPolicy := acc
+				withPolicy.FallbackPolicy = policy
+				m.AccountKeeper.Accounts[acc.Address] = &withPolicy
+			},
+			msg: &types.MsgSetFallbackPolicy{
+				Signer:         acc.FallbackRecipient,
+				Address:        acc.Address,
+				FallbackPolicy: nil,
+			},
+			errContains: "",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			// ARRANGE
+			m, k, ctx := mocks.AutoCCTPKeeper(t)
+			server := keeper.NewMsgServer(k)
+			tC.setup(m)
+
+			// ACT
+			resp, err := server.SetFallbackPolicy(ctx, tC.msg)
+
+			// ASSERT
+			if tC.errContains == "" {
+				require.NoError(t, err, "expected no error executing the server call")
+				account, ok := m.AccountKeeper.Accounts[acc.Address].(*types.Account)
+				require.True(t, ok, "expected an autocctp account")
+				require.Equal(t, tC.msg.FallbackPolicy, account.FallbackPolicy, "expected the fallback policy to be updated")
+			} else {
+				require.Error(t, err, "expected an error executing the server call")
+				require.ErrorContains(t, err, tC.errContains, "expected a different error")
+				require.Nil(t, resp, "expected a nil response when error is not nil")
+			}
+		})
+	}
+}
+
+func TestSetPaused(t *testing.T) {
+	testCases := []struct {
+		name         string
+		msg          *types.MsgSetPaused
+		expPaused    bool
+		expPausedEth bool
+		errContains  string
+	}{
+		{
+			name:        "fail when the msg is nil",
+			msg:         nil,
+			errContains: sdkerrors.ErrInvalidRequest.Error(),
+		},
+		{
+			name:        "fail when the signer is not the authority",
+			msg:         &types.MsgSetPaused{Authority: testutil.NobleAddress(), Paused: true},
+			errContains: types.ErrInvalidAuthority.Error(),
+		},
+		{
+			name: "fail when a domain is not registered",
+			msg: &types.MsgSetPaused{
+				Authority:          mocks.Authority,
+				Paused:             true,
+				DestinationDomains: []uint32{uint32(types.ETHEREUM), uint32(types.UNICHAIN + 1)},
+			},
+			errContains: types.ErrInvalidDestinationDomain.Error(),
+		},
+		{
+			name:         "succeeds when the authority pauses globally",
+			msg:          &types.MsgSetPaused{Authority: mocks.Authority, Paused: true},
+			expPaused:    true,
+			expPausedEth: true,
+		},
+		{
+			name: "succeeds when the authority pauses a domain",
+			msg: &types.MsgSetPaused{
+				Authority:          mocks.Authority,
+				Paused:             true,
+				DestinationDomains: []uint32{uint32(types.ETHEREUM)},
+			},
+			expPausedEth: true,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			// ARRANGE
+			_, k, ctx := mocks.AutoCCTPKeeper(t)
+			server := keeper.NewMsgServer(k)
+
+			// ACT
+			resp, err := server.SetPaused(ctx, tC.msg)
+
+			// ASSERT
+			if tC.errContains == "" {
+				require.NoError(t, err, "expected no error executing the server call")
+			} else {
+				require.Error(t, err, "expected an error executing the server call")
+				require.ErrorContains(t, err, tC.errContains, "expected a different error")
+				require.Nil(t, resp, "expected a nil response when error is not nil")
+			}
+			paused, _ := k.Paused.Get(ctx)
+			require.Equal(t, tC.expPaused, paused, "expected a different global pause state")
+			require.Equal(t, tC.expPausedEth, k.IsPaused(ctx, uint32(types.ETHEREUM)), "expected a different ethereum pause state")
+			require.Equal(t, tC.expPaused, k.IsPaused(ctx, uint32(types.BASE)), "expected a different base pause state")
+		})
+	}
+}
+
+func TestSetForwardOtherDenoms(t *testing.T) {
+	acc := testutil.AutoCCTPAccount(false)
+
+	testCases := []struct {
+		name        string
+		setup       func(*mocks.Mocks)
+		msg         *types.MsgSetForwardOtherDenoms
+		errContains string
+	}{
+		{
+			name:        "fail when the msg is nil",
+			setup:       func(m *mocks.Mocks) {},
+			msg:         nil,
+			errContains: sdkerrors.ErrInvalidRequest.Error(),
+		},
+		{
+			name:        "fail when the address is not valid",
+			setup:       func(m *mocks.Mocks) {},
+			msg:         &types.MsgSetForwardOtherDenoms{Signer: acc.FallbackRecipient, Address: "invalid", ForwardOtherDenoms: true},
+			errContains: sdkerrors.ErrInvalidAddress.Error(),
+		},
+		{
+			name:        "fail when the account is not registered",
+			setup:       func(m *mocks.Mocks) {},
+			msg:         &types.MsgSetForwardOtherDenoms{Signer: acc.FallbackRecipient, Address: acc.Address, ForwardOtherDenoms: true},
+			errContains: "account does not exist",
+		},
+		{
+			name: "fail when the account is base account",
+			setup: func(m *mocks.Mocks) {
+				m.AccountKeeper.Accounts[acc.Address] = acc.BaseAccount
+			},
+			msg:         &types.MsgSetForwardOtherDenoms{Signer: acc.FallbackRecipient, Address: acc.Address, ForwardOtherDenoms: true},
+			errContains: "account is not an autocctp account",
+		},
+		{
+			name: "fail when the signer is not fallback",
+			setup: func(m *mocks.Mocks) {
+				m.AccountKeeper.Accounts[acc.Address] = &acc
+			},
+			msg:         &types.MsgSetForwardOtherDenoms{Signer: testutil.NobleAddress(), Address: acc.Address, ForwardOtherDenoms: true},
+			errContains: "unauthorized",
+		},
+		{
+			name: "succeeds when the fallback recipient enables the forwarding",
+			setup: func(m *mocks.Mocks) {
+				m.AccountKeeper.Accounts[acc.Address] = &acc
+			},
+			msg: &types.MsgSetForwardOtherDenoms{Signer: acc.FallbackRecipient, Address: acc.Address, ForwardOtherDenoms: true},
+		},
+		{
+			name: "succeeds when the fallback recipient disables the forwarding",
+			setup: func(m *mocks.Mocks) {
+				forwarding := acc
+				forwarding.ForwardOtherDenoms = true
+				m.AccountKeeper.Accounts[acc.Address] = &forwarding
+			},
+			msg: &types.MsgSetForwardOtherDenoms{Signer: acc.FallbackRecipient, Address: acc.Address, ForwardOtherDenoms: false},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			// ARRANGE
+			m, k, ctx := mocks.AutoCCTPKeeper(t)
+			server := keeper.NewMsgServer(k)
+			tC.setup(m)
+
+			// ACT
+			resp, err := server.SetForwardOtherDenoms(ctx, tC.msg)
+
+			// ASSERT
+			if tC.errContains == "" {
+				require.NoError(t, err, "expected no error executing the server call")
+				account, ok := m.AccountKeeper.Accounts[acc.Address].(*types.Account)
+				require.True(t, ok, "expected an autocctp account")
+				require.Equal(t, tC.msg.ForwardOtherDenoms, account.ForwardOtherDenoms, "expected the forwarding to be updated")
+			} else {
+				require.Error(t, err, "expected an error executing the server call")
+				require.ErrorContains(t, err, tC.errContains, "expected a different error")
+				require.Nil(t, resp, "expected a nil response when error is not nil")
+			}
+		})
+	}
+}
+
+func TestDeregisterAccount(t *testing.T) {
+	acc := testutil.AutoCCTPAccount(false)
+
+	testCases := []struct {
+		name        string
+		setup       func(*mocks.Mocks)
+		msg         *types.MsgDeregisterAccount
+		errContains string
+	}{
+		{
+			name:        "fail when the msg is nil",
+			setup:       func(m *mocks.Mocks) {},
+			msg:         nil,
+			errContains: sdkerrors.ErrInvalidRequest.Error(),
+		},
+		{
+			name:        "fail when the address is not valid",
+			setup:       func(m *mocks.Mocks) {},
+			msg:         &types.MsgDeregisterAccount{Signer: acc.FallbackRecipient, Address: "invalid"},
+			errContains: sdkerrors.ErrInvalidAddress.Error(),
+		},
+		{
+			name:        "fail when the account is not registered",
+			setup:       func(m *mocks.Mocks) {},
+			msg:         &types.MsgDeregisterAccount{Signer: acc.FallbackRecipient, Address: acc.Address},
+			errContains: "account does not exist",
+		},
+		{
+			name: "fail when the account is base account",
+			setup: func(m *mocks.Mocks) {
+				m.AccountKeeper.Accounts[acc.Address] = acc.BaseAccount
+			},
+			msg:         &types.MsgDeregisterAccount{Signer: acc.FallbackRecipient, Address: acc.Address},
+			errContains: "account is not an autocctp account",
+		},
+		{
+			name: "fail when the account is already deregistered",
+			setup: func(m *mocks.Mocks) {
+				deregistered := acc
+				deregistered.Deregistered = true
+				m.AccountKeeper.Accounts[acc.Address] = &deregistered
+			},
+			msg:         &types.MsgDeregisterAccount{Signer: acc.FallbackRecipient, Address: acc.Address},
+			errContains: types.ErrAccountDeregistered.Error(),
+		},
+		{
+			name: "fail when the signer is not fallback",
+			setup: func(m *mocks.Mocks) {
+				m.AccountKeeper.Accounts[acc.Address] = &acc
+			},
+			msg:         &types.MsgDeregisterAccount{Signer: testutil.NobleAddress(), Address: acc.Address},
+			errContains: "unauthorized",
+		},
+		{
+			name: "succeeds when the fallback recipient deregisters the account",
+			setup: func(m *mocks.Mocks) {
+				m.AccountKeeper.Accounts[acc.Address] = &acc
+			},
+			msg: &types.MsgDeregisterAccount{Signer: acc.FallbackRecipient, Address: acc.Address},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			// ARRANGE
+			m, k, ctx := mocks.AutoCCTPKeeper(t)
+			server := keeper.NewMsgServer(k)
+			tC.setup(m)
+
+			// ACT
+			resp, err := server.DeregisterAccount(ctx, tC.msg)
+
+			// ASSERT
+			if tC.errContains == "" {
+				require.NoError(t, err, "expected no error executing the server call")
+				account, ok := m.AccountKeeper.Accounts[acc.Address].(*types.Account)
+				require.True(t, ok, "expected an autocctp account")
+				require.True(t, account.Deregistered, "expected the account to be deregistered")
+			} else {
+				require.Error(t, err, "expected an error executing the server call")
+				require.ErrorContains(t, err, tC.errContains, "expected a different error")
+				require.Nil(t, resp, "expected a nil response when error is not nil")
+			}
+		})
+	}
+}
+
+func TestDeregisterAccount_State(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewMsgServer(k)
+	properties := testutil.ValidProperties(false)
+	resp, err := server.RegisterAccount(ctx, &types.MsgRegisterAccount{
+		Signer:            testutil.NobleAddress(),
+		DestinationDomain: properties.DestinationDomain,
+		MintRecipient:     properties.MintRecipient,
+		FallbackRecipient: properties.FallbackRecipient,
+	})
+	require.NoError(t, err, "expected no error registering the account")
+	address := resp.Address
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000), sdk.NewInt64Coin("uatom", 1_000))
+	m.BankKeeper.Balances[address] = balances
+	require.NoError(t, k.SetFailedTransfer(ctx, address, math.NewInt(1_000_000), errors.New("error")))
+	account := m.AccountKeeper.Accounts[address].(*types.Account)
+	require.NoError(t, k.AddPendingTransfer(ctx, *account))
+
+	// ACT
+	_, err = server.DeregisterAccount(ctx, &types.MsgDeregisterAccount{Signer: properties.FallbackRecipient, Address: address})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error deregistering the account")
+	require.Equal(t, balances, m.BankKeeper.Balances[properties.FallbackRecipient], "expected all the balances to be sent to the fallback")
+	require.True(t, m.BankKeeper.Balances[address].IsZero(), "expected an empty account")
+
+	account, ok := m.AccountKeeper.Accounts[address].(*types.Account)
+	require.True(t, ok, "expected the account to be kept as an autocctp account")
+	require.True(t, account.Deregistered, "expected the account to be deregistered")
+
+	require.Nil(t, k.GetFailedTransfer(ctx, address), "expected the failed transfer to be removed")
+	has, err := k.HasPendingTransfer(ctx, address)
+	require.NoError(t, err)
+	require.False(t, has, "expected the pending transfer to be removed")
+	has, err = k.AccountsByDestinationDomain.Has(ctx, collections.Join(properties.DestinationDomain, address))
+	require.NoError(t, err)
+	require.False(t, has, "expected the account to be removed from the indexes")
+	numOfAccounts, err := k.NumOfAccounts.Get(ctx, properties.DestinationDomain)
+	require.NoError(t, err)
+	require.Zero(t, numOfAccounts, "expected the number of accounts to be decremented")
+
+	// ACT: The account cannot be registered again.
+	_, err = server.RegisterAccount(ctx, &types.MsgRegisterAccount{
+		Signer:            testutil.NobleAddress(),
+		DestinationDomain: properties.DestinationDomain,
+		MintRecipient:     properties.MintRecipient,
+		FallbackRecipient: properties.FallbackRecipient,
+	})
+
+	// ASSERT
+	require.Error(t, err, "expected an error registering a deregistered account")
+	require.ErrorContains(t, err, "account has already been registered", "expected a different error")
+}
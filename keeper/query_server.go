@@ -21,10 +21,17 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"autocctp.dev/types"
 )
@@ -45,11 +52,11 @@ func (q queryServer) Address(ctx context.Context, req *types.QueryAddress) (*typ
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
 	}
 
-	accountProperties, err := req.GetAccountProperties()
+	accountProperties, err := req.GetAccountProperties(q.GetDomain(ctx, req.DestinationDomain))
 	if err != nil {
 		return nil, types.ErrInvalidInputs.Wrap(err.Error())
 	}
-	if err := q.ValidateAccountProperties(accountProperties); err != nil {
+	if err := q.ValidateAccountProperties(ctx, accountProperties); err != nil {
 		return nil, types.ErrInvalidAccountProperties.Wrap(err.Error())
 	}
 
@@ -91,12 +98,27 @@ func (q queryServer) Stats(ctx context.Context, req *types.QueryStats) (*types.Q
 		totalTransferred, err := q.TotalTransferred.Get(ctx, destinationDomain)
 		if err != nil {
 			q.logger.Error("unable to get total transferred", "destination domain", strconv.Itoa(int(destinationDomain)), "err", err)
+			totalTransferred = math.ZeroInt()
+		}
+
+		totalFees, err := q.TotalFees.Get(ctx, destinationDomain)
+		if err != nil {
+			q.logger.Error("unable to get total fees", "destination domain", strconv.Itoa(int(destinationDomain)), "err", err)
+			totalFees = math.ZeroInt()
+		}
+
+		rateLimitCapacity, err := q.GetRateLimitCapacity(ctx, destinationDomain)
+		if err != nil {
+			q.logger.Error("unable to get rate limit capacity", "destination domain", strconv.Itoa(int(destinationDomain)), "err", err)
 		}
 
 		stats[destinationDomain] = types.DomainStats{
-			Accounts:         numOfAccount,
-			Transfers:        numOfTransfers,
-			TotalTransferred: totalTransferred,
+			Accounts:               numOfAccount,
+			Transfers:              numOfTransfers,
+			TotalTransferredAmount: totalTransferred,
+			TotalFees:              totalFees,
+			RateLimitCapacity:      rateLimitCapacity,
+			Outcomes:               q.GetOutcomeStats(ctx, destinationDomain),
 		}
 	}
 
@@ -120,11 +142,381 @@ func (q queryServer) StatsByDestinationDomain(ctx context.Context, req *types.Qu
 	totalTransferred, err := q.TotalTransferred.Get(ctx, req.DestinationDomain)
 	if err != nil {
 		q.logger.Error("unable to get total transferred", "destination domain", strconv.Itoa(int(req.DestinationDomain)), "err", err)
+		totalTransferred = math.ZeroInt()
+	}
+
+	totalFees, err := q.TotalFees.Get(ctx, req.DestinationDomain)
+	if err != nil {
+		q.logger.Error("unable to get total fees", "destination domain", strconv.Itoa(int(req.DestinationDomain)), "err", err)
+		totalFees = math.ZeroInt()
+	}
+
+	rateLimitCapacity, err := q.GetRateLimitCapacity(ctx, req.DestinationDomain)
+	if err != nil {
+		q.logger.Error("unable to get rate limit capacity", "destination domain", strconv.Itoa(int(req.DestinationDomain)), "err", err)
 	}
 
 	return &types.QueryStatsByDestinationDomainResponse{
-		Accounts:         numOfAccount,
-		Transfers:        numOfTransfers,
-		TotalTransferred: totalTransferred,
+		Accounts:               numOfAccount,
+		Transfers:              numOfTransfers,
+		TotalTransferredAmount: totalTransferred,
+		TotalFees:              totalFees,
+		RateLimitCapacity:      rateLimitCapacity,
+		Outcomes:               q.GetOutcomeStats(ctx, req.DestinationDomain),
 	}, nil
 }
+
+// StatsHistory implements types.QueryServer.
+func (q queryServer) StatsHistory(ctx context.Context, req *types.QueryStatsHistory) (*types.QueryStatsHistoryResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
+	}
+	if req.StartTime != nil && req.EndTime != nil && !req.StartTime.Before(*req.EndTime) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("start time must be before end time")
+	}
+
+	buckets, pagination, err := query.CollectionFilteredPaginate(
+		ctx, q.Keeper.StatsHistory, req.Pagination,
+		func(key collections.Pair[uint32, time.Time], _ types.StatsBucket) (bool, error) {
+			if req.StartTime != nil && key.K2().Before(*req.StartTime) {
+				return false, nil
+			}
+			return req.EndTime == nil || key.K2().Before(*req.EndTime), nil
+		},
+		func(_ collections.Pair[uint32, time.Time], bucket types.StatsBucket) (types.StatsBucket, error) {
+			return bucket, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint32, time.Time](req.DestinationDomain),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStatsHistoryResponse{Buckets: buckets, Pagination: pagination}, nil
+}
+
+// Params implements types.QueryServer.
+func (q queryServer) Params(ctx context.Context, req *types.QueryParams) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
+	}
+
+	return &types.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
+}
+
+// Domains implements types.QueryServer.
+func (q queryServer) Domains(ctx context.Context, req *types.QueryDomains) (*types.QueryDomainsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
+	}
+
+	domains, err := q.GetDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDomainsResponse{Domains: domains}, nil
+}
+
+// Domain implements types.QueryServer.
+func (q queryServer) Domain(ctx context.Context, req *types.QueryDomain) (*types.QueryDomainResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
+	}
+
+	domain := q.GetDomain(ctx, req.DestinationDomain)
+	if domain == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("destination domain %d is not registered", req.DestinationDomain)
+	}
+
+	return &types.QueryDomainResponse{Domain: *domain}, nil
+}
+
+// FailedTransfers implements types.QueryServer.
+func (q queryServer) FailedTransfers(ctx context.Context, req *types.QueryFailedTransfers) (*types.QueryFailedTransfersResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
+	}
+
+	failedTransfers, pagination, err := query.CollectionPaginate(
+		ctx, q.Keeper.FailedTransfers, req.Pagination,
+		func(_ string, failedTransfer types.FailedTransfer) (types.FailedTransfer, error) {
+			return failedTransfer, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFailedTransfersResponse{FailedTransfers: failedTransfers, Pagination: pagination}, nil
+}
+
+// FailedTransfer implements types.QueryServer.
+func (q queryServer) FailedTransfer(ctx context.Context, req *types.QueryFailedTransfer) (*types.QueryFailedTransferResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
+	}
+
+	failedTransfer := q.GetFailedTransfer(ctx, req.Address)
+	if failedTransfer == nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no failed transfer for address %s", req.Address)
+	}
+
+	return &types.QueryFailedTransferResponse{FailedTransfer: *failedTransfer}, nil
+}
+
+// TransferHistory implements types.QueryServer.
+func (q queryServer) TransferHistory(ctx context.Context, req *types.QueryTransferHistory) (*types.QueryTransferHistoryResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
+	}
+
+	if _, err := q.accountKeeper.AddressCodec().StringToBytes(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address %s: %s", req.Address, err)
+	}
+
+	records, pagination, err := query.CollectionPaginate(
+		ctx, q.Keeper.TransferHistory, req.Pagination,
+		func(_ collections.Pair[string, uint64], record types.TransferRecord) (types.TransferRecord, error) {
+			return record, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Address),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTransferHistoryResponse{Records: records, Pagination: pagination}, nil
+}
+
+// Account implements types.QueryServer.
+func (q queryServer) Account(ctx context.Context, req *types.QueryAccount) (*types.QueryAccountResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
+	}
+
+	address, err := q.accountKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address %s: %s", req.Address, err)
+	}
+
+	account, ok := q.accountKeeper.GetAccount(ctx, address).(*types.Account)
+	if !ok {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no autocctp account for address %s", req.Address)
+	}
+
+	balance := q.bankKeeper.GetBalance(ctx, address, q.ftfKeeper.GetMintingDenom(ctx).Denom)
+
+	return &types.QueryAccountResponse{Account: *account, Balance: balance}, nil
+}
+
+// Accounts implements types.QueryServer.
+func (q queryServer) Accounts(ctx context.Context, req *types.QueryAccounts) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
+	}
+
+	filter, err := q.getAccountsFilter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The most selective index available is used to iterate the accounts, while the
+	// remaining filters are applied to the accounts loaded from the account keeper.
+	var (
+		accounts   []types.Account
+		pagination *query.PageResponse
+	)
+	switch {
+	case filter.mintRecipient != nil:
+		accounts, pagination, err = query.CollectionFilteredPaginate(
+			ctx, q.AccountsByMintRecipient, req.Pagination,
+			func(key collections.Pair[[]byte, string], _ collections.NoValue) (bool, error) {
+				return filter.match(q.getAccount(ctx, key.K2())), nil
+			},
+			func(key collections.Pair[[]byte, string], _ collections.NoValue) (types.Account, error) {
+				return *q.getAccount(ctx, key.K2()), nil
+			},
+			query.WithCollectionPaginationPairPrefix[[]byte, string](filter.mintRecipient),
+		)
+	case filter.fallbackRecipient != "":
+		accounts, pagination, err = query.CollectionFilteredPaginate(
+			ctx, q.AccountsByFallbackRecipient, req.Pagination,
+			func(key collections.Pair[string, string], _ collections.NoValue) (bool, error) {
+				return filter.match(q.getAccount(ctx, key.K2())), nil
+			},
+			func(key collections.Pair[string, string], _ collections.NoValue) (types.Account, error) {
+				return *q.getAccount(ctx, key.K2()), nil
+			},
+			query.WithCollectionPaginationPairPrefix[string, string](filter.fallbackRecipient),
+		)
+	default:
+		var opts []func(*query.CollectionsPaginateOptions[collections.Pair[uint32, string]])
+		if filter.destinationDomain != nil {
+			opts = append(opts, query.WithCollectionPaginationPairPrefix[uint32, string](*filter.destinationDomain))
+		}
+		accounts, pagination, err = query.CollectionFilteredPaginate(
+			ctx, q.AccountsByDestinationDomain, req.Pagination,
+			func(key collections.Pair[uint32, string], _ collections.NoValue) (bool, error) {
+				return filter.match(q.getAccount(ctx, key.K2())), nil
+			},
+			func(key collections.Pair[uint32, string], _ collections.NoValue) (types.Account, error) {
+				return *q.getAccount(ctx, key.K2()), nil
+			},
+			opts...,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pagination}, nil
+}
+
+// QueuedTransfers implements types.QueryServer.
+func (q queryServer) QueuedTransfers(ctx context.Context, req *types.QueryQueuedTransfers) (*types.QueryQueuedTransfersResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
+	}
+
+	queuedTransfers, pagination, err := query.CollectionPaginate(
+		ctx, q.Keeper.TransferQueue, req.Pagination,
+		func(_ uint64, queuedTransfer types.QueuedTransfer) (types.QueuedTransfer, error) {
+			return queuedTransfer, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryQueuedTransfersResponse{QueuedTransfers: queuedTransfers, Pagination: pagination}, nil
+}
+
+// AwaitingTransfers implements types.QueryServer.
+func (q queryServer) AwaitingTransfers(ctx context.Context, req *types.QueryAwaitingTransfers) (*types.QueryAwaitingTransfersResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
+	}
+
+	denom := q.ftfKeeper.GetMintingDenom(ctx).Denom
+
+	awaitingTransfers, pagination, err := query.CollectionPaginate(
+		ctx, q.Keeper.AwaitingTransfers, req.Pagination,
+		func(address string, _ collections.NoValue) (types.AwaitingTransfer, error) {
+			addressBz, err := q.accountKeeper.AddressCodec().StringToBytes(address)
+			if err != nil {
+				return types.AwaitingTransfer{}, err
+			}
+			account, ok := q.accountKeeper.GetAccount(ctx, addressBz).(*types.Account)
+			if !ok {
+				return types.AwaitingTransfer{}, sdkerrors.ErrNotFound.Wrapf("no autocctp account for address %s", address)
+			}
+			balance := q.bankKeeper.GetBalance(ctx, addressBz, denom)
+			failed, err := q.Keeper.FailedTransfers.Has(ctx, address)
+			if err != nil {
+				return types.AwaitingTransfer{}, err
+			}
+			return types.AwaitingTransfer{
+				Address:           address,
+				DestinationDomain: account.DestinationDomain,
+				Amount:            balance.Amount,
+				Denom:             balance.Denom,
+				Failed:            failed,
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAwaitingTransfersResponse{AwaitingTransfers: awaitingTransfers, Pagination: pagination}, nil
+}
+
+// Paused implements types.QueryServer.
+func (q queryServer) Paused(ctx context.Context, req *types.QueryPaused) (*types.QueryPausedResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cannot be nil")
+	}
+
+	paused, err := q.Keeper.Paused.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	pausedDomains, err := q.GetPausedDomains(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPausedResponse{Paused: paused, PausedDestinationDomains: pausedDomains}, nil
+}
+
+// accountsFilter contains the parsed filters of an Accounts query.
+type accountsFilter struct {
+	destinationDomain *uint32
+	mintRecipient     []byte
+	fallbackRecipient string
+}
+
+// match returns true if the account is registered and satisfies all the filters.
+func (f accountsFilter) match(account *types.Account) bool {
+	switch {
+	case account == nil:
+		return false
+	case f.destinationDomain != nil && account.DestinationDomain != *f.destinationDomain:
+		return false
+	case f.mintRecipient != nil && !bytes.Equal(account.MintRecipient, f.mintRecipient):
+		return false
+	case f.fallbackRecipient != "" && account.FallbackRecipient != f.fallbackRecipient:
+		return false
+	default:
+		return true
+	}
+}
+
+// getAccountsFilter parses the filters of an Accounts query. The mint recipient is parsed
+// according to the destination domain encoding, if specified, or as an hex address otherwise.
+func (q queryServer) getAccountsFilter(ctx context.Context, req *types.QueryAccounts) (accountsFilter, error) {
+	var filter accountsFilter
+
+	domain := types.DomainConfig{AddressEncoding: types.AddressEncodingHex}
+	if req.DestinationDomain != "" {
+		destinationDomain, err := types.ParseDestinationDomain(req.DestinationDomain)
+		if err != nil {
+			return filter, types.ErrInvalidDestinationDomain.Wrap(err.Error())
+		}
+		filter.destinationDomain = &destinationDomain
+
+		if registered := q.GetDomain(ctx, destinationDomain); registered != nil {
+			domain = *registered
+		}
+	}
+
+	if req.MintRecipient != "" {
+		mintRecipient, err := domain.ParseAddress(req.MintRecipient)
+		if err != nil {
+			return filter, types.ErrInvalidMintRecipient.Wrap(err.Error())
+		}
+		filter.mintRecipient = mintRecipient
+	}
+
+	if req.FallbackRecipient != "" {
+		if _, err := q.accountKeeper.AddressCodec().StringToBytes(req.FallbackRecipient); err != nil {
+			return filter, types.ErrInvalidFallbackRecipient.Wrap(err.Error())
+		}
+		filter.fallbackRecipient = req.FallbackRecipient
+	}
+
+	return filter, nil
+}
+
+// getAccount returns the AutoCCTP account associated with the address, or nil if the
+// address is not associated with a registered account.
+func (q queryServer) getAccount(ctx context.Context, address string) *types.Account {
+	addressBz, err := q.accountKeeper.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return nil
+	}
+
+	account, _ := q.accountKeeper.GetAccount(ctx, addressBz).(*types.Account)
+	return account
+}
@@ -21,13 +21,17 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"autocctp.dev/keeper"
@@ -187,9 +191,11 @@ func TestStatsByDestinationDomain(t *testing.T) {
 			},
 			setup: func(*keeper.Keeper, sdk.Context) {},
 			expResponse: &types.QueryStatsByDestinationDomainResponse{
-				Accounts:         0,
-				Transfers:        0,
-				TotalTransferred: 0,
+				Accounts:               0,
+				Transfers:              0,
+				TotalTransferredAmount: math.ZeroInt(),
+				TotalFees:              math.ZeroInt(),
+				Outcomes:               types.NewOutcomeStats(),
 			},
 		},
 		{
@@ -202,13 +208,15 @@ func TestStatsByDestinationDomain(t *testing.T) {
 				require.NoError(t, err, "expected no error setting the number of accounts")
 				err = k.NumOfTransfers.Set(ctx, destinationDomain+1, 10)
 				require.NoError(t, err, "expected no error setting the number of transfers")
-				err = k.TotalTransferred.Set(ctx, destinationDomain+1, 1_000_000)
+				err = k.TotalTransferred.Set(ctx, destinationDomain+1, math.NewInt(1_000_000))
 				require.NoError(t, err, "expected no error setting the total transferred")
 			},
 			expResponse: &types.QueryStatsByDestinationDomainResponse{
-				Accounts:         0,
-				Transfers:        0,
-				TotalTransferred: 0,
+				Accounts:               0,
+				Transfers:              0,
+				TotalTransferredAmount: math.ZeroInt(),
+				TotalFees:              math.ZeroInt(),
+				Outcomes:               types.NewOutcomeStats(),
 			},
 		},
 		{
@@ -221,13 +229,34 @@ func TestStatsByDestinationDomain(t *testing.T) {
 				require.NoError(t, err, "expected no error setting the number of accounts")
 				err = k.NumOfTransfers.Set(ctx, destinationDomain, 10)
 				require.NoError(t, err, "expected no error setting the number of transfers")
-				err = k.TotalTransferred.Set(ctx, destinationDomain, 1_000_000)
+				err = k.TotalTransferred.Set(ctx, destinationDomain, math.NewInt(1_000_000))
 				require.NoError(t, err, "expected no error setting the total transferred")
 			},
 			expResponse: &types.QueryStatsByDestinationDomainResponse{
-				Accounts:         3,
-				Transfers:        10,
-				TotalTransferred: 1_000_000,
+				Accounts:               3,
+				Transfers:              10,
+				TotalTransferredAmount: math.NewInt(1_000_000),
+				TotalFees:              math.ZeroInt(),
+				Outcomes:               types.NewOutcomeStats(),
+			},
+		},
+		{
+			name: "valid request with a rate limited destination",
+			req: &types.QueryStatsByDestinationDomain{
+				DestinationDomain: uint32(types.ETHEREUM),
+			},
+			setup: func(k *keeper.Keeper, ctx sdk.Context) {
+				domain := types.NewDomainConfig(types.ETHEREUM, "Ethereum", types.AddressEncodingHex)
+				domain.RateLimit = &types.RateLimit{Window: 10, MaxTransfers: 5, MaxAmount: math.NewInt(1_000)}
+				require.NoError(t, k.SetDomain(ctx, domain), "expected no error setting the domain")
+				err := k.AddRateLimitUsage(ctx, uint32(types.ETHEREUM), types.NewRateLimitUsage(2, math.NewInt(400)))
+				require.NoError(t, err, "expected no error adding the rate limit usage")
+			},
+			expResponse: &types.QueryStatsByDestinationDomainResponse{
+				TotalTransferredAmount: math.ZeroInt(),
+				TotalFees:              math.ZeroInt(),
+				RateLimitCapacity:      &types.RateLimitCapacity{RemainingTransfers: 3, RemainingAmount: math.NewInt(600)},
+				Outcomes:               types.NewOutcomeStats(),
 			},
 		},
 		{
@@ -244,15 +273,17 @@ func TestStatsByDestinationDomain(t *testing.T) {
 				require.NoError(t, err, "expected no error setting the number of transfers")
 				err = k.NumOfTransfers.Set(ctx, destinationDomain+1, 10)
 				require.NoError(t, err, "expected no error setting the number of transfers")
-				err = k.TotalTransferred.Set(ctx, destinationDomain, 1_000_000)
+				err = k.TotalTransferred.Set(ctx, destinationDomain, math.NewInt(1_000_000))
 				require.NoError(t, err, "expected no error setting the total transferred")
-				err = k.TotalTransferred.Set(ctx, destinationDomain+1, 1_000_000)
+				err = k.TotalTransferred.Set(ctx, destinationDomain+1, math.NewInt(1_000_000))
 				require.NoError(t, err, "expected no error setting the total transferred")
 			},
 			expResponse: &types.QueryStatsByDestinationDomainResponse{
-				Accounts:         3,
-				Transfers:        10,
-				TotalTransferred: 1_000_000,
+				Accounts:               3,
+				Transfers:              10,
+				TotalTransferredAmount: math.NewInt(1_000_000),
+				TotalFees:              math.ZeroInt(),
+				Outcomes:               types.NewOutcomeStats(),
 			},
 		},
 	}
@@ -310,15 +341,17 @@ func TestStats(t *testing.T) {
 				require.NoError(t, err, "expected no error setting the number of accounts")
 				err = k.NumOfTransfers.Set(ctx, 0, 10)
 				require.NoError(t, err, "expected no error setting the number of transfers")
-				err = k.TotalTransferred.Set(ctx, 0, 1_000_000)
+				err = k.TotalTransferred.Set(ctx, 0, math.NewInt(1_000_000))
 				require.NoError(t, err, "expected no error setting the total transferred")
 			},
 			expResponse: &types.QueryStatsResponse{
 				DestinationDomainStats: map[uint32]types.DomainStats{
 					0: {
-						Accounts:         3,
-						Transfers:        10,
-						TotalTransferred: 1_000_000,
+						Accounts:               3,
+						Transfers:              10,
+						TotalTransferredAmount: math.NewInt(1_000_000),
+						TotalFees:              math.ZeroInt(),
+						Outcomes:               types.NewOutcomeStats(),
 					},
 				},
 			},
@@ -332,7 +365,7 @@ func TestStats(t *testing.T) {
 				require.NoError(t, err, "expected no error setting the number of accounts")
 				err = k.NumOfTransfers.Set(ctx, 0, 10)
 				require.NoError(t, err, "expected no error setting the number of transfers")
-				err = k.TotalTransferred.Set(ctx, 0, 1_000_000)
+				err = k.TotalTransferred.Set(ctx, 0, math.NewInt(1_000_000))
 				require.NoError(t, err, "expected no error setting the total transferred")
 				// Destination 1
 				err = k.NumOfAccounts.Set(ctx, 1, 1)
@@ -342,25 +375,31 @@ func TestStats(t *testing.T) {
 				require.NoError(t, err, "expected no error setting the number of accounts")
 				err = k.NumOfTransfers.Set(ctx, 3, 1_000_000)
 				require.NoError(t, err, "expected no error setting the number of transfers")
-				err = k.TotalTransferred.Set(ctx, 3, 1_000_000_000)
+				err = k.TotalTransferred.Set(ctx, 3, math.NewInt(1_000_000_000))
 				require.NoError(t, err, "expected no error setting the total transferred")
 			},
 			expResponse: &types.QueryStatsResponse{
 				DestinationDomainStats: map[uint32]types.DomainStats{
 					0: {
-						Accounts:         3,
-						Transfers:        10,
-						TotalTransferred: 1_000_000,
+						Accounts:               3,
+						Transfers:              10,
+						TotalTransferredAmount: math.NewInt(1_000_000),
+						TotalFees:              math.ZeroInt(),
+						Outcomes:               types.NewOutcomeStats(),
 					},
 					1: {
-						Accounts:         1,
-						Transfers:        0,
-						TotalTransferred: 0,
+						Accounts:               1,
+						Transfers:              0,
+						TotalTransferredAmount: math.ZeroInt(),
+						TotalFees:              math.ZeroInt(),
+						Outcomes:               types.NewOutcomeStats(),
 					},
 					3: {
-						Accounts:         3_000,
-						Transfers:        1_000_000,
-						TotalTransferred: 1_000_000_000,
+						Accounts:               3_000,
+						Transfers:              1_000_000,
+						TotalTransferredAmount: math.NewInt(1_000_000_000),
+						TotalFees:              math.ZeroInt(),
+						Outcomes:               types.NewOutcomeStats(),
 					},
 				},
 			},
@@ -389,3 +428,604 @@ func TestStats(t *testing.T) {
 		})
 	}
 }
+
+func TestParams(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewQueryServer(k)
+
+	// ACT
+	resp, err := server.Params(ctx, nil)
+
+	// ASSERT
+	require.Error(t, err, "expected an error with nil request")
+	require.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ACT
+	resp, err = server.Params(ctx, &types.QueryParams{})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Equal(t, types.DefaultParams(), resp.Params, "expected the default params")
+
+	// ARRANGE
+	params := types.NewParams(math.NewInt(1_000_000), types.DefaultMaxTransferAttempts, types.DefaultRetryBaseDelay, types.DefaultRetryMaxDelay, false, types.DefaultMaxTransferHistory)
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err, "expected no error setting the params")
+
+	// ACT
+	resp, err = server.Params(ctx, &types.QueryParams{})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Equal(t, params, resp.Params, "expected the updated params")
+}
+
+func TestDomains(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewQueryServer(k)
+
+	// ACT
+	resp, err := server.Domains(ctx, nil)
+
+	// ASSERT
+	require.Error(t, err, "expected an error with nil request")
+	require.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ACT
+	resp, err = server.Domains(ctx, &types.QueryDomains{})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.ElementsMatch(t, types.DefaultDomains(), resp.Domains, "expected the default domains")
+}
+
+func TestDomain(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewQueryServer(k)
+
+	// ACT
+	resp, err := server.Domain(ctx, nil)
+
+	// ASSERT
+	require.Error(t, err, "expected an error with nil request")
+	require.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ACT
+	resp, err = server.Domain(ctx, &types.QueryDomain{DestinationDomain: uint32(types.NOBLE)})
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the domain is not registered")
+	require.ErrorContains(t, err, sdkerrors.ErrNotFound.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ACT
+	resp, err = server.Domain(ctx, &types.QueryDomain{DestinationDomain: uint32(types.SOLANA)})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Equal(t, types.AddressEncodingBase58, resp.Domain.AddressEncoding, "expected solana to use base58 addresses")
+	require.True(t, resp.Domain.Enabled, "expected the domain to be enabled")
+}
+
+func TestFailedTransfers(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewQueryServer(k)
+
+	// ACT
+	resp, err := server.FailedTransfers(ctx, nil)
+
+	// ASSERT
+	require.Error(t, err, "expected an error with nil request")
+	require.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ACT
+	resp, err = server.FailedTransfers(ctx, &types.QueryFailedTransfers{})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Empty(t, resp.FailedTransfers, "expected no failed transfers")
+
+	// ARRANGE
+	for range 3 {
+		err = k.SetFailedTransfer(ctx, testutil.NobleAddress(), math.NewInt(1_000_000), errors.New("error"))
+		require.NoError(t, err, "expected no error setting the failed transfer")
+	}
+
+	// ACT
+	resp, err = server.FailedTransfers(ctx, &types.QueryFailedTransfers{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Len(t, resp.FailedTransfers, 2, "expected the failed transfers to be paginated")
+	require.Equal(t, uint64(3), resp.Pagination.Total, "expected a different total")
+}
+
+func TestFailedTransfer(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewQueryServer(k)
+	address := testutil.NobleAddress()
+
+	// ACT
+	resp, err := server.FailedTransfer(ctx, nil)
+
+	// ASSERT
+	require.Error(t, err, "expected an error with nil request")
+	require.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ACT
+	resp, err = server.FailedTransfer(ctx, &types.QueryFailedTransfer{Address: address})
+
+	// ASSERT
+	require.Error(t, err, "expected an error when the failed transfer does not exist")
+	require.ErrorContains(t, err, sdkerrors.ErrNotFound.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ARRANGE
+	err = k.SetFailedTransfer(ctx, address, math.NewInt(1_000_000), errors.New("error"))
+	require.NoError(t, err, "expected no error setting the failed transfer")
+
+	// ACT
+	resp, err = server.FailedTransfer(ctx, &types.QueryFailedTransfer{Address: address})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Equal(t, address, resp.FailedTransfer.Address, "expected a different address")
+	require.Equal(t, "error", resp.FailedTransfer.Error, "expected a different error")
+	require.Equal(t, uint64(1), resp.FailedTransfer.Attempts, "expected one attempt")
+}
+
+func TestTransferHistory(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewQueryServer(k)
+	address := testutil.NobleAddress()
+
+	// ACT
+	resp, err := server.TransferHistory(ctx, nil)
+
+	// ASSERT
+	require.Error(t, err, "expected an error with nil request")
+	require.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ACT
+	resp, err = server.TransferHistory(ctx, &types.QueryTransferHistory{Address: "noble1invalid"})
+
+	// ASSERT
+	require.Error(t, err, "expected an error with an invalid address")
+	require.ErrorContains(t, err, sdkerrors.ErrInvalidAddress.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ACT
+	resp, err = server.TransferHistory(ctx, &types.QueryTransferHistory{Address: address})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Empty(t, resp.Records, "expected no records")
+
+	// ARRANGE
+	for i := range 3 {
+		err = k.AddTransferRecord(ctx, address, sdk.NewInt64Coin("uusdc", int64(i+1)), uint64(i), types.TransferOutcomeExecuted, nil)
+		require.NoError(t, err, "expected no error adding the transfer record")
+	}
+	err = k.AddTransferRecord(ctx, testutil.NobleAddress(), sdk.NewInt64Coin("uusdc", 1), 0, types.TransferOutcomeExecuted, nil)
+	require.NoError(t, err, "expected no error adding the transfer record")
+
+	// ACT
+	resp, err = server.TransferHistory(ctx, &types.QueryTransferHistory{
+		Address:    address,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true, Reverse: true},
+	})
+
+	// ASSERT: Only the records of the account are returned, most recent first.
+	require.NoError(t, err, "expected no error")
+	require.Len(t, resp.Records, 2, "expected the records to be paginated")
+	require.Equal(t, uint64(3), resp.Pagination.Total, "expected a different total")
+	require.Equal(t, int64(3), resp.Records[0].Amount.Int64(), "expected the most recent record first")
+	for _, record := range resp.Records {
+		require.Equal(t, address, record.Address, "expected only records of the account")
+	}
+}
+
+func TestAccount(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewQueryServer(k)
+	msgServer := keeper.NewMsgServer(k)
+	accountProperties := testutil.ValidProperties(false)
+	address := types.GenerateAddress(accountProperties)
+
+	// ACT
+	resp, err := server.Account(ctx, nil)
+
+	// ASSERT
+	require.Error(t, err, "expected an error with nil request")
+	require.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ACT
+	resp, err = server.Account(ctx, &types.QueryAccount{Address: "cosmos1y5azhw4a99s4tm4kwzfwus52tjlvsaywuq3q3m"})
+
+	// ASSERT
+	require.ErrorContains(t, err, sdkerrors.ErrInvalidAddress.Error(), "expected an error with invalid address")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ACT
+	resp, err = server.Account(ctx, &types.QueryAccount{Address: address.String()})
+
+	// ASSERT
+	require.ErrorContains(t, err, sdkerrors.ErrNotFound.Error(), "expected an error when the account is not registered")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ARRANGE: Register the account and fund it.
+	_, err = msgServer.RegisterAccount(ctx, &types.MsgRegisterAccount{
+		Signer:            testutil.NobleAddress(),
+		DestinationDomain: accountProperties.DestinationDomain,
+		MintRecipient:     accountProperties.MintRecipient,
+		FallbackRecipient: accountProperties.FallbackRecipient,
+	})
+	require.NoError(t, err, "expected no error registering the account")
+	m.BankKeeper.Balances[address.String()] = sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10))
+
+	// ACT
+	resp, err = server.Account(ctx, &types.QueryAccount{Address: address.String()})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Equal(t, address.String(), resp.Account.Address, "expected a different account")
+	require.Equal(t, accountProperties.MintRecipient, resp.Account.MintRecipient, "expected a different mint recipient")
+	require.Equal(t, accountProperties.FallbackRecipient, resp.Account.FallbackRecipient, "expected a different fallback recipient")
+	require.Equal(t, sdk.NewInt64Coin("uusdc", 10), resp.Balance, "expected a different balance")
+}
+
+func TestAccounts(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewQueryServer(k)
+	msgServer := keeper.NewMsgServer(k)
+
+	mintRecipient := testutil.ValidProperties(false).MintRecipient
+	fallbackRecipient := testutil.NobleAddress()
+
+	// Register accounts on Ethereum and Base with the same mint recipient, and one
+	// account on Ethereum with a different mint recipient.
+	properties := []types.AccountProperties{
+		{DestinationDomain: uint32(types.ETHEREUM), MintRecipient: mintRecipient, FallbackRecipient: fallbackRecipient},
+		{DestinationDomain: uint32(types.BASE), MintRecipient: mintRecipient, FallbackRecipient: testutil.NobleAddress()},
+		{DestinationDomain: uint32(types.ETHEREUM), MintRecipient: testutil.ValidProperties(false).MintRecipient, FallbackRecipient: fallbackRecipient},
+	}
+	for _, p := range properties {
+		_, err := msgServer.RegisterAccount(ctx, &types.MsgRegisterAccount{
+			Signer:            testutil.NobleAddress(),
+			DestinationDomain: p.DestinationDomain,
+			MintRecipient:     p.MintRecipient,
+			FallbackRecipient: p.FallbackRecipient,
+		})
+		require.NoError(t, err, "expected no error registering the account")
+	}
+
+	testCases := []struct {
+		name        string
+		req         *types.QueryAccounts
+		expAccounts []types.AccountProperties
+		errContains string
+	}{
+		{
+			name:        "fail when request is nil",
+			req:         nil,
+			errContains: sdkerrors.ErrInvalidRequest.Error(),
+		},
+		{
+			name:        "fail when destination domain is invalid",
+			req:         &types.QueryAccounts{DestinationDomain: "ethereum"},
+			errContains: types.ErrInvalidDestinationDomain.Error(),
+		},
+		{
+			name:        "fail when mint recipient is invalid",
+			req:         &types.QueryAccounts{MintRecipient: "recipient"},
+			errContains: types.ErrInvalidMintRecipient.Error(),
+		},
+		{
+			name:        "fail when fallback recipient is invalid",
+			req:         &types.QueryAccounts{FallbackRecipient: "cosmos1y5azhw4a99s4tm4kwzfwus52tjlvsaywuq3q3m"},
+			errContains: types.ErrInvalidFallbackRecipient.Error(),
+		},
+		{
+			name:        "success without filters",
+			req:         &types.QueryAccounts{},
+			expAccounts: properties,
+		},
+		{
+			name:        "success filtering by destination domain",
+			req:         &types.QueryAccounts{DestinationDomain: "0"},
+			expAccounts: []types.AccountProperties{properties[0], properties[2]},
+		},
+		{
+			name:        "success filtering by mint recipient",
+			req:         &types.QueryAccounts{MintRecipient: common.BytesToAddress(mintRecipient).Hex()},
+			expAccounts: properties[:2],
+		},
+		{
+			name:        "success filtering by fallback recipient",
+			req:         &types.QueryAccounts{FallbackRecipient: fallbackRecipient},
+			expAccounts: []types.AccountProperties{properties[0], properties[2]},
+		},
+		{
+			name: "success filtering by all the properties",
+			req: &types.QueryAccounts{
+				DestinationDomain: "6",
+				MintRecipient:     common.BytesToAddress(mintRecipient).Hex(),
+				FallbackRecipient: properties[1].FallbackRecipient,
+			},
+			expAccounts: properties[1:2],
+		},
+		{
+			name:        "success with no matching accounts",
+			req:         &types.QueryAccounts{DestinationDomain: "6", FallbackRecipient: fallbackRecipient},
+			expAccounts: []types.AccountProperties{},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			// ACT
+			resp, err := server.Accounts(ctx, tC.req)
+
+			// ASSERT
+			if tC.errContains != "" {
+				require.ErrorContains(t, err, tC.errContains, "expected a different error")
+				require.Nil(t, resp, "expected nil response when receiving an error")
+				return
+			}
+			require.NoError(t, err, "expected no error")
+			require.Len(t, resp.Accounts, len(tC.expAccounts), "expected a different number of accounts")
+
+			expAddresses := make([]string, 0, len(tC.expAccounts))
+			for _, p := range tC.expAccounts {
+				expAddresses = append(expAddresses, types.GenerateAddress(p).String())
+			}
+			for _, account := range resp.Accounts {
+				require.Contains(t, expAddresses, account.Address, "expected a different account")
+			}
+		})
+	}
+
+	// ACT
+	resp, err := server.Accounts(ctx, &types.QueryAccounts{
+		FallbackRecipient: fallbackRecipient,
+		Pagination:        &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Len(t, resp.Accounts, 1, "expected the accounts to be paginated")
+	require.NotNil(t, resp.Pagination.NextKey, "expected a next page")
+}
+
+func TestQueuedTransfers(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewQueryServer(k)
+
+	// ACT
+	resp, err := server.QueuedTransfers(ctx, nil)
+
+	// ASSERT
+	require.Error(t, err, "expected an error with nil request")
+	require.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ARRANGE
+	first := testutil.AutoCCTPAccount(false)
+	second := testutil.AutoCCTPAccount(false)
+	require.NoError(t, k.EnqueueTransfer(ctx.WithBlockHeight(2), first), "expected no error queueing the transfer")
+	require.NoError(t, k.EnqueueTransfer(ctx.WithBlockHeight(3), second), "expected no error queueing the transfer")
+	require.NoError(t, k.EnqueueTransfer(ctx.WithBlockHeight(4), first), "expected no error queueing the transfer again")
+
+	// ACT
+	resp, err = server.QueuedTransfers(ctx, &types.QueryQueuedTransfers{})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Equal(t, []types.QueuedTransfer{
+		{Address: first.Address, DestinationDomain: first.DestinationDomain, Height: 2, Id: 0},
+		{Address: second.Address, DestinationDomain: second.DestinationDomain, Height: 3, Id: 1},
+	}, resp.QueuedTransfers, "expected the queued transfers in order, without duplicates")
+}
+
+func TestStatsHistory(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewQueryServer(k)
+	day := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	ethereum, base := uint32(types.ETHEREUM), uint32(types.BASE)
+	require.NoError(t, k.AddStatsHistory(ctx.WithBlockTime(day.Add(time.Hour)), ethereum, math.NewInt(100)), "expected no error adding the stats history")
+	require.NoError(t, k.AddStatsHistory(ctx.WithBlockTime(day.Add(2*time.Hour)), ethereum, math.NewInt(200)), "expected no error adding the stats history")
+	require.NoError(t, k.AddStatsHistory(ctx.WithBlockTime(day.Add(2*time.Hour)), base, math.NewInt(1_000)), "expected no error adding the stats history")
+	require.NoError(t, k.AddStatsHistory(ctx.WithBlockTime(day.Add(49*time.Hour)), ethereum, math.NewInt(400)), "expected no error adding the stats history")
+
+	first := types.StatsBucket{DestinationDomain: ethereum, StartTime: day, Transfers: 2, Amount: math.NewInt(300)}
+	last := types.StatsBucket{DestinationDomain: ethereum, StartTime: day.Add(48 * time.Hour), Transfers: 1, Amount: math.NewInt(400)}
+	endTime := day.Add(24 * time.Hour)
+	startTime := day.Add(time.Hour)
+
+	testCases := []struct {
+		name        string
+		req         *types.QueryStatsHistory
+		expBuckets  []types.StatsBucket
+		errContains string
+	}{
+		{
+			name:        "fails when the request is nil",
+			req:         nil,
+			errContains: sdkerrors.ErrInvalidRequest.Error(),
+		},
+		{
+			name:        "fails when the start time is not before the end time",
+			req:         &types.QueryStatsHistory{DestinationDomain: ethereum, StartTime: &endTime, EndTime: &startTime},
+			errContains: "start time must be before end time",
+		},
+		{
+			name:       "returns all the buckets of the destination domain",
+			req:        &types.QueryStatsHistory{DestinationDomain: ethereum},
+			expBuckets: []types.StatsBucket{first, last},
+		},
+		{
+			name:       "returns the buckets starting before the end time",
+			req:        &types.QueryStatsHistory{DestinationDomain: ethereum, EndTime: &endTime},
+			expBuckets: []types.StatsBucket{first},
+		},
+		{
+			name:       "returns the buckets starting at or after the start time",
+			req:        &types.QueryStatsHistory{DestinationDomain: ethereum, StartTime: &startTime},
+			expBuckets: []types.StatsBucket{last},
+		},
+		{
+			name:       "returns no buckets for a domain without transfers",
+			req:        &types.QueryStatsHistory{DestinationDomain: uint32(types.SOLANA)},
+			expBuckets: nil,
+		},
+		{
+			name:       "returns a page of the buckets",
+			req:        &types.QueryStatsHistory{DestinationDomain: ethereum, Pagination: &query.PageRequest{Limit: 1}},
+			expBuckets: []types.StatsBucket{first},
+		},
+		{
+			name:       "returns a page of the buckets in the time range",
+			req:        &types.QueryStatsHistory{DestinationDomain: ethereum, StartTime: &startTime, Pagination: &query.PageRequest{Limit: 1}},
+			expBuckets: []types.StatsBucket{last},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			// ACT
+			resp, err := server.StatsHistory(ctx, tC.req)
+
+			// ASSERT
+			if tC.errContains != "" {
+				require.ErrorContains(t, err, tC.errContains, "expected a different error")
+				require.Nil(t, resp, "expected nil response when receiving an error")
+				return
+			}
+			require.NoError(t, err, "expected no error")
+			require.Equal(t, tC.expBuckets, resp.Buckets, "expected different buckets")
+		})
+	}
+}
+
+func TestAwaitingTransfers(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewQueryServer(k)
+	msgServer := keeper.NewMsgServer(k)
+
+	// ACT
+	resp, err := server.AwaitingTransfers(ctx, nil)
+
+	// ASSERT
+	require.Error(t, err, "expected an error with nil request")
+	require.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ARRANGE: Register three accounts, one without funds, one with less than the minimum
+	// transfer amount, and one with enough funds to be cleared.
+	minimumTransferAmount := k.GetMinimumTransferAmount(ctx)
+	balances := []math.Int{math.ZeroInt(), minimumTransferAmount.SubRaw(1), minimumTransferAmount}
+	addresses := make([]string, 0, len(balances))
+	for _, balance := range balances {
+		accountProperties := testutil.ValidProperties(false)
+		regResp, err := msgServer.RegisterAccount(ctx, &types.MsgRegisterAccount{
+			Signer:            testutil.NobleAddress(),
+			DestinationDomain: accountProperties.DestinationDomain,
+			MintRecipient:     accountProperties.MintRecipient,
+			FallbackRecipient: accountProperties.FallbackRecipient,
+		})
+		require.NoError(t, err, "expected no error registering the account")
+		m.BankKeeper.Balances[regResp.Address] = sdk.NewCoins(sdk.NewCoin("uusdc", balance))
+		addresses = append(addresses, regResp.Address)
+	}
+
+	// ACT: No transfer has been deferred yet.
+	resp, err = server.AwaitingTransfers(ctx, &types.QueryAwaitingTransfers{})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Empty(t, resp.AwaitingTransfers, "expected no awaiting transfers")
+
+	// ARRANGE: The transfers are deferred while paused.
+	require.NoError(t, k.SetPaused(ctx, true), "expected no error pausing the transfers")
+	for _, address := range addresses {
+		account, ok := m.AccountKeeper.Accounts[address].(*types.Account)
+		require.True(t, ok, "expected an autocctp account")
+		require.NoError(t, k.AddPendingTransfer(ctx, *account), "expected no error adding the pending transfer")
+	}
+	k.ExecuteTransfers(ctx)
+
+	// ACT
+	resp, err = server.AwaitingTransfers(ctx, &types.QueryAwaitingTransfers{})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Len(t, resp.AwaitingTransfers, 1, "expected only the account holding the minimum transfer amount")
+	require.Equal(t, addresses[2], resp.AwaitingTransfers[0].Address, "expected a different account")
+	require.Equal(t, minimumTransferAmount, resp.AwaitingTransfers[0].Amount, "expected a different amount")
+	require.Equal(t, "uusdc", resp.AwaitingTransfers[0].Denom, "expected a different denom")
+	require.False(t, resp.AwaitingTransfers[0].Failed, "expected the transfer to not be failed")
+
+	// ARRANGE
+	err = k.SetFailedTransfer(ctx, addresses[2], minimumTransferAmount, errors.New("error"))
+	require.NoError(t, err, "expected no error setting the failed transfer")
+
+	// ACT
+	resp, err = server.AwaitingTransfers(ctx, &types.QueryAwaitingTransfers{})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.Len(t, resp.AwaitingTransfers, 1, "expected the failed transfer to be awaiting execution")
+	require.True(t, resp.AwaitingTransfers[0].Failed, "expected the transfer to be failed")
+}
+
+func TestPaused(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	server := keeper.NewQueryServer(k)
+
+	// ACT
+	resp, err := server.Paused(ctx, nil)
+
+	// ASSERT
+	require.Error(t, err, "expected an error with nil request")
+	require.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error(), "expected a different error")
+	require.Nil(t, resp, "expected nil response when receiving an error")
+
+	// ACT
+	resp, err = server.Paused(ctx, &types.QueryPaused{})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.False(t, resp.Paused, "expected the transfers to not be paused")
+	require.Empty(t, resp.PausedDestinationDomains, "expected no paused domains")
+
+	// ARRANGE
+	require.NoError(t, k.SetPaused(ctx, true))
+	require.NoError(t, k.SetPaused(ctx, true, uint32(types.BASE), uint32(types.ETHEREUM)))
+
+	// ACT
+	resp, err = server.Paused(ctx, &types.QueryPaused{})
+
+	// ASSERT
+	require.NoError(t, err, "expected no error")
+	require.True(t, resp.Paused, "expected the transfers to be paused")
+	require.Equal(t, []uint32{uint32(types.ETHEREUM), uint32(types.BASE)}, resp.PausedDestinationDomains, "expected a different paused domains")
+}
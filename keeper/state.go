@@ -24,14 +24,539 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"autocctp.dev/types"
 )
 
 // Setters
 
+func (k *Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := k.Params.Set(ctx, params); err != nil {
+		return fmt.Errorf("error setting the params: %w", err)
+	}
+
+	return nil
+}
+
+func (k *Keeper) SetDomain(ctx context.Context, domain types.DomainConfig) error {
+	if err := k.Domains.Set(ctx, domain.DestinationDomain, domain); err != nil {
+		return fmt.Errorf("error setting destination domain %d: %w", domain.DestinationDomain, err)
+	}
+
+	return nil
+}
+
+// SetPaused updates the pause state of the automatic transfers for the destination domains,
+// or the global pause state if no destination domain is specified.
+func (k *Keeper) SetPaused(ctx context.Context, paused bool, destinationDomains ...uint32) error {
+	if len(destinationDomains) == 0 {
+		if err := k.Paused.Set(ctx, paused); err != nil {
+			return fmt.Errorf("error setting the global pause state: %w", err)
+		}
+		return nil
+	}
+
+	for _, destinationDomain := range destinationDomains {
+		var err error
+		if paused {
+			err = k.PausedDomains.Set(ctx, destinationDomain)
+		} else {
+			err = k.PausedDomains.Remove(ctx, destinationDomain)
+		}
+		if err != nil {
+			return fmt.Errorf("error setting the pause state of destination domain %d: %w", destinationDomain, err)
+		}
+	}
+
+	return nil
+}
+
+// SetFailedTransfer records a failed execution attempt of the automatic transfer of the account,
+// scheduling the next retry according to the module parameters.
+func (k *Keeper) SetFailedTransfer(ctx context.Context, address string, amount math.Int, transferErr error) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	attempts := uint64(1)
+	firstFailureHeight, firstFailureTime := height, sdkCtx.BlockTime()
+	if failedTransfer := k.GetFailedTransfer(ctx, address); failedTransfer != nil {
+		attempts = failedTransfer.Attempts + 1
+		firstFailureHeight, firstFailureTime = failedTransfer.FirstFailureHeight, failedTransfer.FirstFailureTime
+	}
+	nextRetryHeight := height + int64(k.GetParams(ctx).RetryDelay(attempts))
+
+	failedTransfer := types.NewFailedTransfer(address, amount, transferErr, height, attempts, nextRetryHeight)
+	failedTransfer.FirstFailureHeight = firstFailureHeight
+	failedTransfer.FirstFailureTime = firstFailureTime
+
+	return k.StoreFailedTransfer(ctx, failedTransfer)
+}
+
+// DeferFailedTransfer postpones the next retry of the failed transfer associated with the
+// account, if any, by the retry delay of its attempts without counting a new attempt.
+func (k *Keeper) DeferFailedTransfer(ctx context.Context, address string) error {
+	failedTransfer := k.GetFailedTransfer(ctx, address)
+	if failedTransfer == nil {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	failedTransfer.NextRetryHeight = height + int64(k.GetParams(ctx).RetryDelay(failedTransfer.Attempts))
+
+	return k.StoreFailedTransfer(ctx, *failedTransfer)
+}
+
+// StoreFailedTransfer stores the failed transfer, replacing the existing one of the account,
+// and schedules its retry unless it exhausted the maximum number of attempts.
+func (k *Keeper) StoreFailedTransfer(ctx context.Context, failedTransfer types.FailedTransfer) error {
+	if err := k.unscheduleFailedTransfer(ctx, failedTransfer.Address); err != nil {
+		return err
+	}
+	if err := k.FailedTransfers.Set(ctx, failedTransfer.Address, failedTransfer); err != nil {
+		return fmt.Errorf("error setting the failed transfer for address %s: %w", failedTransfer.Address, err)
+	}
+
+	if failedTransfer.Attempts >= k.GetParams(ctx).MaxTransferAttempts {
+		return nil
+	}
+	if err := k.FailedTransfersByRetryHeight.Set(ctx, collections.Join(failedTransfer.NextRetryHeight, failedTransfer.Address), collections.NoValue{}); err != nil {
+		return fmt.Errorf("error scheduling the failed transfer for address %s: %w", failedTransfer.Address, err)
+	}
+
+	return nil
+}
+
+// SetAccountIndexes adds the AutoCCTP account to the secondary indexes used to look up
+// accounts by destination domain, mint recipient, fallback recipient, and expiration.
+func (k *Keeper) SetAccountIndexes(ctx context.Context, account *types.Account) error {
+	if err := k.AccountsByDestinationDomain.Set(ctx, collections.Join(account.DestinationDomain, account.Address), collections.NoValue{}); err != nil {
+		return fmt.Errorf("error indexing account %s by destination domain: %w", account.Address, err)
+	}
+	if err := k.AccountsByMintRecipient.Set(ctx, collections.Join(account.MintRecipient, account.Address), collections.NoValue{}); err != nil {
+		return fmt.Errorf("error indexing account %s by mint recipient: %w", account.Address, err)
+	}
+	if err := k.AccountsByFallbackRecipient.Set(ctx, collections.Join(account.FallbackRecipient, account.Address), collections.NoValue{}); err != nil {
+		return fmt.Errorf("error indexing account %s by fallback recipient: %w", account.Address, err)
+	}
+	if account.ExpirationHeight != 0 {
+		if err := k.AccountsByExpirationHeight.Set(ctx, collections.Join(account.ExpirationHeight, account.Address), collections.NoValue{}); err != nil {
+			return fmt.Errorf("error indexing account %s by expiration height: %w", account.Address, err)
+		}
+	}
+	if account.ExpirationTime != nil {
+		if err := k.AccountsByExpirationTime.Set(ctx, collections.Join(*account.ExpirationTime, account.Address), collections.NoValue{}); err != nil {
+			return fmt.Errorf("error indexing account %s by expiration time: %w", account.Address, err)
+		}
+	}
+
+	return nil
+}
+
+// RemoveAccountIndexes removes the AutoCCTP account from the secondary indexes.
+func (k *Keeper) RemoveAccountIndexes(ctx context.Context, account *types.Account) error {
+	if err := k.AccountsByDestinationDomain.Remove(ctx, collections.Join(account.DestinationDomain, account.Address)); err != nil {
+		return fmt.Errorf("error removing account %s from the destination domain index: %w", account.Address, err)
+	}
+	if err := k.AccountsByMintRecipient.Remove(ctx, collections.Join(account.MintRecipient, account.Address)); err != nil {
+		return fmt.Errorf("error removing account %s from the mint recipient index: %w", account.Address, err)
+	}
+	if err := k.AccountsByFallbackRecipient.Remove(ctx, collections.Join(account.FallbackRecipient, account.Address)); err != nil {
+		return fmt.Errorf("error removing account %s from the fallback recipient index: %w", account.Address, err)
+	}
+	if err := k.AccountsByExpirationHeight.Remove(ctx, collections.Join(account.ExpirationHeight, account.Address)); err != nil {
+		return fmt.Errorf("error removing account %s from the expiration height index: %w", account.Address, err)
+	}
+	if err := k.AccountsByExpirationTime.Remove(ctx, collections.Join(account.GetExpirationTimeOrZero(), account.Address)); err != nil {
+		return fmt.Errorf("error removing account %s from the expiration time index: %w", account.Address, err)
+	}
+
+	return nil
+}
+
+// RebuildAccountIndexes clears the secondary account indexes and rebuilds them from the
+// AutoCCTP accounts stored in the account keeper. Deregistered accounts are not indexed.
+func (k *Keeper) RebuildAccountIndexes(ctx context.Context) error {
+	if err := k.AccountsByDestinationDomain.Clear(ctx, nil); err != nil {
+		return fmt.Errorf("error clearing the destination domain index: %w", err)
+	}
+	if err := k.AccountsByMintRecipient.Clear(ctx, nil); err != nil {
+		return fmt.Errorf("error clearing the mint recipient index: %w", err)
+	}
+	if err := k.AccountsByFallbackRecipient.Clear(ctx, nil); err != nil {
+		return fmt.Errorf("error clearing the fallback recipient index: %w", err)
+	}
+	if err := k.AccountsByExpirationHeight.Clear(ctx, nil); err != nil {
+		return fmt.Errorf("error clearing the expiration height index: %w", err)
+	}
+	if err := k.AccountsByExpirationTime.Clear(ctx, nil); err != nil {
+		return fmt.Errorf("error clearing the expiration time index: %w", err)
+	}
+
+	var err error
+	k.accountKeeper.IterateAccounts(ctx, func(rawAccount sdk.AccountI) bool {
+		account, ok := rawAccount.(*types.Account)
+		if !ok || account.Deregistered {
+			return false
+		}
+		err = k.SetAccountIndexes(ctx, account)
+		return err != nil
+	})
+
+	return err
+}
+
+// RemoveFailedTransfer removes the failed transfer associated with the account, if any.
+func (k *Keeper) RemoveFailedTransfer(ctx context.Context, address string) error {
+	if err := k.unscheduleFailedTransfer(ctx, address); err != nil {
+		return err
+	}
+	if err := k.FailedTransfers.Remove(ctx, address); err != nil {
+		return fmt.Errorf("error removing the failed transfer for address %s: %w", address, err)
+	}
+
+	return nil
+}
+
+// unscheduleFailedTransfer removes the scheduled retry of the failed transfer associated
+// with the account, if any.
+func (k *Keeper) unscheduleFailedTransfer(ctx context.Context, address string) error {
+	failedTransfer := k.GetFailedTransfer(ctx, address)
+	if failedTransfer == nil {
+		return nil
+	}
+	if err := k.FailedTransfersByRetryHeight.Remove(ctx, collections.Join(failedTransfer.NextRetryHeight, address)); err != nil {
+		return fmt.Errorf("error unscheduling the failed transfer for address %s: %w", address, err)
+	}
+
+	return nil
+}
+
+// SetFailedForward records a failed forwarding of the other denoms of the AutoCCTP account,
+// counting a new attempt, and schedules its retry with an exponential backoff.
+func (k *Keeper) SetFailedForward(ctx context.Context, address string, forwardErr error) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	attempts := uint64(1)
+	if failedForward := k.GetFailedForward(ctx, address); failedForward != nil {
+		attempts = failedForward.Attempts + 1
+	}
+
+	return k.StoreFailedForward(ctx, types.FailedForward{
+		Address:         address,
+		Error:           forwardErr.Error(),
+		Attempts:        attempts,
+		NextRetryHeight: height + int64(k.GetParams(ctx).RetryDelay(attempts)),
+	})
+}
+
+// StoreFailedForward stores the failed forward, replacing the existing one of the account,
+// and schedules its retry unless it exhausted the maximum number of attempts.
+func (k *Keeper) StoreFailedForward(ctx context.Context, failedForward types.FailedForward) error {
+	if err := k.unscheduleFailedForward(ctx, failedForward.Address); err != nil {
+		return err
+	}
+	if err := k.FailedForwards.Set(ctx, failedForward.Address, failedForward); err != nil {
+		return fmt.Errorf("error setting the failed forward for address %s: %w", failedForward.Address, err)
+	}
+
+	if failedForward.Attempts >= k.GetParams(ctx).MaxTransferAttempts {
+		return nil
+	}
+	if err := k.FailedForwardsByRetryHeight.Set(ctx, collections.Join(failedForward.NextRetryHeight, failedForward.Address), collections.NoValue{}); err != nil {
+		return fmt.Errorf("error scheduling the failed forward for address %s: %w", failedForward.Address, err)
+	}
+
+	return nil
+}
+
+// RemoveFailedForward removes the failed forward of the AutoCCTP account, if any.
+func (k *Keeper) RemoveFailedForward(ctx context.Context, address string) error {
+	if err := k.unscheduleFailedForward(ctx, address); err != nil {
+		return err
+	}
+	if err := k.FailedForwards.Remove(ctx, address); err != nil {
+		return fmt.Errorf("error removing the failed forward for address %s: %w", address, err)
+	}
+
+	return nil
+}
+
+// unscheduleFailedForward removes the failed forward of the account, if any, from the
+// retry schedule.
+func (k *Keeper) unscheduleFailedForward(ctx context.Context, address string) error {
+	failedForward := k.GetFailedForward(ctx, address)
+	if failedForward == nil {
+		return nil
+	}
+	if err := k.FailedForwardsByRetryHeight.Remove(ctx, collections.Join(failedForward.NextRetryHeight, address)); err != nil {
+		return fmt.Errorf("error unscheduling the failed forward for address %s: %w", address, err)
+	}
+
+	return nil
+}
+
+// updateAwaitingTransfer adds the AutoCCTP account to the awaiting transfers if it holds at
+// least the minimum transfer amount, or removes it otherwise.
+func (k *Keeper) updateAwaitingTransfer(ctx context.Context, address string) error {
+	addressBz, err := k.accountKeeper.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return err
+	}
+
+	denom := k.ftfKeeper.GetMintingDenom(ctx).Denom
+	if k.bankKeeper.GetBalance(ctx, addressBz, denom).Amount.LT(k.GetMinimumTransferAmount(ctx)) {
+		if err := k.AwaitingTransfers.Remove(ctx, address); err != nil {
+			return fmt.Errorf("error removing the awaiting transfer for address %s: %w", address, err)
+		}
+		return nil
+	}
+	if err := k.AwaitingTransfers.Set(ctx, address); err != nil {
+		return fmt.Errorf("error setting the awaiting transfer for address %s: %w", address, err)
+	}
+
+	return nil
+}
+
+// EnqueueTransfer adds the transfer of the AutoCCTP account to the end of the transfer
+// queue, if not already queued.
+func (k *Keeper) EnqueueTransfer(ctx context.Context, account types.Account) error {
+	if has, err := k.TransferQueueByAddress.Has(ctx, account.Address); err != nil || has {
+		return err
+	}
+
+	id, err := k.TransferQueueSequence.Next(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting the next queued transfer id: %w", err)
+	}
+
+	queuedTransfer := types.QueuedTransfer{
+		Id:                id,
+		Address:           account.Address,
+		DestinationDomain: account.DestinationDomain,
+		Height:            sdk.UnwrapSDKContext(ctx).BlockHeight(),
+	}
+	if err := k.TransferQueue.Set(ctx, id, queuedTransfer); err != nil {
+		return fmt.Errorf("error queueing the transfer for address %s: %w", account.Address, err)
+	}
+	if err := k.TransferQueueByAddress.Set(ctx, account.Address, id); err != nil {
+		return fmt.Errorf("error indexing the queued transfer for address %s: %w", account.Address, err)
+	}
+
+	return nil
+}
+
+// GetQueuedTransfers returns the AutoCCTP accounts of up to limit transfers from the front of
+// the transfer queue, which are removed from the queue once executed. If limit is zero, the
+// whole queue is returned.
+func (k *Keeper) GetQueuedTransfers(ctx context.Context, limit uint64) ([]types.Account, error) {
+	accounts := []types.Account{}
+	err := k.TransferQueue.Walk(ctx, nil, func(_ uint64, queuedTransfer types.QueuedTransfer) (bool, error) {
+		address, err := k.accountKeeper.AddressCodec().StringToBytes(queuedTransfer.Address)
+		if err != nil {
+			return true, err
+		}
+		if account, ok := k.accountKeeper.GetAccount(ctx, address).(*types.Account); ok {
+			accounts = append(accounts, *account)
+		}
+
+		return limit != 0 && uint64(len(accounts)) >= limit, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking the transfer queue: %w", err)
+	}
+
+	return accounts, nil
+}
+
+// GetTransferQueue returns the queued transfers, in the order they were queued.
+func (k *Keeper) GetTransferQueue(ctx context.Context) ([]types.QueuedTransfer, error) {
+	iter, err := k.TransferQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// RemoveQueuedTransfer removes the queued transfer of the AutoCCTP account, if any.
+func (k *Keeper) RemoveQueuedTransfer(ctx context.Context, address string) error {
+	id, err := k.TransferQueueByAddress.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error getting the queued transfer for address %s: %w", address, err)
+	}
+
+	if err := k.TransferQueue.Remove(ctx, id); err != nil {
+		return fmt.Errorf("error removing the queued transfer for address %s: %w", address, err)
+	}
+	if err := k.TransferQueueByAddress.Remove(ctx, address); err != nil {
+		return fmt.Errorf("error removing the queued transfer index for address %s: %w", address, err)
+	}
+
+	return nil
+}
+
+// AddPendingTransfer marks the AutoCCTP account for the execution of the automatic transfer at
+// the end of the current block. If the account already has a pending transfer, it keeps its
+// position in the execution order.
+func (k *Keeper) AddPendingTransfer(ctx context.Context, account types.Account) error {
+	id, err := k.PendingTransfersByAddress.Get(ctx, account.Address)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		if id, err = k.PendingTransfersSequence.Next(ctx); err != nil {
+			return fmt.Errorf("error getting the next pending transfer id: %w", err)
+		}
+		if err := k.PendingTransfersByAddress.Set(ctx, account.Address, id); err != nil {
+			return fmt.Errorf("error indexing the pending transfer for address %s: %w", account.Address, err)
+		}
+	case err != nil:
+		return fmt.Errorf("error getting the pending transfer for address %s: %w", account.Address, err)
+	}
+
+	if err := k.PendingTransfers.Set(ctx, id, account); err != nil {
+		return fmt.Errorf("error setting the pending transfer for address %s: %w", account.Address, err)
+	}
+
+	return nil
+}
+
+// RemovePendingTransfer removes the pending transfer of the AutoCCTP account, if any.
+func (k *Keeper) RemovePendingTransfer(ctx context.Context, address string) error {
+	id, err := k.PendingTransfersByAddress.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error getting the pending transfer for address %s: %w", address, err)
+	}
+
+	if err := k.PendingTransfers.Remove(ctx, id); err != nil {
+		return fmt.Errorf("error removing the pending transfer for address %s: %w", address, err)
+	}
+	if err := k.PendingTransfersByAddress.Remove(ctx, address); err != nil {
+		return fmt.Errorf("error removing the pending transfer index for address %s: %w", address, err)
+	}
+
+	return nil
+}
+
+// ClearPendingTransfers removes all the pending transfers. The pending transfers are kept in
+// the transient store, so they are cleared at the end of every block anyway.
+func (k *Keeper) ClearPendingTransfers(ctx context.Context) error {
+	if err := k.PendingTransfers.Clear(ctx, nil); err != nil {
+		return fmt.Errorf("error clearing the pending transfers: %w", err)
+	}
+	if err := k.PendingTransfersByAddress.Clear(ctx, nil); err != nil {
+		return fmt.Errorf("error clearing the pending transfers index: %w", err)
+	}
+	if err := k.PendingTransfersSequence.Set(ctx, 0); err != nil {
+		return fmt.Errorf("error resetting the pending transfers sequence: %w", err)
+	}
+
+	return nil
+}
+
+// AddTransferRecord adds a record to the transfer history of the account, pruning the oldest
+// records exceeding the maximum history size defined in the module parameters.
+func (k *Keeper) AddTransferRecord(ctx context.Context, address string, coin sdk.Coin, nonce uint64, outcome types.TransferOutcome, transferErr error) error {
+	maxTransferHistory := k.GetParams(ctx).MaxTransferHistory
+	if maxTransferHistory == 0 {
+		return nil
+	}
+
+	id, err := k.TransferHistorySequence.Next(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting the next transfer record id: %w", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	record := types.TransferRecord{
+		Address: address,
+		Id:      id,
+		Height:  sdkCtx.BlockHeight(),
+		Time:    sdkCtx.BlockTime(),
+		Amount:  coin.Amount,
+		Denom:   coin.Denom,
+		Nonce:   nonce,
+		Outcome: outcome,
+	}
+	if transferErr != nil {
+		record.Error = transferErr.Error()
+	}
+
+	if err := k.TransferHistory.Set(ctx, collections.Join(address, id), record); err != nil {
+		return fmt.Errorf("error setting the transfer record for address %s: %w", address, err)
+	}
+
+	keys, err := k.getTransferHistoryKeys(ctx, address)
+	if err != nil {
+		return fmt.Errorf("error getting the transfer history for address %s: %w", address, err)
+	}
+	for uint64(len(keys)) > maxTransferHistory {
+		if err := k.TransferHistory.Remove(ctx, keys[0]); err != nil {
+			return fmt.Errorf("error pruning the transfer history for address %s: %w", address, err)
+		}
+		keys = keys[1:]
+	}
+
+	return nil
+}
+
+// AddRateLimitUsage counts the usage against the rate limit of the destination domain in the
+// current block.
+func (k *Keeper) AddRateLimitUsage(ctx context.Context, destinationDomain uint32, usage types.RateLimitUsage) error {
+	key := collections.Join(destinationDomain, sdk.UnwrapSDKContext(ctx).BlockHeight())
+	bucket, err := k.RateLimitBuckets.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		bucket = types.NewRateLimitUsage(0, math.ZeroInt())
+	} else if err != nil {
+		return fmt.Errorf("error getting the rate limit usage of the block: %w", err)
+	}
+	if err := k.RateLimitBuckets.Set(ctx, key, bucket.Add(usage)); err != nil {
+		return fmt.Errorf("error setting the rate limit usage of the block: %w", err)
+	}
+
+	total, err := k.RateLimitUsage.Get(ctx, destinationDomain)
+	if errors.Is(err, collections.ErrNotFound) {
+		total = types.NewRateLimitUsage(0, math.ZeroInt())
+	} else if err != nil {
+		return fmt.Errorf("error getting the rate limit usage: %w", err)
+	}
+	if err := k.RateLimitUsage.Set(ctx, destinationDomain, total.Add(usage)); err != nil {
+		return fmt.Errorf("error setting the rate limit usage: %w", err)
+	}
+
+	return nil
+}
+
+// PruneRateLimitUsage removes the usage of the blocks out of the rolling window from the
+// rate limit usage of the destination domain, returning the usage in the window.
+func (k *Keeper) PruneRateLimitUsage(ctx context.Context, destinationDomain uint32, window uint64) (types.RateLimitUsage, error) {
+	usage, expired, err := k.getRateLimitUsage(ctx, destinationDomain, window)
+	if err != nil {
+		return usage, err
+	}
+	if len(expired) == 0 {
+		return usage, nil
+	}
+
+	for _, key := range expired {
+		if err := k.RateLimitBuckets.Remove(ctx, key); err != nil {
+			return usage, fmt.Errorf("error pruning the rate limit usage of block %d: %w", key.K2(), err)
+		}
+	}
+	if err := k.RateLimitUsage.Set(ctx, destinationDomain, usage); err != nil {
+		return usage, fmt.Errorf("error setting the rate limit usage: %w", err)
+	}
+
+	return usage, nil
+}
+
 func (k *Keeper) IncrementNumOfAccounts(ctx context.Context, destinationDomain uint32) error {
 	count, _ := k.NumOfAccounts.Get(ctx, destinationDomain)
 
@@ -42,6 +567,19 @@ func (k *Keeper) IncrementNumOfAccounts(ctx context.Context, destinationDomain u
 	return nil
 }
 
+func (k *Keeper) DecrementNumOfAccounts(ctx context.Context, destinationDomain uint32) error {
+	count, _ := k.NumOfAccounts.Get(ctx, destinationDomain)
+	if count == 0 {
+		return nil
+	}
+
+	if err := k.NumOfAccounts.Set(ctx, destinationDomain, count-1); err != nil {
+		return fmt.Errorf("error decrementing the number of accounts: %w", err)
+	}
+
+	return nil
+}
+
 func (k *Keeper) IncrementNumOfTransfers(ctx context.Context, destinationDomain uint32) error {
 	count, _ := k.NumOfTransfers.Get(ctx, destinationDomain)
 
@@ -53,28 +591,444 @@ func (k *Keeper) IncrementNumOfTransfers(ctx context.Context, destinationDomain
 }
 
 func (k *Keeper) IncrementTotalTransferred(ctx context.Context, destinationDomain uint32, amount math.Int) error {
-	if !amount.IsUint64() {
-		k.logger.Error("increment total transferred because invalid amount",
-			"destination_domain", destinationDomain,
-			"amount", amount.String(),
-		)
-		return errors.New("error incrementing total transferred: not uint64 amount")
+	tot, err := k.TotalTransferred.Get(ctx, destinationDomain)
+	if err != nil {
+		tot = math.ZeroInt()
 	}
-
-	tot, _ := k.TotalTransferred.Get(ctx, destinationDomain)
-	if err := k.TotalTransferred.Set(ctx, destinationDomain, tot+amount.Uint64()); err != nil {
+	if err := k.TotalTransferred.Set(ctx, destinationDomain, tot.Add(amount)); err != nil {
 		return fmt.Errorf("error incrementing total transferred: %w", err)
 	}
 
 	return nil
 }
 
+func (k *Keeper) IncrementTotalFees(ctx context.Context, destinationDomain uint32, amount math.Int) error {
+	tot, err := k.TotalFees.Get(ctx, destinationDomain)
+	if err != nil {
+		tot = math.ZeroInt()
+	}
+	if err := k.TotalFees.Set(ctx, destinationDomain, tot.Add(amount)); err != nil {
+		return fmt.Errorf("error incrementing total fees: %w", err)
+	}
+
+	return nil
+}
+
+// IncrementFailedTransfers counts a failed CCTP burn to the destination domain.
+func (k *Keeper) IncrementFailedTransfers(ctx context.Context, destinationDomain uint32) error {
+	return k.updateOutcomeStats(ctx, destinationDomain, func(stats *types.OutcomeStats) {
+		stats.FailedTransfers++
+	})
+}
+
+// IncrementFallbackClears counts an account of the destination domain cleared to the
+// fallback recipient, along with the amount of the minting denom cleared.
+func (k *Keeper) IncrementFallbackClears(ctx context.Context, destinationDomain uint32, amount math.Int) error {
+	return k.updateOutcomeStats(ctx, destinationDomain, func(stats *types.OutcomeStats) {
+		stats.FallbackClears++
+		stats.FallbackAmount = stats.FallbackAmount.Add(amount)
+	})
+}
+
+// IncrementManualRetries counts a CCTP transfer to the destination domain retried via
+// MsgClearAccount.
+func (k *Keeper) IncrementManualRetries(ctx context.Context, destinationDomain uint32) error {
+	return k.updateOutcomeStats(ctx, destinationDomain, func(stats *types.OutcomeStats) {
+		stats.ManualRetries++
+	})
+}
+
+// IncrementRegistrations counts an account registered for the destination domain, either
+// signerlessly or signed.
+func (k *Keeper) IncrementRegistrations(ctx context.Context, destinationDomain uint32, signerlessly bool) error {
+	return k.updateOutcomeStats(ctx, destinationDomain, func(stats *types.OutcomeStats) {
+		if signerlessly {
+			stats.SignerlessRegistrations++
+		} else {
+			stats.SignedRegistrations++
+		}
+	})
+}
+
+func (k *Keeper) updateOutcomeStats(ctx context.Context, destinationDomain uint32, update func(*types.OutcomeStats)) error {
+	stats := k.GetOutcomeStats(ctx, destinationDomain)
+	update(&stats)
+
+	if err := k.OutcomeStats.Set(ctx, destinationDomain, stats); err != nil {
+		return fmt.Errorf("error updating the outcome stats: %w", err)
+	}
+
+	return nil
+}
+
+// AddStatsHistory counts a transfer of the given amount to the destination domain in the
+// bucket of the statistics history containing the current block time. Nothing is recorded
+// if the bucket duration is zero.
+func (k *Keeper) AddStatsHistory(ctx context.Context, destinationDomain uint32, amount math.Int) error {
+	duration := k.GetParams(ctx).StatsBucketDuration
+	if duration <= 0 {
+		return nil
+	}
+
+	startTime := sdk.UnwrapSDKContext(ctx).BlockTime().Truncate(duration).UTC()
+	key := collections.Join(destinationDomain, startTime)
+	bucket, err := k.StatsHistory.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		bucket = types.StatsBucket{DestinationDomain: destinationDomain, StartTime: startTime, Amount: math.ZeroInt()}
+	case err != nil:
+		return fmt.Errorf("error getting the stats history: %w", err)
+	}
+	bucket.Transfers++
+	bucket.Amount = bucket.Amount.Add(amount)
+
+	if err := k.StatsHistory.Set(ctx, key, bucket); err != nil {
+		return fmt.Errorf("error updating the stats history: %w", err)
+	}
+
+	return nil
+}
+
 // Getters
 
+// GetParams returns the module parameters. If the parameters have never been stored,
+// the default ones are returned.
+func (k *Keeper) GetParams(ctx context.Context) types.Params {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.DefaultParams()
+	}
+
+	return params
+}
+
+// GetMinimumTransferAmount returns the minimum amount of the minting denom that can be
+// transferred to an AutoCCTP account.
+func (k *Keeper) GetMinimumTransferAmount(ctx context.Context) math.Int {
+	return k.GetParams(ctx).MinimumTransferAmount
+}
+
+// GetDomain returns the registry entry associated with the destination domain, or nil if
+// the domain is not registered.
+func (k *Keeper) GetDomain(ctx context.Context, destinationDomain uint32) *types.DomainConfig {
+	domain, err := k.Domains.Get(ctx, destinationDomain)
+	if err != nil {
+		return nil
+	}
+
+	return &domain
+}
+
+func (k *Keeper) GetDomains(ctx context.Context) ([]types.DomainConfig, error) {
+	domains := []types.DomainConfig{}
+
+	if err := k.Domains.Walk(ctx, nil, func(_ uint32, domain types.DomainConfig) (stop bool, err error) {
+		domains = append(domains, domain)
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return domains, nil
+}
+
+// IsPaused returns true if the automatic transfers are paused globally or for the
+// destination domain.
+func (k *Keeper) IsPaused(ctx context.Context, destinationDomain uint32) bool {
+	if paused, _ := k.Paused.Get(ctx); paused {
+		return true
+	}
+
+	paused, _ := k.PausedDomains.Has(ctx, destinationDomain)
+	return paused
+}
+
+// GetPausedDomains returns the destination domains for which the automatic transfers are paused.
+func (k *Keeper) GetPausedDomains(ctx context.Context) ([]uint32, error) {
+	iter, err := k.PausedDomains.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Keys()
+}
+
+// GetFailedTransfer returns the failed transfer associated with the account, or nil if the
+// account has no failed transfer.
+func (k *Keeper) GetFailedTransfer(ctx context.Context, address string) *types.FailedTransfer {
+	failedTransfer, err := k.FailedTransfers.Get(ctx, address)
+	if err != nil {
+		return nil
+	}
+
+	return &failedTransfer
+}
+
+func (k *Keeper) GetFailedTransfers(ctx context.Context) ([]types.FailedTransfer, error) {
+	failedTransfers := []types.FailedTransfer{}
+
+	if err := k.FailedTransfers.Walk(ctx, nil, func(_ string, failedTransfer types.FailedTransfer) (stop bool, err error) {
+		failedTransfers = append(failedTransfers, failedTransfer)
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return failedTransfers, nil
+}
+
+// GetFailedForward returns the failed forward of the AutoCCTP account, or nil if not found.
+func (k *Keeper) GetFailedForward(ctx context.Context, address string) *types.FailedForward {
+	failedForward, err := k.FailedForwards.Get(ctx, address)
+	if err != nil {
+		return nil
+	}
+
+	return &failedForward
+}
+
+// GetFailedForwards returns all the failed forwards.
+func (k *Keeper) GetFailedForwards(ctx context.Context) ([]types.FailedForward, error) {
+	failedForwards := []types.FailedForward{}
+	if err := k.FailedForwards.Walk(ctx, nil, func(_ string, failedForward types.FailedForward) (bool, error) {
+		failedForwards = append(failedForwards, failedForward)
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return failedForwards, nil
+}
+
+// GetOwedFees returns all the transfer fees owed by the AutoCCTP accounts.
+func (k *Keeper) GetOwedFees(ctx context.Context) ([]types.OwedFee, error) {
+	owedFees := []types.OwedFee{}
+	if err := k.OwedFees.Walk(ctx, nil, func(_ string, owedFee types.OwedFee) (bool, error) {
+		owedFees = append(owedFees, owedFee)
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return owedFees, nil
+}
+
+// GetRetryableForwards returns the accounts associated with failed forwards which are
+// scheduled to be retried at the current block height.
+func (k *Keeper) GetRetryableForwards(ctx context.Context) ([]types.Account, error) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	accounts := []types.Account{}
+
+	rng := collections.NewPrefixUntilPairRange[int64, string](height)
+	if err := k.FailedForwardsByRetryHeight.Walk(ctx, rng, func(key collections.Pair[int64, string], _ collections.NoValue) (bool, error) {
+		addressBz, err := k.accountKeeper.AddressCodec().StringToBytes(key.K2())
+		if err != nil {
+			return true, err
+		}
+		if account, ok := k.accountKeeper.GetAccount(ctx, addressBz).(*types.Account); ok {
+			accounts = append(accounts, *account)
+		}
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// GetAwaitingTransfers returns the addresses of the AutoCCTP accounts awaiting a transfer.
+func (k *Keeper) GetAwaitingTransfers(ctx context.Context) ([]string, error) {
+	iter, err := k.AwaitingTransfers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Keys()
+}
+
+// GetDirtyAccounts returns the addresses of the AutoCCTP accounts which received funds after
+// the end block of the module.
+func (k *Keeper) GetDirtyAccounts(ctx context.Context) ([]string, error) {
+	iter, err := k.DirtyAccounts.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Keys()
+}
+
+// GetRetryableTransfers returns the accounts associated with failed transfers which are
+// scheduled to be retried at the current block height. The failed transfers which exhausted
+// the attempts are not scheduled.
+func (k *Keeper) GetRetryableTransfers(ctx context.Context) ([]types.Account, error) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	accounts := []types.Account{}
+
+	rng := collections.NewPrefixUntilPairRange[int64, string](height)
+	if err := k.FailedTransfersByRetryHeight.Walk(ctx, rng, func(key collections.Pair[int64, string], _ collections.NoValue) (stop bool, err error) {
+		addressBz, err := k.accountKeeper.AddressCodec().StringToBytes(key.K2())
+		if err != nil {
+			return true, err
+		}
+		if account, ok := k.accountKeeper.GetAccount(ctx, addressBz).(*types.Account); ok {
+			accounts = append(accounts, *account)
+		}
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+func (k *Keeper) GetTransferHistory(ctx context.Context) ([]types.TransferRecord, error) {
+	records := []types.TransferRecord{}
+
+	if err := k.TransferHistory.Walk(ctx, nil, func(_ collections.Pair[string, uint64], record types.TransferRecord) (stop bool, err error) {
+		records = append(records, record)
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// getTransferHistoryKeys returns the keys of the transfer history of the account, from the
+// oldest to the most recent record.
+func (k *Keeper) getTransferHistoryKeys(ctx context.Context, address string) ([]collections.Pair[string, uint64], error) {
+	iter, err := k.TransferHistory.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](address))
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Keys()
+}
+
+// GetRateLimitCapacity returns the capacity left in the rolling window of the rate limit of
+// the destination domain, or nil if the domain is not rate limited.
+func (k *Keeper) GetRateLimitCapacity(ctx context.Context, destinationDomain uint32) (*types.RateLimitCapacity, error) {
+	domain := k.GetDomain(ctx, destinationDomain)
+	if domain == nil || domain.RateLimit == nil {
+		return nil, nil
+	}
+
+	usage, _, err := k.getRateLimitUsage(ctx, destinationDomain, domain.RateLimit.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := domain.RateLimit.Capacity(usage)
+	return &capacity, nil
+}
+
+// getRateLimitUsage returns the usage of the rate limit of the destination domain in the
+// rolling window ending at the current block, and the keys of the blocks out of the window.
+func (k *Keeper) getRateLimitUsage(ctx context.Context, destinationDomain uint32, window uint64) (types.RateLimitUsage, []collections.Pair[uint32, int64], error) {
+	usage, err := k.RateLimitUsage.Get(ctx, destinationDomain)
+	if errors.Is(err, collections.ErrNotFound) {
+		usage = types.NewRateLimitUsage(0, math.ZeroInt())
+	} else if err != nil {
+		return usage, nil, fmt.Errorf("error getting the rate limit usage: %w", err)
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	start := height + 1
+	if window < uint64(start) {
+		start -= int64(window)
+	} else {
+		start = 0
+	}
+
+	var expired []collections.Pair[uint32, int64]
+	rng := collections.NewPrefixedPairRange[uint32, int64](destinationDomain).EndExclusive(start)
+	if err := k.RateLimitBuckets.Walk(ctx, rng, func(key collections.Pair[uint32, int64], bucket types.RateLimitUsage) (bool, error) {
+		usage = usage.Sub(bucket)
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return usage, nil, fmt.Errorf("error walking the rate limit usage: %w", err)
+	}
+
+	return usage, expired, nil
+}
+
+// GetRateLimitUsagePerDestination returns the usage of the rate limit of every rate limited
+// destination domain.
+func (k *Keeper) GetRateLimitUsagePerDestination(ctx context.Context) (map[uint32]types.RateLimitUsage, error) {
+	usages := make(map[uint32]types.RateLimitUsage)
+
+	if err := k.RateLimitUsage.Walk(ctx, nil, func(key uint32, value types.RateLimitUsage) (stop bool, err error) {
+		usages[key] = value
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return usages, nil
+}
+
+// GetRateLimitBuckets returns the usage of the rate limits of all the destination domains
+// per block.
+func (k *Keeper) GetRateLimitBuckets(ctx context.Context) ([]types.RateLimitBucket, error) {
+	buckets := []types.RateLimitBucket{}
+	if err := k.RateLimitBuckets.Walk(ctx, nil, func(key collections.Pair[uint32, int64], usage types.RateLimitUsage) (bool, error) {
+		buckets = append(buckets, types.RateLimitBucket{
+			DestinationDomain: key.K1(),
+			Height:            key.K2(),
+			Usage:             usage,
+		})
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
+
+// GetRateLimitedAddresses returns the addresses of the AutoCCTP accounts whose transfer was
+// deferred because it exceeded the rate limit of the destination domain.
+func (k *Keeper) GetRateLimitedAddresses(ctx context.Context) ([]string, error) {
+	iter, err := k.RateLimitedTransfers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Keys()
+}
+
+// GetPendingTransfer returns the pending transfer of the AutoCCTP account.
+func (k *Keeper) GetPendingTransfer(ctx context.Context, address string) (types.Account, error) {
+	id, err := k.PendingTransfersByAddress.Get(ctx, address)
+	if err != nil {
+		return types.Account{}, err
+	}
+
+	return k.PendingTransfers.Get(ctx, id)
+}
+
+// HasPendingTransfer returns true if the AutoCCTP account has a pending transfer.
+func (k *Keeper) HasPendingTransfer(ctx context.Context, address string) (bool, error) {
+	return k.PendingTransfersByAddress.Has(ctx, address)
+}
+
+// GetPendingTransfers returns the AutoCCTP accounts with a pending transfer, in the order in
+// which the transfers were requested.
 func (k *Keeper) GetPendingTransfers(ctx context.Context) ([]types.Account, error) {
 	accounts := []types.Account{}
 
-	if err := k.PendingTransfers.Walk(ctx, nil, func(_ string, account types.Account) (stop bool, err error) {
+	if err := k.PendingTransfers.Walk(ctx, nil, func(_ uint64, account types.Account) (stop bool, err error) {
 		accounts = append(accounts, account)
 
 		return false, nil
@@ -85,6 +1039,96 @@ func (k *Keeper) GetPendingTransfers(ctx context.Context) ([]types.Account, erro
 	return accounts, nil
 }
 
+func (k *Keeper) GetPendingForwards(ctx context.Context) ([]types.Account, error) {
+	accounts := []types.Account{}
+
+	if err := k.PendingForwards.Walk(ctx, nil, func(_ string, account types.Account) (stop bool, err error) {
+		accounts = append(accounts, account)
+
+		return false, nil
+	}); err != nil {
+		return []types.Account{}, err
+	}
+
+	return accounts, nil
+}
+
+// GetExpiredAccounts returns the addresses of up to limit AutoCCTP accounts which reached
+// their expiration height or time in the current block. If limit is zero, all the expired
+// accounts are returned.
+func (k *Keeper) GetExpiredAccounts(ctx context.Context, limit uint64) ([]string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var addresses []string
+	seen := make(map[string]bool)
+	add := func(address string) bool {
+		if !seen[address] {
+			seen[address] = true
+			addresses = append(addresses, address)
+		}
+		return limit != 0 && uint64(len(addresses)) >= limit
+	}
+
+	heightRange := new(collections.Range[collections.Pair[uint64, string]]).
+		EndExclusive(collections.Join(uint64(sdkCtx.BlockHeight())+1, ""))
+	err := k.AccountsByExpirationHeight.Walk(ctx, heightRange, func(key collections.Pair[uint64, string], _ collections.NoValue) (bool, error) {
+		return add(key.K2()), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking the expiration height index: %w", err)
+	}
+	if limit != 0 && uint64(len(addresses)) >= limit {
+		return addresses, nil
+	}
+
+	timeRange := new(collections.Range[collections.Pair[time.Time, string]]).
+		EndExclusive(collections.Join(sdkCtx.BlockTime().Add(time.Nanosecond), ""))
+	err = k.AccountsByExpirationTime.Walk(ctx, timeRange, func(key collections.Pair[time.Time, string], _ collections.NoValue) (bool, error) {
+		return add(key.K2()), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking the expiration time index: %w", err)
+	}
+
+	return addresses, nil
+}
+
+// GetPruneRetries returns up to limit expired AutoCCTP accounts whose pruning is scheduled
+// to be retried at the current block height, keyed by address with the failed attempts. If
+// limit is zero, all the scheduled retries are returned.
+func (k *Keeper) GetPruneRetries(ctx context.Context, limit uint64) ([]collections.KeyValue[collections.Pair[int64, string], uint64], error) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	var retries []collections.KeyValue[collections.Pair[int64, string], uint64]
+	rng := collections.NewPrefixUntilPairRange[int64, string](height)
+	if err := k.PruneRetries.Walk(ctx, rng, func(key collections.Pair[int64, string], attempts uint64) (bool, error) {
+		retries = append(retries, collections.KeyValue[collections.Pair[int64, string], uint64]{Key: key, Value: attempts})
+		return limit != 0 && uint64(len(retries)) >= limit, nil
+	}); err != nil {
+		return nil, fmt.Errorf("error walking the prune retries: %w", err)
+	}
+
+	return retries, nil
+}
+
+// GetAllPruneRetries returns all the scheduled retries of the pruning of expired accounts.
+func (k *Keeper) GetAllPruneRetries(ctx context.Context) ([]types.PruneRetry, error) {
+	retries := []types.PruneRetry{}
+	if err := k.PruneRetries.Walk(ctx, nil, func(key collections.Pair[int64, string], attempts uint64) (bool, error) {
+		retries = append(retries, types.PruneRetry{
+			Address:  key.K2(),
+			Height:   key.K1(),
+			Attempts: attempts,
+		})
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return retries, nil
+}
+
 func (k *Keeper) GetNumOfAccountPerDestination(ctx context.Context) (map[uint32]uint64, error) {
 	numOfAccounts := make(map[uint32]uint64)
 
@@ -113,10 +1157,10 @@ func (k *Keeper) GetNumOfTransfersPerDestination(ctx context.Context) (map[uint3
 	return numOfTransfers, nil
 }
 
-func (k *Keeper) GetTotalTransferredPerDestination(ctx context.Context) (map[uint32]uint64, error) {
-	totTransferred := make(map[uint32]uint64)
+func (k *Keeper) GetTotalTransferredPerDestination(ctx context.Context) (map[uint32]math.Int, error) {
+	totTransferred := make(map[uint32]math.Int)
 
-	if err := k.TotalTransferred.Walk(ctx, nil, func(key uint32, value uint64) (stop bool, err error) {
+	if err := k.TotalTransferred.Walk(ctx, nil, func(key uint32, value math.Int) (stop bool, err error) {
 		totTransferred[key] = value
 
 		return false, nil
@@ -126,3 +1170,57 @@ func (k *Keeper) GetTotalTransferredPerDestination(ctx context.Context) (map[uin
 
 	return totTransferred, nil
 }
+
+func (k *Keeper) GetTotalFeesPerDestination(ctx context.Context) (map[uint32]math.Int, error) {
+	totFees := make(map[uint32]math.Int)
+
+	if err := k.TotalFees.Walk(ctx, nil, func(key uint32, value math.Int) (stop bool, err error) {
+		totFees[key] = value
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return totFees, nil
+}
+
+// GetOutcomeStats returns the outcome statistics of the destination domain, empty if none
+// have been recorded.
+func (k *Keeper) GetOutcomeStats(ctx context.Context, destinationDomain uint32) types.OutcomeStats {
+	stats, err := k.OutcomeStats.Get(ctx, destinationDomain)
+	if err != nil {
+		return types.NewOutcomeStats()
+	}
+
+	return stats
+}
+
+func (k *Keeper) GetOutcomeStatsPerDestination(ctx context.Context) (map[uint32]types.OutcomeStats, error) {
+	outcomeStats := make(map[uint32]types.OutcomeStats)
+
+	if err := k.OutcomeStats.Walk(ctx, nil, func(key uint32, value types.OutcomeStats) (stop bool, err error) {
+		outcomeStats[key] = value
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return outcomeStats, nil
+}
+
+// GetAllStatsHistory returns the buckets of the statistics history of all the destination
+// domains, ordered by destination domain and start time.
+func (k *Keeper) GetAllStatsHistory(ctx context.Context) ([]types.StatsBucket, error) {
+	buckets := []types.StatsBucket{}
+	if err := k.StatsHistory.Walk(ctx, nil, func(_ collections.Pair[uint32, time.Time], bucket types.StatsBucket) (bool, error) {
+		buckets = append(buckets, bucket)
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
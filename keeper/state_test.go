@@ -21,15 +21,20 @@
 package keeper_test
 
 import (
+	"errors"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"autocctp.dev/testutil"
 	"autocctp.dev/testutil/mocks"
+	"autocctp.dev/types"
 )
 
 func TestIncrementNumOfAccounts(t *testing.T) {
@@ -121,7 +126,7 @@ func TestIncrementTotalTransferred(t *testing.T) {
 	require.NoError(t, err)
 
 	// ASSERT
-	require.Equal(t, uint64(1_000), count0, "expected a different amount transferred for destination 0")
+	require.Equal(t, math.NewInt(1_000), count0, "expected a different amount transferred for destination 0")
 
 	// ACT
 	err = k.IncrementTotalTransferred(ctx, 1, math.NewInt(1_000))
@@ -139,14 +144,14 @@ func TestIncrementTotalTransferred(t *testing.T) {
 	require.NoError(t, err)
 
 	// ASSERT
-	require.Equal(t, uint64(3_000), count0, "expected a different amount transferred for destination 0")
-	require.Equal(t, uint64(2_000), count1, "expected a different amount transferred for destination 1")
+	require.Equal(t, math.NewInt(3_000), count0, "expected a different amount transferred for destination 0")
+	require.Equal(t, math.NewInt(2_000), count1, "expected a different amount transferred for destination 1")
 
 	amtPerDest, err := k.GetTotalTransferredPerDestination(ctx)
 	require.NoError(t, err)
 	require.Len(t, amtPerDest, 2)
-	require.Equal(t, uint64(3_000), amtPerDest[0], "expected a different amount transferred for destination 0")
-	require.Equal(t, uint64(2_000), amtPerDest[1], "expected a different amount transferred for destination 1")
+	require.Equal(t, math.NewInt(3_000), amtPerDest[0], "expected a different amount transferred for destination 0")
+	require.Equal(t, math.NewInt(2_000), amtPerDest[1], "expected a different amount transferred for destination 1")
 }
 
 func TestGetPendingTransfers(t *testing.T) {
@@ -171,3 +176,124 @@ func TestGetPendingTransfers(t *testing.T) {
 	// require
 	require.Equal(t, 2, len(acc), "expected 2 pending transfers")
 }
+
+func TestAddPendingTransfer(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	accounts := make([]types.Account, 3)
+	for i := range accounts {
+		accounts[i] = testutil.AutoCCTPAccount(false)
+	}
+	// Requests in reverse lexicographic order of the addresses.
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Address > accounts[j].Address })
+
+	// ACT
+	for _, account := range accounts {
+		require.NoError(t, k.AddPendingTransfer(ctx, account))
+	}
+	updated := accounts[0]
+	updated.ForwardOtherDenoms = true
+	require.NoError(t, k.AddPendingTransfer(ctx, updated))
+
+	// ASSERT: The transfers are returned in the order of the requests, without duplicates.
+	pending, err := k.GetPendingTransfers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.Account{updated, accounts[1], accounts[2]}, pending, "expected the transfers in the order of the requests")
+
+	// ACT
+	require.NoError(t, k.RemovePendingTransfer(ctx, accounts[1].Address))
+
+	// ASSERT
+	has, err := k.HasPendingTransfer(ctx, accounts[1].Address)
+	require.NoError(t, err)
+	require.False(t, has, "expected the pending transfer to be removed")
+	pending, err = k.GetPendingTransfers(ctx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2, "expected the other pending transfers to be kept")
+}
+
+func TestGetRetryableTransfers(t *testing.T) {
+	// ARRANGE
+	m, k, ctx := mocks.AutoCCTPKeeper(t)
+	accounts := make([]types.Account, 3)
+	for i := range accounts {
+		accounts[i] = testutil.AutoCCTPAccount(false)
+		m.AccountKeeper.Accounts[accounts[i].Address] = &accounts[i]
+	}
+	require.NoError(t, k.StoreFailedTransfer(ctx, types.NewFailedTransfer(accounts[0].Address, math.NewInt(1), errors.New("error"), 0, 1, 10)))
+	require.NoError(t, k.StoreFailedTransfer(ctx, types.NewFailedTransfer(accounts[1].Address, math.NewInt(1), errors.New("error"), 0, 1, 20)))
+	// The attempts of the third transfer are exhausted.
+	require.NoError(t, k.StoreFailedTransfer(ctx, types.NewFailedTransfer(accounts[2].Address, math.NewInt(1), errors.New("error"), 0, types.DefaultMaxTransferAttempts, 10)))
+
+	// ACT
+	retries, err := k.GetRetryableTransfers(ctx.WithBlockHeight(10))
+
+	// ASSERT: Only the due transfers are returned.
+	require.NoError(t, err)
+	require.Equal(t, []types.Account{accounts[0]}, retries, "expected only the due transfer")
+
+	// ACT: The rescheduled transfer is no longer due.
+	require.NoError(t, k.SetFailedTransfer(ctx.WithBlockHeight(10), accounts[0].Address, math.NewInt(1), errors.New("error")))
+	retries, err = k.GetRetryableTransfers(ctx.WithBlockHeight(10))
+
+	// ASSERT
+	require.NoError(t, err)
+	require.Empty(t, retries, "expected the transfer to be rescheduled")
+
+	// ACT
+	require.NoError(t, k.RemoveFailedTransfer(ctx, accounts[1].Address))
+	retries, err = k.GetRetryableTransfers(ctx.WithBlockHeight(20))
+
+	// ASSERT
+	require.NoError(t, err)
+	require.Empty(t, retries, "expected the removed transfer to be unscheduled")
+}
+
+func TestAddTransferRecord(t *testing.T) {
+	// ARRANGE
+	_, k, ctx := mocks.AutoCCTPKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+	address := testutil.NobleAddress()
+	otherAddress := testutil.NobleAddress()
+
+	params := k.GetParams(ctx)
+	params.MaxTransferHistory = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// ACT
+	err := k.AddTransferRecord(ctx, address, sdk.NewInt64Coin("uusdc", 1), 1, types.TransferOutcomeExecuted, nil)
+	require.NoError(t, err)
+	err = k.AddTransferRecord(ctx, otherAddress, sdk.NewInt64Coin("uusdc", 2), 0, types.TransferOutcomeFailed, errors.New("error"))
+	require.NoError(t, err)
+	err = k.AddTransferRecord(ctx, address, sdk.NewInt64Coin("uusdc", 3), 0, types.TransferOutcomeFailed, errors.New("error"))
+	require.NoError(t, err)
+	err = k.AddTransferRecord(ctx, address, sdk.NewInt64Coin("uusdc", 4), 0, types.TransferOutcomeFallback, nil)
+	require.NoError(t, err)
+
+	// ASSERT: The oldest record of the account is pruned.
+	records, err := k.GetTransferHistory(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 3, "expected the history to be pruned")
+	_, err = k.TransferHistory.Get(ctx, collections.Join(address, uint64(0)))
+	require.Error(t, err, "expected the oldest record to be pruned")
+	record, err := k.TransferHistory.Get(ctx, collections.Join(address, uint64(2)))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), record.Amount.Int64(), "expected a different amount")
+	require.Equal(t, "error", record.Error, "expected the error to be recorded")
+	require.Equal(t, int64(10), record.Height, "expected a different height")
+	_, err = k.TransferHistory.Get(ctx, collections.Join(otherAddress, uint64(1)))
+	require.NoError(t, err, "expected the record of a different account to be kept")
+
+	// ARRANGE
+	params.MaxTransferHistory = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// ACT
+	err = k.AddTransferRecord(ctx, address, sdk.NewInt64Coin("uusdc", 5), 2, types.TransferOutcomeExecuted, nil)
+	require.NoError(t, err)
+
+	// ASSERT
+	records, err = k.GetTransferHistory(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 3, "expected no record when the history is disabled")
+}
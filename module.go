@@ -39,7 +39,7 @@ import (
 )
 
 // ConsensusVersion defines the current AutoCCTP module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 5
 
 var (
 	_ module.AppModuleBasic      = AppModule{}
@@ -47,8 +47,9 @@ var (
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
 
-	_ appmodule.AppModule     = AppModule{}
-	_ appmodule.HasEndBlocker = AppModule{}
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasEndBlocker   = AppModule{}
 )
 
 type AppModuleBasic struct{}
@@ -118,10 +119,33 @@ func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawM
 func (m AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(m.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(m.keeper))
+
+	migrator := keeper.NewMigrator(m.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, migrator.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, migrator.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate %s from version 4 to 5: %v", types.ModuleName, err))
+	}
+}
+
+func (m AppModule) BeginBlock(ctx context.Context) error {
+	m.keeper.SweepAccounts(ctx)
+	return nil
 }
 
 func (m AppModule) EndBlock(ctx context.Context) error {
 	m.keeper.ExecuteTransfers(ctx)
+	m.keeper.SweepFailedTransfers(ctx)
+	m.keeper.ForwardOtherDenoms(ctx)
+	m.keeper.PruneExpiredAccounts(ctx)
+	m.keeper.PruneStatsHistory(ctx)
 	return nil
 }
 
@@ -12,6 +12,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	autocctp "autocctp.dev"
+	autocctpkeeper "autocctp.dev/keeper"
 	"autocctp.dev/types"
 )
 
@@ -23,8 +24,9 @@ type BankKeeper interface {
 type HandlerOptions struct {
 	cdc codec.Codec
 	ante.HandlerOptions
-	FTFKeeper  *ftfkeeper.Keeper
-	BankKeeper BankKeeper
+	FTFKeeper      *ftfkeeper.Keeper
+	BankKeeper     BankKeeper
+	AutoCCTPKeeper *autocctpkeeper.Keeper
 }
 
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
@@ -40,11 +42,16 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.Wrap(errorstypes.ErrLogic, "fiat tokenfactory keeper is required for ante builder")
 	}
 
+	if options.AutoCCTPKeeper == nil {
+		return nil, errors.Wrap(errorstypes.ErrLogic, "autocctp keeper is required for ante builder")
+	}
+
 	if options.SignModeHandler == nil {
 		return nil, errors.Wrap(errorstypes.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
 	sigVerificationDecorator := autocctp.NewSigVerificationDecorator(
+		options.AutoCCTPKeeper,
 		options.FTFKeeper,
 		options.BankKeeper,
 		options.AccountKeeper,
@@ -173,8 +173,9 @@ func NewSimApp(
 			SignModeHandler: app.txConfig.SignModeHandler(),
 			SigGasConsumer:  autocctp.SigVerificationGasConsumer,
 		},
-		FTFKeeper:  app.FTFKeeper,
-		BankKeeper: app.BankKeeper,
+		FTFKeeper:      app.FTFKeeper,
+		BankKeeper:     app.BankKeeper,
+		AutoCCTPKeeper: app.AutoCCTPKeeper,
 	})
 	if err != nil {
 		return nil, err
@@ -27,6 +27,7 @@ import (
 	"strconv"
 
 	"autocctp.dev/keeper"
+	"autocctp.dev/types"
 )
 
 func randomBytes(n int) []byte {
@@ -38,8 +39,10 @@ func randomBytes(n int) []byte {
 	return b
 }
 
+// randomDestinationDomain returns a random destination domain from the default registry.
 func randomDestinationDomain() uint32 {
-	return rand.Uint32() % 10
+	domains := types.DefaultDomains()
+	return domains[rand.Intn(len(domains))].DestinationDomain
 }
 
 // PendingTransfers generates a specified number of dummy pending transfers
@@ -60,7 +63,7 @@ func PendingTransfers(ctx context.Context, k *keeper.Keeper, num int, destinatio
 			}
 			acc.DestinationDomain = uint32(d)
 		}
-		if err := k.PendingTransfers.Set(ctx, acc.Address, acc); err != nil {
+		if err := k.AddPendingTransfer(ctx, acc); err != nil {
 			return []string{}, err
 		}
 		addresses = append(addresses, acc.Address)
@@ -22,6 +22,7 @@ package mocks
 
 import (
 	"context"
+	"slices"
 
 	"cosmossdk.io/core/address"
 	addresscdc "github.com/cosmos/cosmos-sdk/codec/address"
@@ -55,6 +56,22 @@ func (a AccountKeeper) HasAccount(ctx context.Context, addr sdk.AccAddress) bool
 	return false
 }
 
+// IterateAccounts implements types.AccountKeeper.
+func (a AccountKeeper) IterateAccounts(ctx context.Context, cb func(account sdk.AccountI) (stop bool)) {
+	// Sort the addresses to iterate deterministically, as the real keeper does.
+	addresses := make([]string, 0, len(a.Accounts))
+	for address := range a.Accounts {
+		addresses = append(addresses, address)
+	}
+	slices.Sort(addresses)
+
+	for _, address := range addresses {
+		if cb(a.Accounts[address]) {
+			return
+		}
+	}
+}
+
 // NewAccountWithAddress implements types.AccountKeeper.
 func (a AccountKeeper) NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
 	return authtypes.NewBaseAccountWithAddress(addr)
@@ -27,6 +27,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -38,6 +39,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"autocctp.dev/keeper"
 	"autocctp.dev/testutil"
@@ -48,11 +51,17 @@ func init() {
 	testutil.SetSDKConfig()
 }
 
+// Authority is the address used as the module authority in tests.
+var Authority, _ = sdk.Bech32ifyAddressBytes("noble", authtypes.NewModuleAddress(govtypes.ModuleName))
+
 type Mocks struct {
 	AccountKeeper *AccountKeeper
 	BankKeeper    *BankKeeper
 	FTFKeeper     *FTFKeeper
 	CCTPServer    *CCTPServer
+
+	// StoreService is the store service of the keeper, used to write legacy state in tests.
+	StoreService store.KVStoreService
 }
 
 // AutoCCTPKeeper returns the AutoCCTP keeper with all dependencies mocked and a context.
@@ -72,7 +81,7 @@ func AutoCCTPKeeper(t testing.TB) (*Mocks, *keeper.Keeper, sdk.Context) {
 	mocks := Mocks{
 		AccountKeeper: &ak,
 		BankKeeper:    &bk,
-		FTFKeeper:     &FTFKeeper{},
+		FTFKeeper:     &FTFKeeper{Blacklisted: make(map[string]bool)},
 		CCTPServer:    &cctps,
 	}
 
@@ -86,6 +95,7 @@ func autoCCTPKeeperWithMocks(t testing.TB, m *Mocks) (*keeper.Keeper, sdk.Contex
 	key := storetypes.NewKVStoreKey(types.ModuleName)
 	tkey := storetypes.NewTransientStoreKey(fmt.Sprintf("transient_%s", types.ModuleName))
 	wrapper := sdktestutil.DefaultContextWithDB(t, key, tkey)
+	m.StoreService = runtime.NewKVStoreService(key)
 
 	cfg := MakeTestEncodingConfig("noble")
 	types.RegisterInterfaces(cfg.InterfaceRegistry)
@@ -93,9 +103,10 @@ func autoCCTPKeeperWithMocks(t testing.TB, m *Mocks) (*keeper.Keeper, sdk.Contex
 	k := keeper.NewKeeper(
 		cfg.Codec,
 		log.NewNopLogger(),
-		runtime.NewKVStoreService(key),
+		m.StoreService,
 		runtime.NewTransientStoreService(tkey),
 		runtime.ProvideEventService(),
+		Authority,
 		m.AccountKeeper,
 		m.BankKeeper,
 		m.FTFKeeper,
@@ -114,7 +125,28 @@ func ResetTest(t *testing.T, ctx context.Context, k *keeper.Keeper, m *Mocks) {
 
 	m.AccountKeeper.Accounts = make(map[string]sdk.AccountI)
 
-	err := k.PendingTransfers.Clear(ctx, nil)
+	m.FTFKeeper.Blacklisted = make(map[string]bool)
+	m.FTFKeeper.Paused = false
+
+	err := k.ClearPendingTransfers(ctx)
+	assert.NoError(t, err)
+
+	err = k.PendingForwards.Clear(ctx, nil)
+	assert.NoError(t, err)
+
+	err = k.TransfersExecuted.Remove(ctx)
+	assert.NoError(t, err)
+
+	err = k.FallbackSweepCursor.Remove(ctx)
+	assert.NoError(t, err)
+
+	err = k.FailedForwards.Clear(ctx, nil)
+	assert.NoError(t, err)
+
+	err = k.FailedForwardsByRetryHeight.Clear(ctx, nil)
+	assert.NoError(t, err)
+
+	err = k.OwedFees.Clear(ctx, nil)
 	assert.NoError(t, err)
 
 	err = k.NumOfAccounts.Clear(ctx, nil)
@@ -125,6 +157,24 @@ func ResetTest(t *testing.T, ctx context.Context, k *keeper.Keeper, m *Mocks) {
 
 	err = k.TotalTransferred.Clear(ctx, nil)
 	assert.NoError(t, err)
+
+	err = k.TotalFees.Clear(ctx, nil)
+	assert.NoError(t, err)
+
+	err = k.FailedTransfers.Clear(ctx, nil)
+	assert.NoError(t, err)
+
+	err = k.FailedTransfersByRetryHeight.Clear(ctx, nil)
+	assert.NoError(t, err)
+
+	err = k.PruneRetries.Clear(ctx, nil)
+	assert.NoError(t, err)
+
+	err = k.RebuildAccountIndexes(ctx)
+	assert.NoError(t, err)
+
+	err = k.SetParams(ctx, types.DefaultParams())
+	assert.NoError(t, err)
 }
 
 // MakeTestEncodingConfig is a modified testutil.MakeTestEncodingConfig that
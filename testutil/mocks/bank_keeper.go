@@ -27,6 +27,7 @@ import (
 	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"autocctp.dev/types"
 )
@@ -46,6 +47,11 @@ func NoOpSendRestrictionFn(_ context.Context, _, toAddr sdk.AccAddress, _ sdk.Co
 	return toAddr, nil
 }
 
+// GetAllBalances implements types.BankKeeper.
+func (k BankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return k.Balances[addr.String()]
+}
+
 // GetBalance implements types.BankKeeper.
 func (k BankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	coins := k.Balances[addr.String()]
@@ -56,7 +62,7 @@ func (k BankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom s
 
 // SendCoinsFromAccountToModule implements types.BankKeeper.
 func (k BankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
-	return nil
+	return k.SendCoins(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
 }
 
 // SendCoins implements types.BankKeeper.
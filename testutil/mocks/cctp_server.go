@@ -27,6 +27,7 @@ import (
 	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"autocctp.dev/types"
 )
@@ -40,32 +41,46 @@ type MockCounter struct {
 	NumDepositForBurnWithCaller int
 }
 
+// nonce returns a mocked CCTP nonce derived from the number of calls.
+func (mc *MockCounter) nonce() uint64 {
+	return uint64(mc.NumDepositForBurn + mc.NumDepositForBurnWithCaller)
+}
+
 type CCTPServer struct {
 	// Failing defines if calls to the CCTPServer return an error response.
-	Failing           bool
+	Failing bool
+	// FailAfter, if positive, defines the number of successful calls to the deposit for burn
+	// endpoints after which the CCTPServer returns an error response.
+	FailAfter int
+	// Panicking defines if calls to the deposit for burn endpoints panic.
+	Panicking bool
+	// GasConsumed defines the gas consumed by every call to the deposit for burn endpoints.
+	GasConsumed       uint64
 	MaxTransferAmount int64
 	// MockCounter is used to check if the proper method has been called.
 	MockCounter *MockCounter
 }
 
-func (c CCTPServer) DepositForBurn(_ context.Context, msg *cctptypes.MsgDepositForBurn) (*cctptypes.MsgDepositForBurnResponse, error) {
-	if c.Failing {
+func (c CCTPServer) DepositForBurn(ctx context.Context, msg *cctptypes.MsgDepositForBurn) (*cctptypes.MsgDepositForBurnResponse, error) {
+	c.execute(ctx)
+	if c.Failing || c.limitReached() {
 		return nil, errors.New("error calling deposit for burn api")
 	}
 
 	c.MockCounter.NumDepositForBurn += 1
 
-	return nil, nil
+	return &cctptypes.MsgDepositForBurnResponse{Nonce: c.MockCounter.nonce()}, nil
 }
 
-func (c CCTPServer) DepositForBurnWithCaller(_ context.Context, msg *cctptypes.MsgDepositForBurnWithCaller) (*cctptypes.MsgDepositForBurnWithCallerResponse, error) {
-	if c.Failing {
+func (c CCTPServer) DepositForBurnWithCaller(ctx context.Context, msg *cctptypes.MsgDepositForBurnWithCaller) (*cctptypes.MsgDepositForBurnWithCallerResponse, error) {
+	c.execute(ctx)
+	if c.Failing || c.limitReached() {
 		return nil, errors.New("error calling deposit for burn with caller api")
 	}
 
 	c.MockCounter.NumDepositForBurnWithCaller += 1
 
-	return nil, nil
+	return &cctptypes.MsgDepositForBurnWithCallerResponse{Nonce: c.MockCounter.nonce()}, nil
 }
 
 func (c CCTPServer) PerMessageBurnLimit(context.Context, *cctptypes.QueryGetPerMessageBurnLimitRequest) (*cctptypes.QueryGetPerMessageBurnLimitResponse, error) {
@@ -76,3 +91,15 @@ func (c CCTPServer) PerMessageBurnLimit(context.Context, *cctptypes.QueryGetPerM
 		},
 	}, nil
 }
+
+func (c CCTPServer) limitReached() bool {
+	return c.FailAfter > 0 && c.MockCounter.NumDepositForBurn+c.MockCounter.NumDepositForBurnWithCaller >= c.FailAfter
+}
+
+// execute consumes the configured gas, and panics if configured to do so.
+func (c CCTPServer) execute(ctx context.Context) {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(c.GasConsumed, "deposit for burn")
+	if c.Panicking {
+		panic("deposit for burn panicked")
+	}
+}
@@ -25,14 +25,34 @@ import (
 
 	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"autocctp.dev/types"
 )
 
-var _ types.FiatTokenfactoryKeeper = FTFKeeper{}
+var _ types.FiatTokenfactoryKeeper = &FTFKeeper{}
+
+type FTFKeeper struct {
+	// Blacklisted contains the blacklisted addresses.
+	Blacklisted map[string]bool
+	// Paused defines if the minting token is paused.
+	Paused bool
+}
 
-type FTFKeeper struct{}
+// GetBlacklisted implements types.FiatTokenfactoryKeeper.
+func (f *FTFKeeper) GetBlacklisted(ctx context.Context, addressBz []byte) (val fiattokenfactorytypes.Blacklisted, found bool) {
+	if !f.Blacklisted[sdk.AccAddress(addressBz).String()] {
+		return fiattokenfactorytypes.Blacklisted{}, false
+	}
+	return fiattokenfactorytypes.Blacklisted{AddressBz: addressBz}, true
+}
 
 // GetMintingDenom implements types.FiatTokenfactoryKeeper.
-func (f FTFKeeper) GetMintingDenom(ctx context.Context) (val fiattokenfactorytypes.MintingDenom) {
+func (f *FTFKeeper) GetMintingDenom(ctx context.Context) (val fiattokenfactorytypes.MintingDenom) {
 	return fiattokenfactorytypes.MintingDenom{Denom: "uusdc"}
 }
+
+// GetPaused implements types.FiatTokenfactoryKeeper.
+func (f *FTFKeeper) GetPaused(ctx context.Context) (val fiattokenfactorytypes.Paused) {
+	return fiattokenfactorytypes.Paused{Paused: f.Paused}
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mocks
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"autocctp.dev/types"
+)
+
+var _ types.AutoCCTPHooks = &AutoCCTPHooks{}
+
+// AutoCCTPHooks records the calls to the hooks, keyed by hook name, and vetoes the transfers
+// when VetoErr is set.
+type AutoCCTPHooks struct {
+	Calls   map[string]int
+	VetoErr error
+}
+
+func NewAutoCCTPHooks() *AutoCCTPHooks {
+	return &AutoCCTPHooks{Calls: make(map[string]int)}
+}
+
+// AfterAccountRegistered implements types.AutoCCTPHooks.
+func (h *AutoCCTPHooks) AfterAccountRegistered(_ context.Context, _ types.Account) error {
+	h.Calls["AfterAccountRegistered"]++
+	return nil
+}
+
+// BeforeTransferExecuted implements types.AutoCCTPHooks.
+func (h *AutoCCTPHooks) BeforeTransferExecuted(_ context.Context, _ types.Account, _ sdk.Coin) error {
+	h.Calls["BeforeTransferExecuted"]++
+	return h.VetoErr
+}
+
+// AfterTransferExecuted implements types.AutoCCTPHooks.
+func (h *AutoCCTPHooks) AfterTransferExecuted(_ context.Context, _ types.Account, _ sdk.Coin, _ uint64) error {
+	h.Calls["AfterTransferExecuted"]++
+	return nil
+}
+
+// AfterTransferFailed implements types.AutoCCTPHooks.
+func (h *AutoCCTPHooks) AfterTransferFailed(_ context.Context, _ types.Account, _ sdk.Coin, _ error) error {
+	h.Calls["AfterTransferFailed"]++
+	return nil
+}
+
+// AfterAccountCleared implements types.AutoCCTPHooks.
+func (h *AutoCCTPHooks) AfterAccountCleared(_ context.Context, _ types.Account, _ sdk.Coins, _ types.ClearingReason) error {
+	h.Calls["AfterAccountCleared"]++
+	return nil
+}
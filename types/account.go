@@ -23,7 +23,9 @@ package types
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"time"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,9 +38,32 @@ var (
 	_ authtypes.GenesisAccount = &Account{}
 )
 
+const (
+	// AddressVersionV0 is the original derivation scheme, which concatenates the account
+	// properties without separators.
+	AddressVersionV0 uint32 = 0
+	// AddressVersionV1 is the derivation scheme which prefixes the account properties with
+	// the version and their length, and supports a salt.
+	AddressVersionV1 uint32 = 1
+	// LatestAddressVersion is the most recent address derivation scheme.
+	LatestAddressVersion = AddressVersionV1
+
+	// MaxSaltLength is the maximum length of the salt used to derive an address.
+	MaxSaltLength = 32
+)
+
 // GenerateAddress creates returns a new `sdk.AccAddress` derived from the inputs and
-// the module name.
+// the module name, according to the address version of the properties.
 func GenerateAddress(accountProperties AccountProperties) sdk.AccAddress {
+	if accountProperties.AddressVersion == AddressVersionV0 {
+		return generateAddressV0(accountProperties)
+	}
+	return generateAddressV1(accountProperties)
+}
+
+// generateAddressV0 derives the address concatenating the account properties. The salt and
+// the expiration are not supported.
+func generateAddressV0(accountProperties AccountProperties) sdk.AccAddress {
 	rawDestinationDomain := make([]byte, 4)
 	binary.BigEndian.PutUint32(rawDestinationDomain, accountProperties.DestinationDomain)
 
@@ -51,13 +76,43 @@ func GenerateAddress(accountProperties AccountProperties) sdk.AccAddress {
 	return address.Derive([]byte(ModuleName), bz)[12:]
 }
 
+// generateAddressV1 derives the address from the version followed by all the account
+// properties, including the salt, each one prefixed by its length so that different
+// properties can never produce the same input.
+func generateAddressV1(accountProperties AccountProperties) sdk.AccAddress {
+	bz := binary.BigEndian.AppendUint32(nil, accountProperties.AddressVersion)
+	bz = binary.BigEndian.AppendUint32(bz, accountProperties.DestinationDomain)
+	bz = appendLengthPrefixed(bz, accountProperties.MintRecipient)
+	bz = appendLengthPrefixed(bz, []byte(accountProperties.FallbackRecipient))
+	bz = appendLengthPrefixed(bz, accountProperties.DestinationCaller)
+	bz = binary.BigEndian.AppendUint64(bz, accountProperties.ExpirationHeight)
+	if accountProperties.ExpirationTime.IsZero() {
+		bz = appendLengthPrefixed(bz, nil)
+	} else {
+		bz = appendLengthPrefixed(bz, sdk.FormatTimeBytes(accountProperties.ExpirationTime))
+	}
+	bz = appendLengthPrefixed(bz, accountProperties.Salt)
+
+	return address.Derive([]byte(ModuleName), bz)[12:]
+}
+
+// appendLengthPrefixed appends the data to the bytes prefixed by its varint encoded length.
+func appendLengthPrefixed(bz []byte, data []byte) []byte {
+	bz = binary.AppendUvarint(bz, uint64(len(data)))
+	return append(bz, data...)
+}
+
 // AccountProperties specifies properties for configuring cross-chain token transfers
 // and fallback mechanisms for AutoCCTP accounts.
 type AccountProperties struct {
-	DestinationDomain uint32 // Target domain identifier for cross-chain transfers.
-	MintRecipient     []byte // Address where tokens will be minted.
-	FallbackRecipient string // Backup recipient address for recovering failed transfers.
-	DestinationCaller []byte // Optional address that can finalize transfers on the destination chain.
+	DestinationDomain uint32    // Target domain identifier for cross-chain transfers.
+	MintRecipient     []byte    // Address where tokens will be minted.
+	FallbackRecipient string    // Backup recipient address for recovering failed transfers.
+	DestinationCaller []byte    // Optional address that can finalize transfers on the destination chain.
+	ExpirationHeight  uint64    // Optional block height from which the account expires.
+	ExpirationTime    time.Time // Optional time from which the account expires.
+	AddressVersion    uint32    // Version of the scheme used to derive the address.
+	Salt              []byte    // Optional salt used to derive a different address.
 }
 
 func NewAccount(baseAccount *authtypes.BaseAccount, accountProperties AccountProperties) *Account {
@@ -67,7 +122,36 @@ func NewAccount(baseAccount *authtypes.BaseAccount, accountProperties AccountPro
 		MintRecipient:     accountProperties.MintRecipient,
 		FallbackRecipient: accountProperties.FallbackRecipient,
 		DestinationCaller: accountProperties.DestinationCaller,
+		ExpirationHeight:  accountProperties.ExpirationHeight,
+		ExpirationTime:    NewExpirationTime(accountProperties.ExpirationTime),
+		AddressVersion:    accountProperties.AddressVersion,
+		Salt:              accountProperties.Salt,
+	}
+}
+
+// NewExpirationTime returns the optional expiration time used in messages and accounts,
+// which is nil when the time is zero.
+func NewExpirationTime(expirationTime time.Time) *time.Time {
+	if expirationTime.IsZero() {
+		return nil
 	}
+	expirationTime = expirationTime.UTC()
+	return &expirationTime
+}
+
+// GetExpirationTimeOrZero returns the expiration time of the account, or the zero time if
+// the account does not expire by time.
+func (a *Account) GetExpirationTimeOrZero() time.Time {
+	return expirationTimeOrZero(a.ExpirationTime)
+}
+
+// IsExpired returns true if the account has reached its expiration given the current block
+// height and time.
+func (a *Account) IsExpired(height int64, blockTime time.Time) bool {
+	if a.ExpirationHeight != 0 && height >= int64(a.ExpirationHeight) {
+		return true
+	}
+	return a.ExpirationTime != nil && !blockTime.Before(*a.ExpirationTime)
 }
 
 func (a *Account) Validate() error {
@@ -85,11 +169,71 @@ func (a *Account) Validate() error {
 		return ErrInvalidDestinationCaller.Wrap(err.Error())
 	}
 
+	if a.FallbackPolicy != nil {
+		if err := a.FallbackPolicy.ValidateBasic(); err != nil {
+			return ErrInvalidFallbackPolicy.Wrap(err.Error())
+		}
+	}
+
+	if err := ValidateAddressDerivation(a.AddressVersion, a.Salt); err != nil {
+		return ErrInvalidAddressDerivation.Wrap(err.Error())
+	}
+
+	if err := ValidateExpirationSupport(a.AddressVersion, a.ExpirationHeight, a.GetExpirationTimeOrZero()); err != nil {
+		return ErrInvalidExpiration.Wrap(err.Error())
+	}
+
 	return a.BaseAccount.Validate()
 }
 
 //
 
+// Validate returns an error if the fallback policy does not define any condition, or if its
+// max attempts condition can never be met because the automatic retries stop after
+// maxTransferAttempts attempts.
+func (p *FallbackPolicy) Validate(maxTransferAttempts uint64) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if p.MaxAttempts > maxTransferAttempts {
+		return fmt.Errorf("max attempts cannot exceed the max transfer attempts %d", maxTransferAttempts)
+	}
+
+	return nil
+}
+
+// ValidateBasic returns an error if the fallback policy does not define any condition.
+func (p *FallbackPolicy) ValidateBasic() error {
+	if p.Timeout < 0 {
+		return errors.New("timeout cannot be negative")
+	}
+
+	if p.MaxAttempts == 0 && p.TimeoutBlocks == 0 && p.Timeout == 0 {
+		return errors.New("at least one condition must be defined")
+	}
+
+	return nil
+}
+
+// ClearingReason returns the reason why the account associated with the failed transfer
+// has to be cleared to the fallback recipient given the current block height and time.
+// If no condition of the policy is met, ClearingReasonUnspecified is returned.
+func (p *FallbackPolicy) ClearingReason(failedTransfer FailedTransfer, height int64, blockTime time.Time) ClearingReason {
+	switch {
+	case p.MaxAttempts != 0 && failedTransfer.Attempts >= p.MaxAttempts:
+		return ClearingReasonMaxAttempts
+	case p.TimeoutBlocks != 0 && height-failedTransfer.FirstFailureHeight >= int64(p.TimeoutBlocks):
+		return ClearingReasonTimeoutBlocks
+	case p.Timeout != 0 && blockTime.Sub(failedTransfer.FirstFailureTime) >= p.Timeout:
+		return ClearingReasonTimeout
+	default:
+		return ClearingReasonUnspecified
+	}
+}
+
+//
+
 var _ cryptotypes.PubKey = &PubKey{}
 
 func (pk *PubKey) String() string {
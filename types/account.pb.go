@@ -9,15 +9,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +37,25 @@ type Account struct {
 	MintRecipient      []byte `protobuf:"bytes,3,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
 	FallbackRecipient  string `protobuf:"bytes,4,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
 	DestinationCaller  []byte `protobuf:"bytes,5,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	// An optional policy to automatically clear the account to the fallback recipient when
+	// the CCTP transfers keep failing.
+	FallbackPolicy *FallbackPolicy `protobuf:"bytes,6,opt,name=fallback_policy,json=fallbackPolicy,proto3" json:"fallback_policy,omitempty"`
+	// If true, the account accepts denoms other than the minting denom, which are forwarded
+	// to the fallback recipient at the end of the block.
+	ForwardOtherDenoms bool `protobuf:"varint,7,opt,name=forward_other_denoms,json=forwardOtherDenoms,proto3" json:"forward_other_denoms,omitempty"`
+	// If true, the account has been deregistered by the fallback recipient, or pruned after
+	// its expiration. A deregistered account is kept as an inert record that cannot receive
+	// funds nor be registered again.
+	Deregistered bool `protobuf:"varint,8,opt,name=deregistered,proto3" json:"deregistered,omitempty"`
+	// An optional block height from which the account expires. Zero means no expiration.
+	ExpirationHeight uint64 `protobuf:"varint,9,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// An optional time from which the account expires.
+	ExpirationTime *time.Time `protobuf:"bytes,10,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+	// The version of the scheme used to derive the account address.
+	AddressVersion uint32 `protobuf:"varint,11,opt,name=address_version,json=addressVersion,proto3" json:"address_version,omitempty"`
+	// An optional salt used to derive a different address for the same properties. Only
+	// supported from the address version 1.
+	Salt []byte `protobuf:"bytes,12,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
 func (m *Account) Reset()         { *m = Account{} }
@@ -95,6 +119,120 @@ func (m *Account) GetDestinationCaller() []byte {
 	return nil
 }
 
+func (m *Account) GetFallbackPolicy() *FallbackPolicy {
+	if m != nil {
+		return m.FallbackPolicy
+	}
+	return nil
+}
+
+func (m *Account) GetForwardOtherDenoms() bool {
+	if m != nil {
+		return m.ForwardOtherDenoms
+	}
+	return false
+}
+
+func (m *Account) GetDeregistered() bool {
+	if m != nil {
+		return m.Deregistered
+	}
+	return false
+}
+
+func (m *Account) GetExpirationHeight() uint64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (m *Account) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
+func (m *Account) GetAddressVersion() uint32 {
+	if m != nil {
+		return m.AddressVersion
+	}
+	return 0
+}
+
+func (m *Account) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+// FallbackPolicy defines when the funds of an AutoCCTP account with a failed transfer are
+// automatically sent to the fallback recipient. A zero value disables the condition.
+type FallbackPolicy struct {
+	// The number of failed CCTP transfer attempts after which the account is cleared.
+	MaxAttempts uint64 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// The number of blocks since the first failed attempt after which the account is cleared.
+	TimeoutBlocks uint64 `protobuf:"varint,2,opt,name=timeout_blocks,json=timeoutBlocks,proto3" json:"timeout_blocks,omitempty"`
+	// The time elapsed since the first failed attempt after which the account is cleared.
+	Timeout time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout"`
+}
+
+func (m *FallbackPolicy) Reset()         { *m = FallbackPolicy{} }
+func (m *FallbackPolicy) String() string { return proto.CompactTextString(m) }
+func (*FallbackPolicy) ProtoMessage()    {}
+func (*FallbackPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a30e5e55bcab873, []int{1}
+}
+func (m *FallbackPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FallbackPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FallbackPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FallbackPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FallbackPolicy.Merge(m, src)
+}
+func (m *FallbackPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *FallbackPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FallbackPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FallbackPolicy proto.InternalMessageInfo
+
+func (m *FallbackPolicy) GetMaxAttempts() uint64 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *FallbackPolicy) GetTimeoutBlocks() uint64 {
+	if m != nil {
+		return m.TimeoutBlocks
+	}
+	return 0
+}
+
+func (m *FallbackPolicy) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
 // PubKey is the custom AutoCCTP public key type used for custom AutoCCTP accounts.
 type PubKey struct {
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -103,7 +241,7 @@ type PubKey struct {
 func (m *PubKey) Reset()      { *m = PubKey{} }
 func (*PubKey) ProtoMessage() {}
 func (*PubKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a30e5e55bcab873, []int{1}
+	return fileDescriptor_3a30e5e55bcab873, []int{2}
 }
 func (m *PubKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -141,36 +279,54 @@ func (m *PubKey) GetKey() []byte {
 
 func init() {
 	proto.RegisterType((*Account)(nil), "noble.autocctp.v1.Account")
+	proto.RegisterType((*FallbackPolicy)(nil), "noble.autocctp.v1.FallbackPolicy")
 	proto.RegisterType((*PubKey)(nil), "noble.autocctp.v1.PubKey")
 }
 
 func init() { proto.RegisterFile("noble/autocctp/v1/account.proto", fileDescriptor_3a30e5e55bcab873) }
 
 var fileDescriptor_3a30e5e55bcab873 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x3f, 0x4f, 0xe3, 0x30,
-	0x18, 0xc6, 0xe3, 0xb6, 0xd7, 0xd3, 0xb9, 0xed, 0xe9, 0x6a, 0xdd, 0x10, 0x2a, 0xe4, 0x5a, 0x95,
-	0x90, 0x32, 0xd0, 0x44, 0x81, 0xad, 0x1b, 0x85, 0xa5, 0x62, 0x41, 0x19, 0x59, 0x22, 0xdb, 0x35,
-	0x25, 0x6a, 0x6a, 0x47, 0x8d, 0x1b, 0xa9, 0xdf, 0x82, 0x91, 0x91, 0x99, 0x99, 0x0f, 0x81, 0x98,
-	0x3a, 0x32, 0x21, 0xd4, 0x7e, 0x11, 0x14, 0x27, 0x85, 0x20, 0xb1, 0xf9, 0x7d, 0x7e, 0xcf, 0xfb,
-	0xcf, 0x2f, 0xec, 0x4b, 0xc5, 0x62, 0xe1, 0xd1, 0x95, 0x56, 0x9c, 0xeb, 0xc4, 0xcb, 0x7c, 0x8f,
-	0x72, 0xae, 0x56, 0x52, 0xbb, 0xc9, 0x52, 0x69, 0x85, 0xba, 0xc6, 0xe0, 0xee, 0x0d, 0x6e, 0xe6,
-	0xf7, 0x30, 0x57, 0xe9, 0x42, 0xa5, 0x79, 0xd2, 0xad, 0x97, 0xf9, 0x4c, 0x68, 0xea, 0x9b, 0xa0,
-	0x48, 0xe9, 0x1d, 0x14, 0x3c, 0x34, 0x91, 0x57, 0x04, 0x25, 0xfa, 0x3f, 0x53, 0x33, 0x55, 0xe8,
-	0xf9, 0xab, 0x50, 0x07, 0x8f, 0x35, 0xf8, 0xfb, 0xac, 0xe8, 0x8a, 0x26, 0xb0, 0xcd, 0x68, 0x2a,
-	0xc2, 0x72, 0x0a, 0x1b, 0x10, 0xe0, 0xb4, 0x4e, 0x88, 0x5b, 0x96, 0x31, 0x6d, 0xca, 0x9e, 0xee,
-	0x98, 0xa6, 0xa2, 0xcc, 0x1b, 0x37, 0x36, 0x6f, 0x7d, 0x10, 0xb4, 0xd8, 0x97, 0x84, 0x86, 0x10,
-	0x4d, 0x45, 0xaa, 0x23, 0x49, 0x75, 0xa4, 0x64, 0x38, 0x55, 0x0b, 0x1a, 0x49, 0xbb, 0x46, 0x80,
-	0xd3, 0x09, 0xba, 0x15, 0x72, 0x61, 0x00, 0x3a, 0x82, 0x7f, 0x17, 0x91, 0xd4, 0xe1, 0x52, 0xf0,
-	0x28, 0x89, 0x84, 0xd4, 0x76, 0x9d, 0x00, 0xa7, 0x1d, 0x74, 0x72, 0x35, 0xd8, 0x8b, 0x79, 0xd5,
-	0x1b, 0x1a, 0xc7, 0x8c, 0xf2, 0x79, 0xc5, 0xda, 0x20, 0xc0, 0xf9, 0x13, 0x74, 0xf7, 0xe4, 0x9b,
-	0xbd, 0x3a, 0x04, 0xa7, 0x71, 0x2c, 0x96, 0xf6, 0x2f, 0x53, 0xb9, 0x3a, 0xc4, 0xb9, 0x01, 0x23,
-	0xf2, 0xf2, 0x34, 0x3c, 0xfc, 0x69, 0xd7, 0x72, 0xa9, 0xc9, 0x80, 0xc0, 0xe6, 0xd5, 0x8a, 0x5d,
-	0x8a, 0x35, 0xfa, 0x07, 0xeb, 0x73, 0xb1, 0x36, 0x3f, 0xd4, 0x0e, 0xf2, 0xe7, 0xa8, 0x71, 0xff,
-	0xd0, 0xb7, 0xc6, 0xc7, 0xcf, 0x5b, 0x0c, 0x36, 0x5b, 0x0c, 0xde, 0xb7, 0x18, 0xdc, 0xed, 0xb0,
-	0xb5, 0xd9, 0x61, 0xeb, 0x75, 0x87, 0xad, 0x6b, 0xf4, 0x79, 0xc6, 0xa9, 0xc8, 0x3c, 0xbd, 0x4e,
-	0x44, 0xca, 0x9a, 0xe6, 0x06, 0xa7, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x68, 0x62, 0x30,
-	0x0a, 0x02, 0x00, 0x00,
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xcd, 0x52, 0xf7, 0x6b, 0x93, 0xa6, 0xcd, 0xaa, 0x07, 0xb7, 0x42, 0x8e, 0x1b, 0x09, 0x11,
+	0x09, 0x6a, 0x93, 0x72, 0xab, 0xc4, 0xa1, 0xa1, 0x42, 0x14, 0x0e, 0x54, 0x16, 0xe2, 0xc0, 0xc5,
+	0x5a, 0xdb, 0x93, 0x64, 0x55, 0xdb, 0x6b, 0x79, 0x37, 0xa1, 0x39, 0xf2, 0x0f, 0x7a, 0x2c, 0x37,
+	0x7e, 0x04, 0x3f, 0xa2, 0xe2, 0xd4, 0x23, 0xa7, 0x82, 0xda, 0x3f, 0x82, 0xbc, 0x5e, 0x37, 0x09,
+	0xe5, 0xb6, 0xf3, 0xde, 0x9b, 0xf5, 0xdb, 0x99, 0x67, 0xdc, 0x4e, 0x79, 0x10, 0x83, 0x4b, 0xc7,
+	0x92, 0x87, 0xa1, 0xcc, 0xdc, 0x49, 0xcf, 0xa5, 0x61, 0xc8, 0xc7, 0xa9, 0x74, 0xb2, 0x9c, 0x4b,
+	0x4e, 0x5a, 0x4a, 0xe0, 0x54, 0x02, 0x67, 0xd2, 0xdb, 0xb5, 0x42, 0x2e, 0x12, 0x2e, 0x8a, 0xa6,
+	0x91, 0x3b, 0xe9, 0x05, 0x20, 0x69, 0x4f, 0x15, 0x65, 0xcb, 0xee, 0x4e, 0xc9, 0xfb, 0xaa, 0x72,
+	0xcb, 0x42, 0x53, 0xdb, 0x43, 0x3e, 0xe4, 0x25, 0x5e, 0x9c, 0x34, 0x6a, 0x0d, 0x39, 0x1f, 0xc6,
+	0xe0, 0xaa, 0x2a, 0x18, 0x0f, 0xdc, 0x68, 0x9c, 0x53, 0xc9, 0x78, 0xaa, 0xf9, 0xf6, 0xbf, 0xbc,
+	0x64, 0x09, 0x08, 0x49, 0x93, 0xac, 0x14, 0x74, 0xbe, 0x2e, 0xe3, 0xd5, 0xa3, 0xd2, 0x36, 0x39,
+	0xc1, 0x8d, 0x80, 0x0a, 0xf0, 0xf5, 0x33, 0x4c, 0x64, 0xa3, 0x6e, 0xfd, 0xc0, 0x76, 0xb4, 0x0f,
+	0xe5, 0x53, 0x9b, 0x76, 0xfa, 0x54, 0x80, 0xee, 0xeb, 0x1b, 0xd7, 0x37, 0x6d, 0xe4, 0xd5, 0x83,
+	0x19, 0x44, 0xf6, 0x31, 0x89, 0x40, 0x48, 0x96, 0x2a, 0x33, 0x7e, 0xc4, 0x13, 0xca, 0x52, 0xf3,
+	0x91, 0x8d, 0xba, 0x1b, 0x5e, 0x6b, 0x8e, 0x39, 0x56, 0x04, 0x79, 0x82, 0x9b, 0x09, 0x4b, 0xa5,
+	0x9f, 0x43, 0xc8, 0x32, 0x06, 0xa9, 0x34, 0x97, 0x6c, 0xd4, 0x6d, 0x78, 0x1b, 0x05, 0xea, 0x55,
+	0x60, 0x71, 0xeb, 0x80, 0xc6, 0x71, 0x40, 0xc3, 0xb3, 0x39, 0xa9, 0x61, 0xa3, 0xee, 0xba, 0xd7,
+	0xaa, 0x98, 0x05, 0xf9, 0xbc, 0x89, 0x90, 0xc6, 0x31, 0xe4, 0xe6, 0xb2, 0xba, 0x79, 0xde, 0xc4,
+	0x6b, 0x45, 0x90, 0x77, 0x78, 0xf3, 0xfe, 0xf6, 0x8c, 0xc7, 0x2c, 0x9c, 0x9a, 0x2b, 0x6a, 0x02,
+	0x7b, 0xce, 0x83, 0x4d, 0x3a, 0x6f, 0xb4, 0xf2, 0x54, 0x09, 0xbd, 0xe6, 0x60, 0xa1, 0x26, 0x2f,
+	0xf0, 0xf6, 0x80, 0xe7, 0x5f, 0x68, 0x1e, 0xf9, 0x5c, 0x8e, 0x20, 0xf7, 0x23, 0x48, 0x79, 0x22,
+	0xcc, 0x55, 0x1b, 0x75, 0xd7, 0x3c, 0xa2, 0xb9, 0x0f, 0x05, 0x75, 0xac, 0x18, 0xd2, 0xc1, 0x8d,
+	0x08, 0x72, 0x18, 0x32, 0x21, 0x21, 0x87, 0xc8, 0x5c, 0x53, 0xca, 0x05, 0x8c, 0x3c, 0xc3, 0x2d,
+	0x38, 0xcf, 0x58, 0xb9, 0x61, 0x7f, 0x04, 0x6c, 0x38, 0x92, 0xe6, 0xba, 0x8d, 0xba, 0x86, 0xb7,
+	0x35, 0x23, 0xde, 0x2a, 0x9c, 0x9c, 0xe0, 0xcd, 0x39, 0x71, 0xb1, 0x77, 0x13, 0xab, 0xe7, 0xec,
+	0x3a, 0x65, 0x28, 0x9c, 0x2a, 0x14, 0xce, 0xc7, 0x2a, 0x14, 0x7d, 0xe3, 0xe2, 0x77, 0x1b, 0x79,
+	0xcd, 0x59, 0x63, 0x41, 0x91, 0xa7, 0x78, 0x93, 0x46, 0x51, 0x0e, 0x42, 0xf8, 0x13, 0xc8, 0x05,
+	0xe3, 0xa9, 0x59, 0x57, 0xab, 0x6c, 0x6a, 0xf8, 0x53, 0x89, 0x12, 0x82, 0x0d, 0x41, 0x63, 0x69,
+	0x36, 0xd4, 0x8c, 0xd5, 0xf9, 0xd0, 0xfe, 0xf9, 0x63, 0xff, 0xf1, 0xff, 0x22, 0xa4, 0xb3, 0x72,
+	0xd2, 0xf9, 0x86, 0x70, 0x73, 0x71, 0x9e, 0x64, 0x0f, 0x37, 0x12, 0x7a, 0xee, 0x53, 0x29, 0x21,
+	0xc9, 0xa4, 0x50, 0x51, 0x34, 0xbc, 0x7a, 0x42, 0xcf, 0x8f, 0x34, 0x54, 0x64, 0xa6, 0x78, 0x14,
+	0x1f, 0x4b, 0x3f, 0x88, 0x79, 0x78, 0x26, 0x54, 0xbc, 0x0c, 0x6f, 0x43, 0xa3, 0x7d, 0x05, 0x92,
+	0x57, 0x78, 0x55, 0x03, 0x2a, 0x53, 0xf5, 0x83, 0x9d, 0x07, 0xcf, 0x3f, 0xd6, 0xff, 0x4c, 0x7f,
+	0xed, 0xea, 0xa6, 0x5d, 0xbb, 0x2c, 0x26, 0x50, 0xf5, 0x74, 0x6c, 0xbc, 0x72, 0x3a, 0x0e, 0xde,
+	0xc3, 0x94, 0x6c, 0xe1, 0xa5, 0x33, 0x98, 0x2a, 0x27, 0x0d, 0xaf, 0x38, 0x1e, 0x1a, 0x97, 0xdf,
+	0xdb, 0xb5, 0xfe, 0xf3, 0xab, 0x5b, 0x0b, 0x5d, 0xdf, 0x5a, 0xe8, 0xcf, 0xad, 0x85, 0x2e, 0xee,
+	0xac, 0xda, 0xf5, 0x9d, 0x55, 0xfb, 0x75, 0x67, 0xd5, 0x3e, 0x93, 0xfb, 0xc0, 0x44, 0x30, 0x71,
+	0xe5, 0x34, 0x03, 0x11, 0xac, 0xa8, 0xaf, 0xbe, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xe6, 0x5c,
+	0x80, 0xee, 0x3e, 0x04, 0x00, 0x00,
 }
 
 func (m *Account) Marshal() (dAtA []byte, err error) {
@@ -193,6 +349,65 @@ func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.AddressVersion != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAccount(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Deregistered {
+		i--
+		if m.Deregistered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ForwardOtherDenoms {
+		i--
+		if m.ForwardOtherDenoms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FallbackPolicy != nil {
+		{
+			size, err := m.FallbackPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.DestinationCaller) > 0 {
 		i -= len(m.DestinationCaller)
 		copy(dAtA[i:], m.DestinationCaller)
@@ -234,6 +449,47 @@ func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FallbackPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FallbackPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FallbackPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAccount(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.TimeoutBlocks != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.TimeoutBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PubKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -300,6 +556,47 @@ func (m *Account) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
+	if m.FallbackPolicy != nil {
+		l = m.FallbackPolicy.Size()
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.ForwardOtherDenoms {
+		n += 2
+	}
+	if m.Deregistered {
+		n += 2
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovAccount(uint64(m.ExpirationHeight))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovAccount(uint64(m.AddressVersion))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func (m *FallbackPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovAccount(uint64(m.MaxAttempts))
+	}
+	if m.TimeoutBlocks != 0 {
+		n += 1 + sovAccount(uint64(m.TimeoutBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovAccount(uint64(l))
 	return n
 }
 
@@ -506,6 +803,311 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				m.DestinationCaller = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FallbackPolicy == nil {
+				m.FallbackPolicy = &FallbackPolicy{}
+			}
+			if err := m.FallbackPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardOtherDenoms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForwardOtherDenoms = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deregistered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deregistered = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FallbackPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FallbackPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FallbackPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutBlocks", wireType)
+			}
+			m.TimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"autocctp.dev/testutil"
+	"autocctp.dev/types"
+)
+
+func TestGenerateAddress_Expiration(t *testing.T) {
+	// ARRANGE
+	properties := testutil.ValidProperties(false)
+	properties.AddressVersion = types.AddressVersionV1
+	address := types.GenerateAddress(properties)
+
+	withHeight := properties
+	withHeight.ExpirationHeight = 100
+	withTime := properties
+	withTime.ExpirationTime = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// ACT
+	heightAddress := types.GenerateAddress(withHeight)
+	timeAddress := types.GenerateAddress(withTime)
+
+	// ASSERT
+	require.NotEqual(t, address, heightAddress, "expected the expiration height to change the address")
+	require.NotEqual(t, address, timeAddress, "expected the expiration time to change the address")
+	require.NotEqual(t, heightAddress, timeAddress, "expected different addresses for different expirations")
+
+	withHeight.ExpirationHeight = 0
+	require.Equal(t, address, types.GenerateAddress(withHeight), "expected the address without expiration to be unchanged")
+
+	// ASSERT: The original derivation is left unchanged, it does not support the expiration.
+	v0 := withTime
+	v0.AddressVersion = types.AddressVersionV0
+	v0WithoutExpiration := v0
+	v0WithoutExpiration.ExpirationTime = time.Time{}
+	require.Equal(t, types.GenerateAddress(v0WithoutExpiration), types.GenerateAddress(v0), "expected the expiration to not be part of the original derivation")
+	require.Error(t, types.ValidateExpirationSupport(v0.AddressVersion, v0.ExpirationHeight, v0.ExpirationTime), "expected the expiration to not be supported by the original derivation")
+}
+
+func TestAccount_IsExpired(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		account    types.Account
+		expExpired bool
+	}{
+		{
+			name:       "not expired without expiration",
+			account:    types.Account{},
+			expExpired: false,
+		},
+		{
+			name:       "not expired before the expiration height",
+			account:    types.Account{ExpirationHeight: 11},
+			expExpired: false,
+		},
+		{
+			name:       "expired at the expiration height",
+			account:    types.Account{ExpirationHeight: 10},
+			expExpired: true,
+		},
+		{
+			name:       "not expired before the expiration time",
+			account:    types.Account{ExpirationTime: types.NewExpirationTime(now.Add(time.Second))},
+			expExpired: false,
+		},
+		{
+			name:       "expired at the expiration time",
+			account:    types.Account{ExpirationTime: types.NewExpirationTime(now)},
+			expExpired: true,
+		},
+		{
+			name:       "expired when the first of the expirations is reached",
+			account:    types.Account{ExpirationHeight: 100, ExpirationTime: types.NewExpirationTime(now)},
+			expExpired: true,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			require.Equal(t, tC.expExpired, tC.account.IsExpired(10, now), "expected a different expiration")
+		})
+	}
+}
+
+func TestGenerateAddress_Versions(t *testing.T) {
+	// ARRANGE
+	properties := testutil.ValidProperties(false)
+	v0Address := types.GenerateAddress(properties)
+
+	v1 := properties
+	v1.AddressVersion = types.AddressVersionV1
+	salted := v1
+	salted.Salt = []byte("salt")
+	otherSalted := v1
+	otherSalted.Salt = []byte("other salt")
+
+	// ACT
+	v1Address := types.GenerateAddress(v1)
+	saltedAddress := types.GenerateAddress(salted)
+	otherSaltedAddress := types.GenerateAddress(otherSalted)
+
+	// ASSERT
+	require.NotEqual(t, v0Address, v1Address, "expected the version to change the address")
+	require.NotEqual(t, v1Address, saltedAddress, "expected the salt to change the address")
+	require.NotEqual(t, saltedAddress, otherSaltedAddress, "expected different salts to derive different addresses")
+	require.Equal(t, saltedAddress, types.GenerateAddress(salted), "expected a deterministic derivation")
+
+	// ASSERT: Fields are length prefixed, moving bytes between fields changes the address.
+	shifted := v1
+	shifted.FallbackRecipient = properties.FallbackRecipient[:len(properties.FallbackRecipient)-1]
+	shifted.DestinationCaller = []byte(properties.FallbackRecipient[len(properties.FallbackRecipient)-1:])
+	require.NotEqual(t, v1Address, types.GenerateAddress(shifted), "expected no collision between fields")
+}
@@ -22,13 +22,8 @@ package types
 
 import (
 	"context"
-	"errors"
-	"fmt"
 
 	"github.com/circlefin/noble-cctp/x/cctp/types"
-	"github.com/ethereum/go-ethereum/common"
-
-	"github.com/cosmos/btcutil/base58"
 )
 
 // Domain represents a destination domain supported by CCTP.
@@ -50,44 +45,6 @@ const (
 	UNICHAIN
 )
 
-var supportedDomains = map[uint32]Domain{
-	0:  ETHEREUM,
-	1:  AVALANCHE,
-	2:  OPTIMISM,
-	3:  ARBITRUM,
-	4:  NOBLE,
-	5:  SOLANA,
-	6:  BASE,
-	7:  POLYGON,
-	8:  SUI,
-	9:  APTOS,
-	10: UNICHAIN,
-}
-
-// parseAddress parses an encoded address into a 32 length byte array. If the encoded bytes are
-// less than 32, the function left pads to obtain the length used in cross-chain transfers.
-func (d Domain) parseAddress(address string) ([]byte, error) {
-	var bz []byte
-	switch d {
-	case ETHEREUM, AVALANCHE, OPTIMISM, ARBITRUM, BASE, POLYGON, SUI, APTOS, UNICHAIN:
-		if !isHex(address) {
-			return nil, errors.New("address not in hex format")
-		}
-		bz = common.FromHex(address)
-	case SOLANA:
-		bz = base58.Decode(address)
-		if len(bz) == 0 {
-			return nil, errors.New("address not valid base58")
-		}
-	case NOBLE:
-		return nil, errors.New("destination domain cannot be source domain")
-	default:
-		return nil, fmt.Errorf("destination domain %d is not supported", uint32(d))
-	}
-
-	return LeftPadBytes(bz)
-}
-
 func NewCCTPServer(msgServer CCTPMsgServer, queryServer CCTPQueryServer) CCTPService {
 	if msgServer == nil {
 		panic("CCTP msg server cannot be nil")
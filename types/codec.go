@@ -40,6 +40,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterAccount{}, "noble/autocctp/RegisterAccount", nil)
 	cdc.RegisterConcrete(&MsgRegisterAccountSignerlessly{}, "noble/autocctp/RegisterAccountSignerlessly", nil)
 	cdc.RegisterConcrete(&MsgClearAccount{}, "noble/autocctp/ClearAccount", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "noble/autocctp/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgAddDomain{}, "noble/autocctp/AddDomain", nil)
+	cdc.RegisterConcrete(&MsgUpdateDomain{}, "noble/autocctp/UpdateDomain", nil)
+	cdc.RegisterConcrete(&MsgDisableDomain{}, "noble/autocctp/DisableDomain", nil)
+	cdc.RegisterConcrete(&MsgSetFallbackPolicy{}, "noble/autocctp/SetFallbackPolicy", nil)
+	cdc.RegisterConcrete(&MsgSetPaused{}, "noble/autocctp/SetPaused", nil)
+	cdc.RegisterConcrete(&MsgSetForwardOtherDenoms{}, "noble/autocctp/SetForwardOtherDenoms", nil)
+	cdc.RegisterConcrete(&MsgDeregisterAccount{}, "noble/autocctp/DeregisterAccount", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -51,6 +59,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterAccount{},
 		&MsgRegisterAccountSignerlessly{},
 		&MsgClearAccount{},
+		&MsgUpdateParams{},
+		&MsgAddDomain{},
+		&MsgUpdateDomain{},
+		&MsgDisableDomain{},
+		&MsgSetFallbackPolicy{},
+		&MsgSetPaused{},
+		&MsgSetForwardOtherDenoms{},
+		&MsgDeregisterAccount{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -20,15 +20,45 @@
 
 package types
 
-import "cosmossdk.io/math"
+import "time"
 
 const (
-	// minimumTransferAmount defines the minimum amount that can be transferred via AutoCCTP.
-	minimumTransferAmount = 10_000 // 1 cent
+	// DefaultMinimumTransferAmount defines the default minimum amount that can be transferred
+	// via AutoCCTP.
+	DefaultMinimumTransferAmount = 10_000 // 1 cent
+	// DefaultMaxTransferAttempts defines the default maximum number of attempts executed for
+	// an automatic transfer.
+	DefaultMaxTransferAttempts = 5
+	// DefaultRetryBaseDelay defines the default number of blocks to wait before retrying a
+	// failed transfer for the first time.
+	DefaultRetryBaseDelay = 10
+	// DefaultRetryMaxDelay defines the default maximum number of blocks to wait before
+	// retrying a failed transfer.
+	DefaultRetryMaxDelay = 14_400 // ~1 day with 6 seconds blocks
+	// DefaultMaxTransferHistory defines the default maximum number of transfer records kept
+	// for every AutoCCTP account.
+	DefaultMaxTransferHistory = 100
+	// DefaultMaxTransfersPerBlock defines the default maximum number of CCTP burns executed
+	// at the end of every block.
+	DefaultMaxTransfersPerBlock = 100
+	// DefaultMaxPrunedAccountsPerBlock defines the default maximum number of expired accounts
+	// pruned at the end of every block.
+	DefaultMaxPrunedAccountsPerBlock = 100
+	// DefaultFallbackSweepBatchSize defines the default maximum number of failed transfers
+	// checked at the end of every block against the fallback policy of their account.
+	DefaultFallbackSweepBatchSize = 100
+	// DefaultTransferGasLimit defines the default maximum amount of gas consumed by every
+	// automatic transfer.
+	DefaultTransferGasLimit = 1_000_000
+	// DefaultSweepBatchSize defines the default number of AutoCCTP accounts checked at the
+	// beginning of every block for balances that were not marked for clearing.
+	DefaultSweepBatchSize = 100
+	// DefaultStatsBucketDuration defines the default duration of the time buckets of the
+	// statistics history.
+	DefaultStatsBucketDuration = 24 * time.Hour
+	// DefaultStatsHistoryRetention defines the default time for which the buckets of the
+	// statistics history are kept.
+	DefaultStatsHistoryRetention = 90 * DefaultStatsBucketDuration
+	// MaxBasisPoints defines the basis points corresponding to the whole transferred amount.
+	MaxBasisPoints = 10_000
 )
-
-// GetMinimumTransferAmount returns the minimum amount of the minting denom that can be
-// transferred to an AutoCCTP account.
-func GetMinimumTransferAmount() math.Int {
-	return math.NewInt(minimumTransferAmount)
-}
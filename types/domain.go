@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/btcutil/base58"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// DefaultDomains returns the destination domains registry entries used to seed the
+// module genesis.
+func DefaultDomains() []DomainConfig {
+	return []DomainConfig{
+		NewDomainConfig(ETHEREUM, "Ethereum", AddressEncodingHex),
+		NewDomainConfig(AVALANCHE, "Avalanche", AddressEncodingHex),
+		NewDomainConfig(OPTIMISM, "Optimism", AddressEncodingHex),
+		NewDomainConfig(ARBITRUM, "Arbitrum", AddressEncodingHex),
+		NewDomainConfig(SOLANA, "Solana", AddressEncodingBase58),
+		NewDomainConfig(BASE, "Base", AddressEncodingHex),
+		NewDomainConfig(POLYGON, "Polygon", AddressEncodingHex),
+		NewDomainConfig(SUI, "Sui", AddressEncodingHex),
+		NewDomainConfig(APTOS, "Aptos", AddressEncodingHex),
+		NewDomainConfig(UNICHAIN, "Unichain", AddressEncodingHex),
+	}
+}
+
+// NewDomainConfig returns an enabled destination domain registry entry which allows the
+// usage of a destination caller.
+func NewDomainConfig(domain Domain, name string, encoding AddressEncoding) DomainConfig {
+	return DomainConfig{
+		DestinationDomain:        uint32(domain),
+		Name:                     name,
+		AddressEncoding:          encoding,
+		Enabled:                  true,
+		DestinationCallerAllowed: true,
+	}
+}
+
+// Validate returns an error if the destination domain registry entry is not valid.
+func (d DomainConfig) Validate() error {
+	if Domain(d.DestinationDomain) == NOBLE {
+		return errors.New("destination domain cannot be source domain")
+	}
+	if d.Name == "" {
+		return fmt.Errorf("name of destination domain %d cannot be empty", d.DestinationDomain)
+	}
+	if _, found := AddressEncoding_name[int32(d.AddressEncoding)]; !found || d.AddressEncoding == AddressEncodingUnspecified {
+		return fmt.Errorf("address encoding of destination domain %d is not valid", d.DestinationDomain)
+	}
+	if d.RateLimit != nil {
+		if err := d.RateLimit.Validate(); err != nil {
+			return fmt.Errorf("rate limit of destination domain %d is not valid: %w", d.DestinationDomain, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate returns an error if the rate limit is not valid.
+func (r RateLimit) Validate() error {
+	if r.Window == 0 {
+		return errors.New("window cannot be zero")
+	}
+	if r.MaxAmount.IsNil() || r.MaxAmount.IsNegative() {
+		return errors.New("max amount cannot be negative")
+	}
+	if r.MaxTransfers == 0 && r.MaxAmount.IsZero() {
+		return errors.New("at least one of max transfers and max amount must be set")
+	}
+
+	return nil
+}
+
+// Capacity returns the capacity left in the rolling window given the usage. The remaining
+// transfers, or amount, are zero when not capped.
+func (r RateLimit) Capacity(usage RateLimitUsage) RateLimitCapacity {
+	capacity := RateLimitCapacity{RemainingAmount: math.ZeroInt()}
+	if r.MaxTransfers > usage.Transfers {
+		capacity.RemainingTransfers = r.MaxTransfers - usage.Transfers
+	}
+	if r.MaxAmount.IsPositive() && r.MaxAmount.GT(usage.Amount) {
+		capacity.RemainingAmount = r.MaxAmount.Sub(usage.Amount)
+	}
+
+	return capacity
+}
+
+// Allowance returns the part of the amount, transferred in chunks of at most chunkSize,
+// fitting in the capacity left in the rolling window given the usage.
+func (r RateLimit) Allowance(usage RateLimitUsage, amount math.Int, chunkSize math.Int) math.Int {
+	capacity := r.Capacity(usage)
+	if r.MaxTransfers != 0 {
+		amount = math.MinInt(amount, chunkSize.Mul(math.NewIntFromUint64(capacity.RemainingTransfers)))
+	}
+	if r.MaxAmount.IsPositive() {
+		amount = math.MinInt(amount, capacity.RemainingAmount)
+	}
+
+	return amount
+}
+
+// NewRateLimitUsage returns the usage of the transfers of the amount.
+func NewRateLimitUsage(transfers uint64, amount math.Int) RateLimitUsage {
+	return RateLimitUsage{Transfers: transfers, Amount: amount}
+}
+
+// Validate returns an error if the amount of the usage is negative.
+func (u RateLimitUsage) Validate() error {
+	if u.Amount.IsNil() || u.Amount.IsNegative() {
+		return errors.New("amount cannot be negative")
+	}
+
+	return nil
+}
+
+// Add returns the sum of the usages.
+func (u RateLimitUsage) Add(other RateLimitUsage) RateLimitUsage {
+	return NewRateLimitUsage(u.Transfers+other.Transfers, u.Amount.Add(other.Amount))
+}
+
+// Sub returns the difference of the usages, floored at zero.
+func (u RateLimitUsage) Sub(other RateLimitUsage) RateLimitUsage {
+	usage := NewRateLimitUsage(0, math.ZeroInt())
+	if u.Transfers > other.Transfers {
+		usage.Transfers = u.Transfers - other.Transfers
+	}
+	if u.Amount.GT(other.Amount) {
+		usage.Amount = u.Amount.Sub(other.Amount)
+	}
+
+	return usage
+}
+
+// Validate returns an error if the bucket does not count any transfer or the amount is
+// negative.
+func (b StatsBucket) Validate() error {
+	if b.Transfers == 0 {
+		return errors.New("transfers must be positive")
+	}
+
+	if b.Amount.IsNil() || b.Amount.IsNegative() {
+		return errors.New("amount cannot be negative")
+	}
+
+	return nil
+}
+
+// NewOutcomeStats returns empty outcome statistics.
+func NewOutcomeStats() OutcomeStats {
+	return OutcomeStats{FallbackAmount: math.ZeroInt()}
+}
+
+// Validate returns an error if the statistics are empty, the fallback amount is negative, or
+// an amount is cleared to the fallback recipients without any fallback clear.
+func (s OutcomeStats) Validate() error {
+	if s.FallbackAmount.IsNil() || s.FallbackAmount.IsNegative() {
+		return errors.New("fallback amount cannot be negative")
+	}
+
+	if s.FallbackClears == 0 && s.FallbackAmount.IsPositive() {
+		return errors.New("fallback amount must be zero without fallback clears")
+	}
+
+	if s.FailedTransfers == 0 && s.FallbackClears == 0 && s.ManualRetries == 0 &&
+		s.SignedRegistrations == 0 && s.SignerlessRegistrations == 0 {
+		return errors.New("outcome stats cannot be empty")
+	}
+
+	return nil
+}
+
+// ParseAddress parses an encoded address into a 32 length byte array. If the encoded bytes are
+// less than 32, the function left pads to obtain the length used in cross-chain transfers.
+func (d DomainConfig) ParseAddress(address string) ([]byte, error) {
+	var bz []byte
+	switch d.AddressEncoding {
+	case AddressEncodingHex:
+		if !isHex(address) {
+			return nil, errors.New("address not in hex format")
+		}
+		bz = common.FromHex(address)
+	case AddressEncodingBase58:
+		bz = base58.Decode(address)
+		if len(bz) == 0 {
+			return nil, errors.New("address not valid base58")
+		}
+	case AddressEncodingBech32:
+		var err error
+		_, bz, err = bech32.DecodeAndConvert(address)
+		if err != nil {
+			return nil, fmt.Errorf("address not valid bech32: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("address encoding of destination domain %d is not supported", d.DestinationDomain)
+	}
+
+	return LeftPadBytes(bz)
+}
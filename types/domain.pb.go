@@ -0,0 +1,2409 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/autocctp/v1/domain.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddressEncoding defines how addresses of a destination domain are encoded.
+type AddressEncoding int32
+
+const (
+	AddressEncodingUnspecified AddressEncoding = 0
+	AddressEncodingHex         AddressEncoding = 1
+	AddressEncodingBase58      AddressEncoding = 2
+	AddressEncodingBech32      AddressEncoding = 3
+)
+
+var AddressEncoding_name = map[int32]string{
+	0: "ADDRESS_ENCODING_UNSPECIFIED",
+	1: "ADDRESS_ENCODING_HEX",
+	2: "ADDRESS_ENCODING_BASE58",
+	3: "ADDRESS_ENCODING_BECH32",
+}
+
+var AddressEncoding_value = map[string]int32{
+	"ADDRESS_ENCODING_UNSPECIFIED": 0,
+	"ADDRESS_ENCODING_HEX":         1,
+	"ADDRESS_ENCODING_BASE58":      2,
+	"ADDRESS_ENCODING_BECH32":      3,
+}
+
+func (x AddressEncoding) String() string {
+	return proto.EnumName(AddressEncoding_name, int32(x))
+}
+
+func (AddressEncoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_32366e9f4822b435, []int{0}
+}
+
+// DomainConfig is an entry of the registry of CCTP destination domains supported by
+// the AutoCCTP module.
+type DomainConfig struct {
+	// The destination domain identifier according to Circle's CCTP.
+	DestinationDomain uint32 `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// A human readable name of the destination domain.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The encoding used for mint recipient and destination caller addresses.
+	AddressEncoding AddressEncoding `protobuf:"varint,3,opt,name=address_encoding,json=addressEncoding,proto3,enum=noble.autocctp.v1.AddressEncoding" json:"address_encoding,omitempty"`
+	// A flag indicating whether or not the domain can be used by AutoCCTP accounts.
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// A flag indicating whether or not accounts can specify a destination caller.
+	DestinationCallerAllowed bool `protobuf:"varint,5,opt,name=destination_caller_allowed,json=destinationCallerAllowed,proto3" json:"destination_caller_allowed,omitempty"`
+	// The optional caps of the transfers to the domain.
+	RateLimit *RateLimit `protobuf:"bytes,6,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+}
+
+func (m *DomainConfig) Reset()         { *m = DomainConfig{} }
+func (m *DomainConfig) String() string { return proto.CompactTextString(m) }
+func (*DomainConfig) ProtoMessage()    {}
+func (*DomainConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32366e9f4822b435, []int{0}
+}
+func (m *DomainConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainConfig.Merge(m, src)
+}
+func (m *DomainConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainConfig proto.InternalMessageInfo
+
+func (m *DomainConfig) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *DomainConfig) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DomainConfig) GetAddressEncoding() AddressEncoding {
+	if m != nil {
+		return m.AddressEncoding
+	}
+	return AddressEncodingUnspecified
+}
+
+func (m *DomainConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *DomainConfig) GetDestinationCallerAllowed() bool {
+	if m != nil {
+		return m.DestinationCallerAllowed
+	}
+	return false
+}
+
+func (m *DomainConfig) GetRateLimit() *RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return nil
+}
+
+// RateLimit defines the caps of the automatic transfers to a destination domain over a
+// rolling window of blocks.
+type RateLimit struct {
+	// The number of blocks of the rolling window.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// The maximum number of transfers executed in the window. Zero means no cap.
+	MaxTransfers uint64 `protobuf:"varint,2,opt,name=max_transfers,json=maxTransfers,proto3" json:"max_transfers,omitempty"`
+	// The maximum amount transferred in the window. Zero means no cap.
+	MaxAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32366e9f4822b435, []int{1}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *RateLimit) GetMaxTransfers() uint64 {
+	if m != nil {
+		return m.MaxTransfers
+	}
+	return 0
+}
+
+// RateLimitUsage contains the transfers to a destination domain counted against the
+// rate limit.
+type RateLimitUsage struct {
+	// The number of transfers executed.
+	Transfers uint64 `protobuf:"varint,1,opt,name=transfers,proto3" json:"transfers,omitempty"`
+	// The amount transferred.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *RateLimitUsage) Reset()         { *m = RateLimitUsage{} }
+func (m *RateLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*RateLimitUsage) ProtoMessage()    {}
+func (*RateLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32366e9f4822b435, []int{2}
+}
+func (m *RateLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitUsage.Merge(m, src)
+}
+func (m *RateLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitUsage proto.InternalMessageInfo
+
+func (m *RateLimitUsage) GetTransfers() uint64 {
+	if m != nil {
+		return m.Transfers
+	}
+	return 0
+}
+
+// RateLimitBucket contains the transfers to a destination domain counted against the rate
+// limit in a block.
+type RateLimitBucket struct {
+	// The receiving chain identifier according to Circle's CCTP.
+	DestinationDomain uint32 `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// The height of the block.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The transfers executed in the block.
+	Usage RateLimitUsage `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *RateLimitBucket) Reset()         { *m = RateLimitBucket{} }
+func (m *RateLimitBucket) String() string { return proto.CompactTextString(m) }
+func (*RateLimitBucket) ProtoMessage()    {}
+func (*RateLimitBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32366e9f4822b435, []int{3}
+}
+func (m *RateLimitBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitBucket.Merge(m, src)
+}
+func (m *RateLimitBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitBucket proto.InternalMessageInfo
+
+func (m *RateLimitBucket) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *RateLimitBucket) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RateLimitBucket) GetUsage() RateLimitUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return RateLimitUsage{}
+}
+
+// PruneRetry contains a scheduled retry of the pruning of an expired AutoCCTP account.
+type PruneRetry struct {
+	// The address of the AutoCCTP account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The height of the block from which the pruning is retried.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The number of failed pruning attempts.
+	Attempts uint64 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (m *PruneRetry) Reset()         { *m = PruneRetry{} }
+func (m *PruneRetry) String() string { return proto.CompactTextString(m) }
+func (*PruneRetry) ProtoMessage()    {}
+func (*PruneRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32366e9f4822b435, []int{4}
+}
+func (m *PruneRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PruneRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruneRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PruneRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneRetry.Merge(m, src)
+}
+func (m *PruneRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruneRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruneRetry proto.InternalMessageInfo
+
+func (m *PruneRetry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PruneRetry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PruneRetry) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+// RateLimitCapacity contains the capacity left in the rolling window of the rate limit
+// of a destination domain.
+type RateLimitCapacity struct {
+	// The number of transfers that can still be executed, if capped.
+	RemainingTransfers uint64 `protobuf:"varint,1,opt,name=remaining_transfers,json=remainingTransfers,proto3" json:"remaining_transfers,omitempty"`
+	// The amount that can still be transferred, if capped.
+	RemainingAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=remaining_amount,json=remainingAmount,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_amount"`
+}
+
+func (m *RateLimitCapacity) Reset()         { *m = RateLimitCapacity{} }
+func (m *RateLimitCapacity) String() string { return proto.CompactTextString(m) }
+func (*RateLimitCapacity) ProtoMessage()    {}
+func (*RateLimitCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32366e9f4822b435, []int{5}
+}
+func (m *RateLimitCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitCapacity.Merge(m, src)
+}
+func (m *RateLimitCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitCapacity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitCapacity proto.InternalMessageInfo
+
+func (m *RateLimitCapacity) GetRemainingTransfers() uint64 {
+	if m != nil {
+		return m.RemainingTransfers
+	}
+	return 0
+}
+
+// StatsBucket aggregates the transfers executed to a destination domain in a time bucket.
+type StatsBucket struct {
+	// The receiving chain identifier according to Circle's CCTP.
+	DestinationDomain uint32 `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// The start time of the bucket.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// The number of transfers executed.
+	Transfers uint64 `protobuf:"varint,3,opt,name=transfers,proto3" json:"transfers,omitempty"`
+	// The amount transferred.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *StatsBucket) Reset()         { *m = StatsBucket{} }
+func (m *StatsBucket) String() string { return proto.CompactTextString(m) }
+func (*StatsBucket) ProtoMessage()    {}
+func (*StatsBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32366e9f4822b435, []int{6}
+}
+func (m *StatsBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatsBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatsBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatsBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsBucket.Merge(m, src)
+}
+func (m *StatsBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatsBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsBucket proto.InternalMessageInfo
+
+func (m *StatsBucket) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *StatsBucket) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *StatsBucket) GetTransfers() uint64 {
+	if m != nil {
+		return m.Transfers
+	}
+	return 0
+}
+
+// OutcomeStats counts the outcomes of the transfers and registrations of the AutoCCTP
+// accounts of a destination domain.
+type OutcomeStats struct {
+	// The number of failed CCTP transfer attempts.
+	FailedTransfers uint64 `protobuf:"varint,1,opt,name=failed_transfers,json=failedTransfers,proto3" json:"failed_transfers,omitempty"`
+	// The number of accounts cleared to the fallback recipient.
+	FallbackClears uint64 `protobuf:"varint,2,opt,name=fallback_clears,json=fallbackClears,proto3" json:"fallback_clears,omitempty"`
+	// The amount of the minting denom cleared to the fallback recipients.
+	FallbackAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=fallback_amount,json=fallbackAmount,proto3,customtype=cosmossdk.io/math.Int" json:"fallback_amount"`
+	// The number of CCTP transfers retried manually via MsgClearAccount.
+	ManualRetries uint64 `protobuf:"varint,4,opt,name=manual_retries,json=manualRetries,proto3" json:"manual_retries,omitempty"`
+	// The number of accounts registered via MsgRegisterAccount.
+	SignedRegistrations uint64 `protobuf:"varint,5,opt,name=signed_registrations,json=signedRegistrations,proto3" json:"signed_registrations,omitempty"`
+	// The number of accounts registered via MsgRegisterAccountSignerlessly.
+	SignerlessRegistrations uint64 `protobuf:"varint,6,opt,name=signerless_registrations,json=signerlessRegistrations,proto3" json:"signerless_registrations,omitempty"`
+}
+
+func (m *OutcomeStats) Reset()         { *m = OutcomeStats{} }
+func (m *OutcomeStats) String() string { return proto.CompactTextString(m) }
+func (*OutcomeStats) ProtoMessage()    {}
+func (*OutcomeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32366e9f4822b435, []int{7}
+}
+func (m *OutcomeStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutcomeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutcomeStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutcomeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutcomeStats.Merge(m, src)
+}
+func (m *OutcomeStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutcomeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutcomeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutcomeStats proto.InternalMessageInfo
+
+func (m *OutcomeStats) GetFailedTransfers() uint64 {
+	if m != nil {
+		return m.FailedTransfers
+	}
+	return 0
+}
+
+func (m *OutcomeStats) GetFallbackClears() uint64 {
+	if m != nil {
+		return m.FallbackClears
+	}
+	return 0
+}
+
+func (m *OutcomeStats) GetManualRetries() uint64 {
+	if m != nil {
+		return m.ManualRetries
+	}
+	return 0
+}
+
+func (m *OutcomeStats) GetSignedRegistrations() uint64 {
+	if m != nil {
+		return m.SignedRegistrations
+	}
+	return 0
+}
+
+func (m *OutcomeStats) GetSignerlessRegistrations() uint64 {
+	if m != nil {
+		return m.SignerlessRegistrations
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("noble.autocctp.v1.AddressEncoding", AddressEncoding_name, AddressEncoding_value)
+	proto.RegisterType((*DomainConfig)(nil), "noble.autocctp.v1.DomainConfig")
+	proto.RegisterType((*RateLimit)(nil), "noble.autocctp.v1.RateLimit")
+	proto.RegisterType((*RateLimitUsage)(nil), "noble.autocctp.v1.RateLimitUsage")
+	proto.RegisterType((*RateLimitBucket)(nil), "noble.autocctp.v1.RateLimitBucket")
+	proto.RegisterType((*PruneRetry)(nil), "noble.autocctp.v1.PruneRetry")
+	proto.RegisterType((*RateLimitCapacity)(nil), "noble.autocctp.v1.RateLimitCapacity")
+	proto.RegisterType((*StatsBucket)(nil), "noble.autocctp.v1.StatsBucket")
+	proto.RegisterType((*OutcomeStats)(nil), "noble.autocctp.v1.OutcomeStats")
+}
+
+func init() { proto.RegisterFile("noble/autocctp/v1/domain.proto", fileDescriptor_32366e9f4822b435) }
+
+var fileDescriptor_32366e9f4822b435 = []byte{
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x6d, 0x45, 0xb1, 0x9e, 0x7f, 0x48, 0xbe, 0x38, 0x0e, 0x43, 0x04, 0xb2, 0xaa, 0x2e,
+	0x86, 0x11, 0x53, 0x8e, 0x8c, 0x04, 0x06, 0xda, 0x02, 0xb1, 0x64, 0xb5, 0x36, 0xd0, 0xda, 0x01,
+	0x15, 0x03, 0xfd, 0x31, 0x10, 0x27, 0xf2, 0x44, 0x1f, 0x4c, 0xde, 0x09, 0xbc, 0x93, 0x7f, 0x4c,
+	0x5d, 0x0b, 0x4f, 0xd9, 0x3a, 0x19, 0x28, 0xd0, 0x25, 0x63, 0x87, 0xf4, 0x7f, 0xc8, 0x18, 0x64,
+	0x2a, 0x3a, 0xa4, 0x85, 0x3d, 0x74, 0xed, 0xd2, 0xa9, 0x4b, 0xc1, 0x23, 0x45, 0xc9, 0xb2, 0xdd,
+	0xa2, 0xf1, 0x22, 0xf0, 0xdd, 0xf7, 0x7d, 0x0f, 0xdf, 0xbb, 0xf7, 0xee, 0x09, 0x4a, 0x8c, 0xb7,
+	0x7d, 0x52, 0xc5, 0x3d, 0xc9, 0x1d, 0x47, 0x76, 0xab, 0x07, 0x8f, 0xaa, 0x2e, 0x0f, 0x30, 0x65,
+	0x66, 0x37, 0xe4, 0x92, 0xa3, 0x59, 0x85, 0x9b, 0x7d, 0xdc, 0x3c, 0x78, 0x64, 0xcc, 0xe2, 0x80,
+	0x32, 0x5e, 0x55, 0xbf, 0x31, 0xcb, 0xb8, 0xef, 0x70, 0x11, 0x70, 0x61, 0xab, 0xa8, 0x1a, 0x07,
+	0x09, 0x34, 0xe7, 0x71, 0x8f, 0xc7, 0xe7, 0xd1, 0x57, 0x72, 0xba, 0xe0, 0x71, 0xee, 0xf9, 0xa4,
+	0xaa, 0xa2, 0x76, 0xaf, 0x53, 0x95, 0x34, 0x20, 0x42, 0xe2, 0xa0, 0x1b, 0x13, 0x2a, 0x3f, 0x8f,
+	0xc1, 0xd4, 0x86, 0x32, 0xd2, 0xe0, 0xac, 0x43, 0x3d, 0xb4, 0x0c, 0xc8, 0x25, 0x42, 0x52, 0x86,
+	0x25, 0xe5, 0xcc, 0x8e, 0x4d, 0xea, 0x5a, 0x59, 0x5b, 0x9c, 0xb6, 0x66, 0x87, 0x90, 0x58, 0x84,
+	0x10, 0x64, 0x19, 0x0e, 0x88, 0x3e, 0x56, 0xd6, 0x16, 0xf3, 0x96, 0xfa, 0x46, 0x5f, 0x40, 0x11,
+	0xbb, 0x6e, 0x48, 0x84, 0xb0, 0x09, 0x73, 0xb8, 0x4b, 0x99, 0xa7, 0x8f, 0x97, 0xb5, 0xc5, 0x99,
+	0x5a, 0xc5, 0xbc, 0x54, 0xa6, 0xb9, 0x1e, 0x53, 0x9b, 0x09, 0xd3, 0x2a, 0xe0, 0x8b, 0x07, 0x48,
+	0x87, 0xdb, 0x84, 0xe1, 0xb6, 0x4f, 0x5c, 0x3d, 0x5b, 0xd6, 0x16, 0x27, 0xac, 0x7e, 0x88, 0x3e,
+	0x06, 0x63, 0xd8, 0xab, 0x83, 0x7d, 0x9f, 0x84, 0x36, 0xf6, 0x7d, 0x7e, 0x48, 0x5c, 0xfd, 0x96,
+	0x22, 0xeb, 0x43, 0x8c, 0x86, 0x22, 0xac, 0xc7, 0x38, 0xfa, 0x08, 0x20, 0xc4, 0x92, 0xd8, 0x3e,
+	0x0d, 0xa8, 0xd4, 0x73, 0x65, 0x6d, 0x71, 0xb2, 0xf6, 0xe0, 0x0a, 0x83, 0x16, 0x96, 0xe4, 0xf3,
+	0x88, 0x63, 0xe5, 0xc3, 0xfe, 0x67, 0xe5, 0x07, 0x0d, 0xf2, 0x29, 0x80, 0xe6, 0x21, 0x77, 0x48,
+	0x99, 0xcb, 0x0f, 0xd5, 0x45, 0x65, 0xad, 0x24, 0x42, 0x4b, 0x30, 0x1d, 0xe0, 0x23, 0x5b, 0x86,
+	0x98, 0x89, 0x0e, 0x09, 0x85, 0xba, 0xa6, 0x6c, 0xfd, 0xd6, 0xcb, 0x3f, 0x7e, 0x5a, 0xd2, 0xac,
+	0xa9, 0x00, 0x1f, 0x3d, 0xef, 0x43, 0x68, 0x07, 0x20, 0xe2, 0xe2, 0x80, 0xf7, 0x98, 0x54, 0xf7,
+	0x95, 0xaf, 0xaf, 0xbc, 0x7e, 0xb7, 0x90, 0xf9, 0xf5, 0xdd, 0xc2, 0xdd, 0xb8, 0xd5, 0xc2, 0xdd,
+	0x37, 0x29, 0xaf, 0x06, 0x58, 0xee, 0x99, 0x5b, 0x4c, 0xbe, 0x7d, 0xb5, 0x0c, 0xc9, 0x0c, 0x6c,
+	0x31, 0x19, 0xe7, 0xcc, 0x07, 0xf8, 0x68, 0x5d, 0xa5, 0xa8, 0x7c, 0x0b, 0x33, 0xa9, 0xc3, 0x5d,
+	0x81, 0x3d, 0x82, 0x3e, 0x84, 0xfc, 0xc0, 0x8a, 0x36, 0x6c, 0x65, 0x70, 0x8e, 0x36, 0x21, 0x97,
+	0x78, 0x18, 0x7b, 0x4f, 0x0f, 0x89, 0xbe, 0xf2, 0xbd, 0x06, 0x85, 0xd4, 0x41, 0xbd, 0xe7, 0xec,
+	0x13, 0xf9, 0x7f, 0xc7, 0x6b, 0x1e, 0x72, 0x7b, 0x84, 0x7a, 0x7b, 0xb1, 0x99, 0x71, 0x2b, 0x89,
+	0xd0, 0x27, 0x70, 0xab, 0x17, 0x95, 0xa4, 0xee, 0x69, 0xb2, 0xf6, 0xc1, 0xbf, 0xb5, 0x4d, 0xd5,
+	0x5e, 0xcf, 0x46, 0x65, 0x58, 0xb1, 0xaa, 0x22, 0x01, 0x9e, 0x85, 0x3d, 0x46, 0x2c, 0x22, 0xc3,
+	0x63, 0x54, 0x83, 0xdb, 0xc9, 0xcc, 0x29, 0x23, 0xf9, 0xba, 0xfe, 0xf6, 0xd5, 0xf2, 0x5c, 0x52,
+	0x55, 0x32, 0x9e, 0x2d, 0x19, 0x46, 0xc3, 0xd9, 0x27, 0x5e, 0x6b, 0xcc, 0x80, 0x09, 0x2c, 0x25,
+	0x09, 0xba, 0x52, 0x28, 0x6f, 0x59, 0x2b, 0x8d, 0x2b, 0x2f, 0x35, 0x98, 0x4d, 0x5d, 0x35, 0x70,
+	0x17, 0x3b, 0x54, 0x1e, 0xa3, 0x27, 0x70, 0x27, 0x24, 0x51, 0xb1, 0x94, 0x79, 0xf6, 0x35, 0xed,
+	0x41, 0x29, 0x63, 0x30, 0x2f, 0xdf, 0x40, 0x71, 0xa0, 0xbb, 0x61, 0xc7, 0x0a, 0x69, 0xa6, 0x64,
+	0x76, 0xfe, 0xd4, 0x60, 0xb2, 0x25, 0xb1, 0x14, 0xef, 0xd7, 0xb6, 0x06, 0x80, 0x90, 0x38, 0x94,
+	0x76, 0xb4, 0x6e, 0x94, 0xab, 0xc9, 0x9a, 0x61, 0xc6, 0xbb, 0xc8, 0xec, 0xef, 0x22, 0xf3, 0x79,
+	0x7f, 0x17, 0xd5, 0x27, 0x22, 0xc7, 0x2f, 0x7e, 0x5b, 0xd0, 0xac, 0xbc, 0xd2, 0x45, 0xc8, 0xc5,
+	0x69, 0x1d, 0xff, 0xcf, 0x69, 0xcd, 0xde, 0x70, 0x5a, 0xff, 0x1a, 0x83, 0xa9, 0x9d, 0x9e, 0x74,
+	0x78, 0x40, 0x54, 0xe5, 0x68, 0x05, 0x8a, 0x1d, 0x4c, 0x7d, 0xe2, 0x5e, 0xd7, 0x95, 0x42, 0x0c,
+	0x0f, 0x5a, 0x62, 0x42, 0xa1, 0x83, 0x7d, 0xbf, 0x8d, 0x9d, 0x7d, 0xdb, 0xf1, 0x09, 0x1e, 0x7d,
+	0xf0, 0x33, 0x7d, 0xb4, 0xa1, 0x40, 0xf4, 0xd5, 0x10, 0xff, 0x86, 0xef, 0x3e, 0x4d, 0x1d, 0x37,
+	0x10, 0x3d, 0x84, 0x99, 0x00, 0xb3, 0x1e, 0xf6, 0xed, 0x90, 0xc8, 0x90, 0x12, 0xa1, 0xee, 0x27,
+	0x75, 0x32, 0x1d, 0x83, 0x56, 0x8c, 0xa1, 0x35, 0x98, 0x13, 0xd4, 0x63, 0xc4, 0xb5, 0x43, 0xe2,
+	0x51, 0x21, 0x43, 0xd5, 0x4c, 0xa1, 0x56, 0x68, 0xaa, 0xb9, 0x13, 0x53, 0xac, 0x61, 0x06, 0x7a,
+	0x0a, 0xba, 0x3a, 0x0e, 0xfd, 0x68, 0xdd, 0x5f, 0x54, 0xe7, 0x86, 0xd5, 0xf7, 0x06, 0xb4, 0x0b,
+	0x19, 0x96, 0xfe, 0xd6, 0xa0, 0x30, 0xf2, 0x1f, 0x80, 0x9e, 0xc2, 0x83, 0xf5, 0x8d, 0x0d, 0xab,
+	0xd9, 0x6a, 0xd9, 0xcd, 0xed, 0xc6, 0xce, 0xc6, 0xd6, 0xf6, 0x67, 0xf6, 0xee, 0x76, 0xeb, 0x59,
+	0xb3, 0xb1, 0xf5, 0xe9, 0x56, 0x73, 0xa3, 0x98, 0x31, 0x4a, 0x27, 0xa7, 0x65, 0x63, 0x44, 0xb6,
+	0xcb, 0x44, 0x97, 0x38, 0xb4, 0x43, 0x89, 0x8b, 0x56, 0x60, 0xee, 0x52, 0x86, 0xcd, 0xe6, 0x97,
+	0x45, 0xcd, 0x98, 0x3f, 0x39, 0x2d, 0xa3, 0x11, 0xe5, 0x26, 0x39, 0x42, 0x4f, 0xe0, 0xde, 0x25,
+	0x45, 0x7d, 0xbd, 0xd5, 0x7c, 0xbc, 0x56, 0x1c, 0x33, 0xee, 0x9f, 0x9c, 0x96, 0xef, 0x8e, 0x88,
+	0xea, 0x58, 0x90, 0xc7, 0x6b, 0x57, 0xeb, 0x9a, 0x8d, 0xcd, 0xd5, 0x5a, 0x71, 0xfc, 0x6a, 0x1d,
+	0x71, 0xf6, 0x56, 0x6b, 0x46, 0xf6, 0xbb, 0x1f, 0x4b, 0x99, 0xfa, 0xc3, 0xd7, 0x67, 0x25, 0xed,
+	0xcd, 0x59, 0x49, 0xfb, 0xfd, 0xac, 0xa4, 0xbd, 0x38, 0x2f, 0x65, 0xde, 0x9c, 0x97, 0x32, 0xbf,
+	0x9c, 0x97, 0x32, 0x5f, 0xa3, 0x74, 0x99, 0xb9, 0xe4, 0xa0, 0x2a, 0x8f, 0xbb, 0x44, 0xb4, 0x73,
+	0xea, 0xed, 0xac, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0xb3, 0xcd, 0xdc, 0xd1, 0x4e, 0x08, 0x00,
+	0x00,
+}
+
+func (m *DomainConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDomain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DestinationCallerAllowed {
+		i--
+		if m.DestinationCallerAllowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AddressEncoding != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.AddressEncoding))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDomain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxTransfers != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.MaxTransfers))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDomain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Transfers != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Transfers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDomain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PruneRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruneRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruneRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDomain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RemainingTransfers != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.RemainingTransfers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatsBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatsBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatsBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDomain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Transfers != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Transfers))
+		i--
+		dAtA[i] = 0x18
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintDomain(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.DestinationDomain != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutcomeStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutcomeStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutcomeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignerlessRegistrations != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.SignerlessRegistrations))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SignedRegistrations != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.SignedRegistrations))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ManualRetries != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.ManualRetries))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.FallbackAmount.Size()
+		i -= size
+		if _, err := m.FallbackAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDomain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.FallbackClears != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.FallbackClears))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FailedTransfers != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.FailedTransfers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDomain(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDomain(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DomainConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		n += 1 + sovDomain(uint64(m.DestinationDomain))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	if m.AddressEncoding != 0 {
+		n += 1 + sovDomain(uint64(m.AddressEncoding))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.DestinationCallerAllowed {
+		n += 2
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovDomain(uint64(m.Window))
+	}
+	if m.MaxTransfers != 0 {
+		n += 1 + sovDomain(uint64(m.MaxTransfers))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovDomain(uint64(l))
+	return n
+}
+
+func (m *RateLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transfers != 0 {
+		n += 1 + sovDomain(uint64(m.Transfers))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDomain(uint64(l))
+	return n
+}
+
+func (m *RateLimitBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		n += 1 + sovDomain(uint64(m.DestinationDomain))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDomain(uint64(m.Height))
+	}
+	l = m.Usage.Size()
+	n += 1 + l + sovDomain(uint64(l))
+	return n
+}
+
+func (m *PruneRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDomain(uint64(m.Height))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovDomain(uint64(m.Attempts))
+	}
+	return n
+}
+
+func (m *RateLimitCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemainingTransfers != 0 {
+		n += 1 + sovDomain(uint64(m.RemainingTransfers))
+	}
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovDomain(uint64(l))
+	return n
+}
+
+func (m *StatsBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		n += 1 + sovDomain(uint64(m.DestinationDomain))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovDomain(uint64(l))
+	if m.Transfers != 0 {
+		n += 1 + sovDomain(uint64(m.Transfers))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDomain(uint64(l))
+	return n
+}
+
+func (m *OutcomeStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FailedTransfers != 0 {
+		n += 1 + sovDomain(uint64(m.FailedTransfers))
+	}
+	if m.FallbackClears != 0 {
+		n += 1 + sovDomain(uint64(m.FallbackClears))
+	}
+	l = m.FallbackAmount.Size()
+	n += 1 + l + sovDomain(uint64(l))
+	if m.ManualRetries != 0 {
+		n += 1 + sovDomain(uint64(m.ManualRetries))
+	}
+	if m.SignedRegistrations != 0 {
+		n += 1 + sovDomain(uint64(m.SignedRegistrations))
+	}
+	if m.SignerlessRegistrations != 0 {
+		n += 1 + sovDomain(uint64(m.SignerlessRegistrations))
+	}
+	return n
+}
+
+func sovDomain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDomain(x uint64) (n int) {
+	return sovDomain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DomainConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressEncoding", wireType)
+			}
+			m.AddressEncoding = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressEncoding |= AddressEncoding(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCallerAllowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DestinationCallerAllowed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransfers", wireType)
+			}
+			m.MaxTransfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTransfers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			m.Transfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transfers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PruneRetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruneRetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruneRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingTransfers", wireType)
+			}
+			m.RemainingTransfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingTransfers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatsBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatsBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			m.Transfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transfers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutcomeStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutcomeStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutcomeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTransfers", wireType)
+			}
+			m.FailedTransfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedTransfers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackClears", wireType)
+			}
+			m.FallbackClears = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FallbackClears |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FallbackAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualRetries", wireType)
+			}
+			m.ManualRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ManualRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedRegistrations", wireType)
+			}
+			m.SignedRegistrations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedRegistrations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerlessRegistrations", wireType)
+			}
+			m.SignerlessRegistrations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerlessRegistrations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDomain(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDomain
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDomain
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDomain
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDomain        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDomain          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDomain = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"autocctp.dev/types"
+)
+
+func TestRateLimit_Validate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		rateLimit   types.RateLimit
+		errContains string
+	}{
+		{
+			name:        "fail when the window is zero",
+			rateLimit:   types.RateLimit{MaxTransfers: 1, MaxAmount: math.ZeroInt()},
+			errContains: "window",
+		},
+		{
+			name:        "fail when the max amount is negative",
+			rateLimit:   types.RateLimit{Window: 10, MaxTransfers: 1, MaxAmount: math.NewInt(-1)},
+			errContains: "max amount",
+		},
+		{
+			name:        "fail when no cap is set",
+			rateLimit:   types.RateLimit{Window: 10, MaxAmount: math.ZeroInt()},
+			errContains: "at least one",
+		},
+		{
+			name:      "valid with only the max transfers",
+			rateLimit: types.RateLimit{Window: 10, MaxTransfers: 1, MaxAmount: math.ZeroInt()},
+		},
+		{
+			name:      "valid with only the max amount",
+			rateLimit: types.RateLimit{Window: 10, MaxAmount: math.NewInt(1_000)},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			err := tC.rateLimit.Validate()
+
+			if tC.errContains == "" {
+				require.NoError(t, err, "expected no error")
+			} else {
+				require.Error(t, err, "expected an error")
+				require.ErrorContains(t, err, tC.errContains, "expected a different error")
+			}
+		})
+	}
+}
+
+func TestRateLimit_Allowance(t *testing.T) {
+	rateLimit := types.RateLimit{Window: 10, MaxTransfers: 2, MaxAmount: math.NewInt(1_000)}
+
+	testCases := []struct {
+		name      string
+		usage     types.RateLimitUsage
+		amount    int64
+		chunkSize int64
+		expected  int64
+	}{
+		{
+			name:      "within the caps",
+			usage:     types.NewRateLimitUsage(1, math.NewInt(500)),
+			amount:    500,
+			chunkSize: 500,
+			expected:  500,
+		},
+		{
+			name:      "exceeds the max transfers",
+			usage:     types.NewRateLimitUsage(2, math.ZeroInt()),
+			amount:    1,
+			chunkSize: 1,
+			expected:  0,
+		},
+		{
+			name:      "exceeds the max transfers with chunks",
+			usage:     types.NewRateLimitUsage(1, math.ZeroInt()),
+			amount:    900,
+			chunkSize: 300,
+			expected:  300,
+		},
+		{
+			name:      "exceeds the max amount",
+			usage:     types.NewRateLimitUsage(0, math.NewInt(500)),
+			amount:    501,
+			chunkSize: 501,
+			expected:  500,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			allowed := rateLimit.Allowance(tC.usage, math.NewInt(tC.amount), math.NewInt(tC.chunkSize))
+
+			require.Equal(t, tC.expected, allowed.Int64(), "expected a different result")
+		})
+	}
+
+	// A zero cap is not enforced.
+	rateLimit.MaxAmount = math.ZeroInt()
+	require.Equal(t, int64(1), rateLimit.Allowance(types.NewRateLimitUsage(0, math.NewInt(1_000)), math.NewInt(1), math.NewInt(1)).Int64(), "expected the amount to not be capped")
+	capacity := rateLimit.Capacity(types.NewRateLimitUsage(1, math.NewInt(1_000)))
+	require.Equal(t, uint64(1), capacity.RemainingTransfers, "expected a different remaining transfers")
+	require.True(t, capacity.RemainingAmount.IsZero(), "expected no remaining amount when not capped")
+}
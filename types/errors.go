@@ -31,4 +31,13 @@ var (
 	ErrInvalidDestinationCaller = errors.Register(ModuleName, 5, "invalid destination caller")
 	ErrInvalidTransferAmount    = errors.Register(ModuleName, 6, "invalid transfer amount")
 	ErrInvalidAccountBalance    = errors.Register(ModuleName, 7, "invalid account balance")
+	ErrInvalidAuthority         = errors.Register(ModuleName, 8, "signer is not the module authority")
+	ErrInvalidParams            = errors.Register(ModuleName, 9, "invalid module parameters")
+	ErrInvalidDestinationDomain = errors.Register(ModuleName, 10, "invalid destination domain")
+	ErrInvalidFallbackPolicy    = errors.Register(ModuleName, 11, "invalid fallback policy")
+	ErrAccountDeregistered      = errors.Register(ModuleName, 12, "autocctp account has been deregistered")
+	ErrAccountExpired           = errors.Register(ModuleName, 13, "autocctp account has expired")
+	ErrInvalidExpiration        = errors.Register(ModuleName, 14, "invalid autocctp account expiration")
+	ErrInvalidAddressDerivation = errors.Register(ModuleName, 15, "invalid address derivation")
+	ErrBlacklisted              = errors.Register(ModuleName, 16, "address is blacklisted")
 )
@@ -4,17 +4,26 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,14 +31,101 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ClearingReason defines why an AutoCCTP account has been cleared to the fallback recipient.
+type ClearingReason int32
+
+const (
+	ClearingReasonUnspecified ClearingReason = 0
+	// The fallback recipient manually cleared the account.
+	ClearingReasonManual ClearingReason = 1
+	// The failed attempts reached the maximum of the account fallback policy.
+	ClearingReasonMaxAttempts ClearingReason = 2
+	// The blocks elapsed since the first failure reached the account fallback policy timeout.
+	ClearingReasonTimeoutBlocks ClearingReason = 3
+	// The time elapsed since the first failure reached the account fallback policy timeout.
+	ClearingReasonTimeout ClearingReason = 4
+	// The fallback recipient deregistered the account.
+	ClearingReasonDeregistration ClearingReason = 5
+	// The account reached its expiration.
+	ClearingReasonExpiration ClearingReason = 6
+)
+
+var ClearingReason_name = map[int32]string{
+	0: "CLEARING_REASON_UNSPECIFIED",
+	1: "CLEARING_REASON_MANUAL",
+	2: "CLEARING_REASON_MAX_ATTEMPTS",
+	3: "CLEARING_REASON_TIMEOUT_BLOCKS",
+	4: "CLEARING_REASON_TIMEOUT",
+	5: "CLEARING_REASON_DEREGISTRATION",
+	6: "CLEARING_REASON_EXPIRATION",
+}
+
+var ClearingReason_value = map[string]int32{
+	"CLEARING_REASON_UNSPECIFIED":    0,
+	"CLEARING_REASON_MANUAL":         1,
+	"CLEARING_REASON_MAX_ATTEMPTS":   2,
+	"CLEARING_REASON_TIMEOUT_BLOCKS": 3,
+	"CLEARING_REASON_TIMEOUT":        4,
+	"CLEARING_REASON_DEREGISTRATION": 5,
+	"CLEARING_REASON_EXPIRATION":     6,
+}
+
+func (x ClearingReason) String() string {
+	return proto.EnumName(ClearingReason_name, int32(x))
+}
+
+func (ClearingReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{0}
+}
+
+// ComplianceReason defines why an automatic CCTP transfer has been blocked by the
+// fiat-tokenfactory compliance checks.
+type ComplianceReason int32
+
+const (
+	ComplianceReasonUnspecified ComplianceReason = 0
+	// The minting token is paused, the transfer is deferred until the token is unpaused.
+	ComplianceReasonTokenPaused ComplianceReason = 1
+	// The AutoCCTP account is blacklisted, the transfer is refused.
+	ComplianceReasonAccountBlacklisted ComplianceReason = 2
+	// The fallback recipient is blacklisted, the transfer is refused.
+	ComplianceReasonFallbackBlacklisted ComplianceReason = 3
+)
+
+var ComplianceReason_name = map[int32]string{
+	0: "COMPLIANCE_REASON_UNSPECIFIED",
+	1: "COMPLIANCE_REASON_TOKEN_PAUSED",
+	2: "COMPLIANCE_REASON_ACCOUNT_BLACKLISTED",
+	3: "COMPLIANCE_REASON_FALLBACK_BLACKLISTED",
+}
+
+var ComplianceReason_value = map[string]int32{
+	"COMPLIANCE_REASON_UNSPECIFIED":          0,
+	"COMPLIANCE_REASON_TOKEN_PAUSED":         1,
+	"COMPLIANCE_REASON_ACCOUNT_BLACKLISTED":  2,
+	"COMPLIANCE_REASON_FALLBACK_BLACKLISTED": 3,
+}
+
+func (x ComplianceReason) String() string {
+	return proto.EnumName(ComplianceReason_name, int32(x))
+}
+
+func (ComplianceReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{1}
+}
+
 // AccountRegistered is emitted whenever a new AutoCCTP account is registered.
 type AccountRegistered struct {
-	Address           string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	DestinationDomain uint32 `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
-	MintRecipient     []byte `protobuf:"bytes,3,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
-	FallbackRecipient string `protobuf:"bytes,4,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
-	DestinationCaller []byte `protobuf:"bytes,5,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
-	Signerlessly      bool   `protobuf:"varint,6,opt,name=signerlessly,proto3" json:"signerlessly,omitempty"`
+	Address           string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32     `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	MintRecipient     []byte     `protobuf:"bytes,3,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+	FallbackRecipient string     `protobuf:"bytes,4,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
+	DestinationCaller []byte     `protobuf:"bytes,5,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	Signerlessly      bool       `protobuf:"varint,6,opt,name=signerlessly,proto3" json:"signerlessly,omitempty"`
+	ExpirationHeight  uint64     `protobuf:"varint,7,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	ExpirationTime    *time.Time `protobuf:"bytes,8,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+	AddressVersion    uint32     `protobuf:"varint,9,opt,name=address_version,json=addressVersion,proto3" json:"address_version,omitempty"`
+	Salt              []byte     `protobuf:"bytes,10,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
 func (m *AccountRegistered) Reset()         { *m = AccountRegistered{} }
@@ -107,11 +203,40 @@ func (m *AccountRegistered) GetSignerlessly() bool {
 	return false
 }
 
+func (m *AccountRegistered) GetExpirationHeight() uint64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (m *AccountRegistered) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
+func (m *AccountRegistered) GetAddressVersion() uint32 {
+	if m != nil {
+		return m.AddressVersion
+	}
+	return 0
+}
+
+func (m *AccountRegistered) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
 // AccountCleared is an event emitted when the AutoCCTP account associated with the
 // address is cleared.
 type AccountCleared struct {
-	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Address  string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Receiver string         `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Reason   ClearingReason `protobuf:"varint,3,opt,name=reason,proto3,enum=noble.autocctp.v1.ClearingReason" json:"reason,omitempty"`
 }
 
 func (m *AccountCleared) Reset()         { *m = AccountCleared{} }
@@ -161,205 +286,4299 @@ func (m *AccountCleared) GetReceiver() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*AccountRegistered)(nil), "noble.autocctp.v1.AccountRegistered")
-	proto.RegisterType((*AccountCleared)(nil), "noble.autocctp.v1.AccountCleared")
+func (m *AccountCleared) GetReason() ClearingReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ClearingReasonUnspecified
 }
 
-func init() { proto.RegisterFile("noble/autocctp/v1/event.proto", fileDescriptor_c4b6599cb121ef2c) }
+// TransferRetryRequested is an event emitted when the CCTP transfer of an AutoCCTP account is
+// retried manually, and is executed at the end of the block. The outcome is reported by the
+// TransferExecuted or TransferFailed events of the same address.
+type TransferRetryRequested struct {
+	Address           string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32                `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	Amount            cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom             string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+}
 
-var fileDescriptor_c4b6599cb121ef2c = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x6a, 0x32, 0x31,
-	0x14, 0x85, 0x8d, 0xff, 0x5f, 0xab, 0x41, 0x85, 0xc9, 0x2a, 0x14, 0x1a, 0x06, 0xa1, 0x30, 0x8b,
-	0xd6, 0x41, 0xfa, 0x04, 0xad, 0xa5, 0x0f, 0x90, 0x65, 0x37, 0x12, 0x93, 0x5b, 0x09, 0x8d, 0xc9,
-	0x90, 0xc4, 0x01, 0x1f, 0xa0, 0xfb, 0x3e, 0x56, 0x97, 0x2e, 0xbb, 0x2c, 0xfa, 0x22, 0xc5, 0xd0,
-	0x91, 0x71, 0xd3, 0xe5, 0x3d, 0xdf, 0xe5, 0xe4, 0xe4, 0x5c, 0x7c, 0x6d, 0xdd, 0xd2, 0x40, 0x29,
-	0x36, 0xd1, 0x49, 0x19, 0xab, 0xb2, 0x9e, 0x95, 0x50, 0x83, 0x8d, 0xd3, 0xca, 0xbb, 0xe8, 0x48,
-	0x96, 0xf0, 0xb4, 0xc1, 0xd3, 0x7a, 0x36, 0x79, 0xef, 0xe2, 0xec, 0x41, 0x4a, 0xb7, 0xb1, 0x91,
-	0xc3, 0x4a, 0x87, 0x08, 0x1e, 0x14, 0xa1, 0xf8, 0x52, 0x28, 0xe5, 0x21, 0x04, 0x8a, 0x72, 0x54,
-	0x0c, 0x78, 0x33, 0x92, 0x3b, 0x4c, 0x14, 0x84, 0xa8, 0xad, 0x88, 0xda, 0xd9, 0x85, 0x72, 0x6b,
-	0xa1, 0x2d, 0xed, 0xe6, 0xa8, 0x18, 0xf1, 0xac, 0x45, 0x9e, 0x12, 0x20, 0x37, 0x78, 0xbc, 0xd6,
-	0x36, 0x2e, 0x3c, 0x48, 0x5d, 0x69, 0xb0, 0x91, 0xfe, 0xcb, 0x51, 0x31, 0xe4, 0xa3, 0xa3, 0xca,
-	0x1b, 0xf1, 0xe8, 0xfa, 0x2a, 0x8c, 0x59, 0x0a, 0xf9, 0xd6, 0x5a, 0xfd, 0x9f, 0x9e, 0xce, 0x1a,
-	0x72, 0xb6, 0xde, 0x0e, 0x21, 0x85, 0x31, 0xe0, 0xe9, 0x45, 0x72, 0x6e, 0x87, 0x98, 0x27, 0x40,
-	0x26, 0x78, 0x18, 0xf4, 0xca, 0x82, 0x37, 0x10, 0x82, 0xd9, 0xd2, 0x5e, 0x8e, 0x8a, 0x3e, 0x3f,
-	0xd3, 0x26, 0xcf, 0x78, 0xfc, 0x5b, 0xc3, 0xdc, 0x80, 0xf8, 0xbb, 0x83, 0x2b, 0xdc, 0xf7, 0x20,
-	0x41, 0xd7, 0xe0, 0xd3, 0xcf, 0x07, 0xfc, 0x34, 0x3f, 0xde, 0x7e, 0xee, 0x19, 0xda, 0xed, 0x19,
-	0xfa, 0xde, 0x33, 0xf4, 0x71, 0x60, 0x9d, 0xdd, 0x81, 0x75, 0xbe, 0x0e, 0xac, 0xf3, 0x42, 0x4e,
-	0xb5, 0x2b, 0xa8, 0xcb, 0xb8, 0xad, 0x20, 0x2c, 0x7b, 0xe9, 0x2e, 0xf7, 0x3f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xc2, 0xff, 0x2c, 0x27, 0xb8, 0x01, 0x00, 0x00,
+func (m *TransferRetryRequested) Reset()         { *m = TransferRetryRequested{} }
+func (m *TransferRetryRequested) String() string { return proto.CompactTextString(m) }
+func (*TransferRetryRequested) ProtoMessage()    {}
+func (*TransferRetryRequested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{2}
+}
+func (m *TransferRetryRequested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRetryRequested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRetryRequested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRetryRequested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRetryRequested.Merge(m, src)
+}
+func (m *TransferRetryRequested) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRetryRequested) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRetryRequested.DiscardUnknown(m)
 }
 
-func (m *AccountRegistered) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TransferRetryRequested proto.InternalMessageInfo
+
+func (m *TransferRetryRequested) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AccountRegistered) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TransferRetryRequested) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
 }
 
-func (m *AccountRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Signerlessly {
-		i--
-		if m.Signerlessly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+func (m *TransferRetryRequested) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.DestinationCaller) > 0 {
-		i -= len(m.DestinationCaller)
-		copy(dAtA[i:], m.DestinationCaller)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.DestinationCaller)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+// FallbackPolicyUpdated is an event emitted when the fallback policy of an AutoCCTP
+// account is updated.
+type FallbackPolicyUpdated struct {
+	Address        string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	FallbackPolicy *FallbackPolicy `protobuf:"bytes,2,opt,name=fallback_policy,json=fallbackPolicy,proto3" json:"fallback_policy,omitempty"`
+}
+
+func (m *FallbackPolicyUpdated) Reset()         { *m = FallbackPolicyUpdated{} }
+func (m *FallbackPolicyUpdated) String() string { return proto.CompactTextString(m) }
+func (*FallbackPolicyUpdated) ProtoMessage()    {}
+func (*FallbackPolicyUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{3}
+}
+func (m *FallbackPolicyUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FallbackPolicyUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FallbackPolicyUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.FallbackRecipient) > 0 {
-		i -= len(m.FallbackRecipient)
-		copy(dAtA[i:], m.FallbackRecipient)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FallbackRecipient)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *FallbackPolicyUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FallbackPolicyUpdated.Merge(m, src)
+}
+func (m *FallbackPolicyUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *FallbackPolicyUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_FallbackPolicyUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FallbackPolicyUpdated proto.InternalMessageInfo
+
+func (m *FallbackPolicyUpdated) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if len(m.MintRecipient) > 0 {
-		i -= len(m.MintRecipient)
-		copy(dAtA[i:], m.MintRecipient)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.MintRecipient)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *FallbackPolicyUpdated) GetFallbackPolicy() *FallbackPolicy {
+	if m != nil {
+		return m.FallbackPolicy
 	}
-	if m.DestinationDomain != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.DestinationDomain))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+// ForwardOtherDenomsUpdated is an event emitted when an AutoCCTP account is allowed, or
+// disallowed, to receive denoms other than the minting denom.
+type ForwardOtherDenomsUpdated struct {
+	Address            string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ForwardOtherDenoms bool   `protobuf:"varint,2,opt,name=forward_other_denoms,json=forwardOtherDenoms,proto3" json:"forward_other_denoms,omitempty"`
+}
+
+func (m *ForwardOtherDenomsUpdated) Reset()         { *m = ForwardOtherDenomsUpdated{} }
+func (m *ForwardOtherDenomsUpdated) String() string { return proto.CompactTextString(m) }
+func (*ForwardOtherDenomsUpdated) ProtoMessage()    {}
+func (*ForwardOtherDenomsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{4}
+}
+func (m *ForwardOtherDenomsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardOtherDenomsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardOtherDenomsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ForwardOtherDenomsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardOtherDenomsUpdated.Merge(m, src)
+}
+func (m *ForwardOtherDenomsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardOtherDenomsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardOtherDenomsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardOtherDenomsUpdated proto.InternalMessageInfo
+
+func (m *ForwardOtherDenomsUpdated) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AccountCleared) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ForwardOtherDenomsUpdated) GetForwardOtherDenoms() bool {
+	if m != nil {
+		return m.ForwardOtherDenoms
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *AccountCleared) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OtherDenomsForwarded is an event emitted when the denoms other than the minting denom
+// held by an AutoCCTP account are forwarded to the fallback recipient.
+type OtherDenomsForwarded struct {
+	Address  string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Receiver string                                   `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Coins    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
 }
 
-func (m *AccountCleared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+func (m *OtherDenomsForwarded) Reset()         { *m = OtherDenomsForwarded{} }
+func (m *OtherDenomsForwarded) String() string { return proto.CompactTextString(m) }
+func (*OtherDenomsForwarded) ProtoMessage()    {}
+func (*OtherDenomsForwarded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{5}
+}
+func (m *OtherDenomsForwarded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OtherDenomsForwarded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OtherDenomsForwarded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *OtherDenomsForwarded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OtherDenomsForwarded.Merge(m, src)
+}
+func (m *OtherDenomsForwarded) XXX_Size() int {
+	return m.Size()
+}
+func (m *OtherDenomsForwarded) XXX_DiscardUnknown() {
+	xxx_messageInfo_OtherDenomsForwarded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OtherDenomsForwarded proto.InternalMessageInfo
+
+func (m *OtherDenomsForwarded) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OtherDenomsForwarded) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *AccountRegistered) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *OtherDenomsForwarded) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+// TransferExecuted is an event emitted when an automatic CCTP transfer from an AutoCCTP
+// account is executed.
+type TransferExecuted struct {
+	Address           string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32                `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	MintRecipient     []byte                `protobuf:"bytes,3,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+	DestinationCaller []byte                `protobuf:"bytes,4,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	Amount            cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom             string                `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The nonce of the CCTP message.
+	Nonce uint64 `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// The position of the transfer in the execution order of the block.
+	Sequence uint64 `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *TransferExecuted) Reset()         { *m = TransferExecuted{} }
+func (m *TransferExecuted) String() string { return proto.CompactTextString(m) }
+func (*TransferExecuted) ProtoMessage()    {}
+func (*TransferExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{6}
+}
+func (m *TransferExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.DestinationDomain != 0 {
-		n += 1 + sovEvent(uint64(m.DestinationDomain))
+}
+func (m *TransferExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferExecuted.Merge(m, src)
+}
+func (m *TransferExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferExecuted proto.InternalMessageInfo
+
+func (m *TransferExecuted) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	l = len(m.MintRecipient)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *TransferExecuted) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
 	}
-	l = len(m.FallbackRecipient)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return 0
+}
+
+func (m *TransferExecuted) GetMintRecipient() []byte {
+	if m != nil {
+		return m.MintRecipient
 	}
-	l = len(m.DestinationCaller)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+func (m *TransferExecuted) GetDestinationCaller() []byte {
+	if m != nil {
+		return m.DestinationCaller
 	}
-	if m.Signerlessly {
-		n += 2
+	return nil
+}
+
+func (m *TransferExecuted) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferExecuted) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TransferExecuted) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// TransferFailed is an event emitted when an automatic CCTP transfer from an AutoCCTP
+// account fails.
+type TransferFailed struct {
+	Address           string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32                `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	MintRecipient     []byte                `protobuf:"bytes,3,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+	DestinationCaller []byte                `protobuf:"bytes,4,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	Amount            cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom             string                `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	Error             string                `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// The position of the transfer in the execution order of the block.
+	Sequence uint64 `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *TransferFailed) Reset()         { *m = TransferFailed{} }
+func (m *TransferFailed) String() string { return proto.CompactTextString(m) }
+func (*TransferFailed) ProtoMessage()    {}
+func (*TransferFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{7}
+}
+func (m *TransferFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFailed.Merge(m, src)
+}
+func (m *TransferFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFailed proto.InternalMessageInfo
+
+func (m *TransferFailed) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TransferFailed) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *TransferFailed) GetMintRecipient() []byte {
+	if m != nil {
+		return m.MintRecipient
+	}
+	return nil
+}
+
+func (m *TransferFailed) GetDestinationCaller() []byte {
+	if m != nil {
+		return m.DestinationCaller
+	}
+	return nil
+}
+
+func (m *TransferFailed) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *TransferFailed) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// TransferFeeCollected is an event emitted when the fee of an automatic CCTP transfer is
+// deducted from an AutoCCTP account.
+type TransferFeeCollected struct {
+	Address           string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32 `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// The address receiving the fee.
+	Recipient string                `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom     string                `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *TransferFeeCollected) Reset()         { *m = TransferFeeCollected{} }
+func (m *TransferFeeCollected) String() string { return proto.CompactTextString(m) }
+func (*TransferFeeCollected) ProtoMessage()    {}
+func (*TransferFeeCollected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{8}
+}
+func (m *TransferFeeCollected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferFeeCollected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferFeeCollected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferFeeCollected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFeeCollected.Merge(m, src)
+}
+func (m *TransferFeeCollected) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferFeeCollected) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFeeCollected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFeeCollected proto.InternalMessageInfo
+
+func (m *TransferFeeCollected) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TransferFeeCollected) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *TransferFeeCollected) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *TransferFeeCollected) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// AccountDeregistered is an event emitted when the AutoCCTP account associated with the
+// address is deregistered by the fallback recipient.
+type AccountDeregistered struct {
+	Address           string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32 `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+}
+
+func (m *AccountDeregistered) Reset()         { *m = AccountDeregistered{} }
+func (m *AccountDeregistered) String() string { return proto.CompactTextString(m) }
+func (*AccountDeregistered) ProtoMessage()    {}
+func (*AccountDeregistered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{9}
+}
+func (m *AccountDeregistered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountDeregistered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountDeregistered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountDeregistered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountDeregistered.Merge(m, src)
+}
+func (m *AccountDeregistered) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountDeregistered) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountDeregistered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountDeregistered proto.InternalMessageInfo
+
+func (m *AccountDeregistered) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountDeregistered) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+// AccountExpired is an event emitted when the AutoCCTP account associated with the address
+// reaches its expiration and is pruned at the end of the block.
+type AccountExpired struct {
+	Address           string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32 `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+}
+
+func (m *AccountExpired) Reset()         { *m = AccountExpired{} }
+func (m *AccountExpired) String() string { return proto.CompactTextString(m) }
+func (*AccountExpired) ProtoMessage()    {}
+func (*AccountExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{10}
+}
+func (m *AccountExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountExpired.Merge(m, src)
+}
+func (m *AccountExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountExpired proto.InternalMessageInfo
+
+func (m *AccountExpired) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountExpired) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+// AccountPruneFailed is an event emitted when the AutoCCTP account associated with the
+// address cannot be pruned after its expiration. The pruning is retried until it reaches
+// the maximum number of transfer attempts.
+type AccountPruneFailed struct {
+	Address           string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32 `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	Error             string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Attempts          uint64 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (m *AccountPruneFailed) Reset()         { *m = AccountPruneFailed{} }
+func (m *AccountPruneFailed) String() string { return proto.CompactTextString(m) }
+func (*AccountPruneFailed) ProtoMessage()    {}
+func (*AccountPruneFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{11}
+}
+func (m *AccountPruneFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountPruneFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountPruneFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountPruneFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountPruneFailed.Merge(m, src)
+}
+func (m *AccountPruneFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountPruneFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountPruneFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountPruneFailed proto.InternalMessageInfo
+
+func (m *AccountPruneFailed) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountPruneFailed) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *AccountPruneFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AccountPruneFailed) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+// TransferBlocked is an event emitted when an automatic CCTP transfer is deferred, or
+// refused, by the fiat-tokenfactory compliance checks.
+type TransferBlocked struct {
+	Address           string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32                `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	Amount            cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom             string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	Reason            ComplianceReason      `protobuf:"varint,5,opt,name=reason,proto3,enum=noble.autocctp.v1.ComplianceReason" json:"reason,omitempty"`
+}
+
+func (m *TransferBlocked) Reset()         { *m = TransferBlocked{} }
+func (m *TransferBlocked) String() string { return proto.CompactTextString(m) }
+func (*TransferBlocked) ProtoMessage()    {}
+func (*TransferBlocked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{12}
+}
+func (m *TransferBlocked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferBlocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferBlocked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferBlocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferBlocked.Merge(m, src)
+}
+func (m *TransferBlocked) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferBlocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferBlocked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferBlocked proto.InternalMessageInfo
+
+func (m *TransferBlocked) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TransferBlocked) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *TransferBlocked) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferBlocked) GetReason() ComplianceReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ComplianceReasonUnspecified
+}
+
+// TransferRateLimited is an event emitted when an automatic CCTP transfer is deferred to a
+// later block because it exceeds the rate limit of the destination domain.
+type TransferRateLimited struct {
+	Address           string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32                `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	Amount            cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom             string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *TransferRateLimited) Reset()         { *m = TransferRateLimited{} }
+func (m *TransferRateLimited) String() string { return proto.CompactTextString(m) }
+func (*TransferRateLimited) ProtoMessage()    {}
+func (*TransferRateLimited) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{13}
+}
+func (m *TransferRateLimited) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRateLimited) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRateLimited.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRateLimited) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRateLimited.Merge(m, src)
+}
+func (m *TransferRateLimited) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRateLimited) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRateLimited.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRateLimited proto.InternalMessageInfo
+
+func (m *TransferRateLimited) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TransferRateLimited) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *TransferRateLimited) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// TransferRetriesExhausted is an event emitted when an automatic CCTP transfer fails after
+// reaching the maximum number of attempts, and is no longer retried automatically. The
+// failed transfer is kept until the account is cleared.
+type TransferRetriesExhausted struct {
+	Address           string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32                `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	Amount            cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Attempts          uint64                `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (m *TransferRetriesExhausted) Reset()         { *m = TransferRetriesExhausted{} }
+func (m *TransferRetriesExhausted) String() string { return proto.CompactTextString(m) }
+func (*TransferRetriesExhausted) ProtoMessage()    {}
+func (*TransferRetriesExhausted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4b6599cb121ef2c, []int{14}
+}
+func (m *TransferRetriesExhausted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRetriesExhausted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRetriesExhausted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRetriesExhausted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRetriesExhausted.Merge(m, src)
+}
+func (m *TransferRetriesExhausted) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRetriesExhausted) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRetriesExhausted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRetriesExhausted proto.InternalMessageInfo
+
+func (m *TransferRetriesExhausted) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TransferRetriesExhausted) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *TransferRetriesExhausted) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("noble.autocctp.v1.ClearingReason", ClearingReason_name, ClearingReason_value)
+	proto.RegisterEnum("noble.autocctp.v1.ComplianceReason", ComplianceReason_name, ComplianceReason_value)
+	proto.RegisterType((*AccountRegistered)(nil), "noble.autocctp.v1.AccountRegistered")
+	proto.RegisterType((*AccountCleared)(nil), "noble.autocctp.v1.AccountCleared")
+	proto.RegisterType((*TransferRetryRequested)(nil), "noble.autocctp.v1.TransferRetryRequested")
+	proto.RegisterType((*FallbackPolicyUpdated)(nil), "noble.autocctp.v1.FallbackPolicyUpdated")
+	proto.RegisterType((*ForwardOtherDenomsUpdated)(nil), "noble.autocctp.v1.ForwardOtherDenomsUpdated")
+	proto.RegisterType((*OtherDenomsForwarded)(nil), "noble.autocctp.v1.OtherDenomsForwarded")
+	proto.RegisterType((*TransferExecuted)(nil), "noble.autocctp.v1.TransferExecuted")
+	proto.RegisterType((*TransferFailed)(nil), "noble.autocctp.v1.TransferFailed")
+	proto.RegisterType((*TransferFeeCollected)(nil), "noble.autocctp.v1.TransferFeeCollected")
+	proto.RegisterType((*AccountDeregistered)(nil), "noble.autocctp.v1.AccountDeregistered")
+	proto.RegisterType((*AccountExpired)(nil), "noble.autocctp.v1.AccountExpired")
+	proto.RegisterType((*AccountPruneFailed)(nil), "noble.autocctp.v1.AccountPruneFailed")
+	proto.RegisterType((*TransferBlocked)(nil), "noble.autocctp.v1.TransferBlocked")
+	proto.RegisterType((*TransferRateLimited)(nil), "noble.autocctp.v1.TransferRateLimited")
+	proto.RegisterType((*TransferRetriesExhausted)(nil), "noble.autocctp.v1.TransferRetriesExhausted")
+}
+
+func init() { proto.RegisterFile("noble/autocctp/v1/event.proto", fileDescriptor_c4b6599cb121ef2c) }
+
+var fileDescriptor_c4b6599cb121ef2c = []byte{
+	// 1323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x76, 0x1a, 0x4f, 0x5b, 0xc7, 0xd9, 0xa6, 0xfd, 0x6e, 0xb6, 0xa9, 0xb3, 0x5f,
+	0x57, 0x6d, 0xad, 0xf6, 0xdb, 0x75, 0xd3, 0x2f, 0x42, 0x42, 0x20, 0xd0, 0x7a, 0xbd, 0x29, 0x26,
+	0x8e, 0x6d, 0xd6, 0x36, 0x2a, 0x1c, 0xb0, 0xc6, 0xeb, 0xb1, 0x33, 0xca, 0x7a, 0xc7, 0xec, 0x8c,
+	0x4d, 0x72, 0xe0, 0xc0, 0x0d, 0xf9, 0x42, 0xff, 0x01, 0x9f, 0xb8, 0x71, 0xe1, 0xc2, 0x05, 0x54,
+	0x71, 0xe2, 0x50, 0x2e, 0xa8, 0xe2, 0x54, 0x71, 0x68, 0x51, 0xfb, 0x1f, 0xf0, 0x17, 0xa0, 0x9d,
+	0x5d, 0x3b, 0xfe, 0xd5, 0x20, 0x21, 0x4b, 0x50, 0x71, 0xb2, 0xdf, 0x7b, 0xf3, 0x3e, 0xf3, 0xe6,
+	0xbd, 0xcf, 0xbc, 0x37, 0x0b, 0xae, 0x38, 0xa4, 0x6e, 0xa3, 0x34, 0xec, 0x32, 0x62, 0x59, 0xac,
+	0x93, 0xee, 0xed, 0xa4, 0x51, 0x0f, 0x39, 0x4c, 0xed, 0xb8, 0x84, 0x11, 0x71, 0x9d, 0x9b, 0xd5,
+	0xa1, 0x59, 0xed, 0xed, 0xc8, 0x09, 0x8b, 0xd0, 0x36, 0xa1, 0xe9, 0x3a, 0xa4, 0x28, 0xdd, 0xdb,
+	0xa9, 0x23, 0x06, 0x77, 0xd2, 0x16, 0xc1, 0x8e, 0xef, 0x22, 0x6f, 0xfa, 0xf6, 0x1a, 0x97, 0xd2,
+	0xbe, 0x10, 0x98, 0x36, 0x5a, 0xa4, 0x45, 0x7c, 0xbd, 0xf7, 0x2f, 0xd0, 0x6e, 0xb7, 0x08, 0x69,
+	0xd9, 0x28, 0xcd, 0xa5, 0x7a, 0xb7, 0x99, 0x66, 0xb8, 0x8d, 0x28, 0x83, 0xed, 0xce, 0x70, 0xc1,
+	0x6c, 0x8c, 0xd0, 0xb2, 0x48, 0x77, 0x18, 0x65, 0xf2, 0xfb, 0x10, 0x58, 0xd7, 0x7c, 0x8d, 0x89,
+	0x5a, 0x98, 0x32, 0xe4, 0xa2, 0x86, 0x28, 0x81, 0x33, 0xb0, 0xd1, 0x70, 0x11, 0xa5, 0x92, 0xa0,
+	0x08, 0xa9, 0xa8, 0x39, 0x14, 0xc5, 0xdb, 0x40, 0x6c, 0x20, 0xca, 0xb0, 0x03, 0x19, 0x26, 0x4e,
+	0xad, 0x41, 0xda, 0x10, 0x3b, 0xd2, 0xb2, 0x22, 0xa4, 0xce, 0x9b, 0xeb, 0x63, 0x96, 0x2c, 0x37,
+	0x88, 0xd7, 0x40, 0xac, 0x8d, 0x1d, 0x56, 0x73, 0x91, 0x85, 0x3b, 0x18, 0x39, 0x4c, 0x0a, 0x29,
+	0x42, 0xea, 0x9c, 0x79, 0xde, 0xd3, 0x9a, 0x43, 0xa5, 0x87, 0xda, 0x84, 0xb6, 0x5d, 0x87, 0xd6,
+	0xe1, 0xd8, 0xd2, 0x30, 0xdf, 0x7a, 0x7d, 0x68, 0x99, 0x58, 0x3e, 0x1e, 0x84, 0x05, 0x6d, 0x1b,
+	0xb9, 0x52, 0x84, 0x23, 0x8f, 0x07, 0xa1, 0x73, 0x83, 0x98, 0x04, 0xe7, 0x28, 0x6e, 0x39, 0xc8,
+	0xb5, 0x11, 0xa5, 0xf6, 0xb1, 0xb4, 0xa2, 0x08, 0xa9, 0x55, 0x73, 0x42, 0x27, 0xde, 0x02, 0xeb,
+	0xe8, 0xa8, 0x83, 0x5d, 0x1f, 0xf1, 0x00, 0xe1, 0xd6, 0x01, 0x93, 0xce, 0x28, 0x42, 0x2a, 0x6c,
+	0xc6, 0x4f, 0x0c, 0xef, 0x72, 0xbd, 0x98, 0x03, 0x6b, 0x63, 0x8b, 0xbd, 0x9c, 0x4b, 0xab, 0x8a,
+	0x90, 0x3a, 0x7b, 0x57, 0x56, 0xfd, 0x82, 0xa8, 0xc3, 0x82, 0xa8, 0x95, 0x61, 0x41, 0x32, 0xe1,
+	0x07, 0xcf, 0xb6, 0x05, 0x33, 0x76, 0xe2, 0xe8, 0x99, 0xc4, 0x1b, 0x60, 0x2d, 0x48, 0x6d, 0xad,
+	0x87, 0x5c, 0x8a, 0x89, 0x23, 0x45, 0x79, 0x32, 0x63, 0x81, 0xfa, 0x03, 0x5f, 0x2b, 0x8a, 0x20,
+	0x4c, 0xa1, 0xcd, 0x24, 0xc0, 0x4f, 0xc9, 0xff, 0x27, 0x3f, 0x17, 0x40, 0x2c, 0x28, 0x9e, 0x6e,
+	0x23, 0x78, 0x7a, 0xe5, 0x64, 0xb0, 0xea, 0x22, 0x0b, 0xe1, 0x1e, 0x72, 0x79, 0xbd, 0xa2, 0xe6,
+	0x48, 0x16, 0xdf, 0x00, 0x2b, 0x2e, 0x82, 0x94, 0x38, 0xbc, 0x3c, 0xb1, 0xbb, 0xff, 0x55, 0x67,
+	0xc8, 0xab, 0xf2, 0x1d, 0xb0, 0xd3, 0x32, 0xf9, 0x42, 0x33, 0x70, 0x48, 0x3e, 0x14, 0xc0, 0xa5,
+	0x8a, 0x0b, 0x1d, 0xda, 0x44, 0xae, 0x89, 0x98, 0x7b, 0x6c, 0xa2, 0x4f, 0xba, 0x88, 0xb2, 0x45,
+	0xb2, 0x48, 0x07, 0x2b, 0xb0, 0xed, 0x9d, 0x92, 0x87, 0x17, 0xcd, 0xdc, 0x7a, 0xf4, 0x74, 0x7b,
+	0xe9, 0xd7, 0xa7, 0xdb, 0x17, 0xfd, 0x2b, 0x42, 0x1b, 0x87, 0x2a, 0x26, 0xe9, 0x36, 0x64, 0x07,
+	0x6a, 0xce, 0x61, 0xbf, 0x7c, 0x7b, 0x1b, 0x04, 0x77, 0x27, 0xe7, 0x30, 0x33, 0x70, 0x15, 0x37,
+	0x40, 0xa4, 0x81, 0x1c, 0xd2, 0x0e, 0x68, 0xe5, 0x0b, 0xc9, 0xcf, 0xc0, 0xc5, 0xdd, 0x80, 0x5f,
+	0x25, 0x62, 0x63, 0xeb, 0xb8, 0xda, 0x69, 0xc0, 0xd3, 0x83, 0x7f, 0x0f, 0xac, 0x8d, 0xc8, 0xda,
+	0xe1, 0x3e, 0x3c, 0xf2, 0xb3, 0x73, 0xb3, 0x36, 0x09, 0x6e, 0xc6, 0x9a, 0x13, 0x72, 0xb2, 0x05,
+	0x36, 0x77, 0x89, 0xfb, 0x29, 0x74, 0x1b, 0x45, 0x76, 0x80, 0xdc, 0xac, 0x17, 0x13, 0xfd, 0xf3,
+	0x10, 0xee, 0x80, 0x8d, 0xa6, 0xef, 0x56, 0x23, 0x9e, 0x5f, 0x8d, 0x1f, 0x86, 0xf2, 0x38, 0x56,
+	0x4d, 0xb1, 0x39, 0x03, 0x99, 0xfc, 0x46, 0x00, 0x1b, 0x63, 0x72, 0xb0, 0xe9, 0x5f, 0x26, 0x0c,
+	0x04, 0x11, 0xaf, 0x6f, 0x51, 0x29, 0xa4, 0x84, 0x52, 0x67, 0xef, 0x6e, 0xaa, 0x41, 0xc2, 0xbd,
+	0xce, 0xa6, 0x06, 0x9d, 0x4d, 0xd5, 0x09, 0x76, 0x32, 0x77, 0xbc, 0x5a, 0x7d, 0xfd, 0x6c, 0x3b,
+	0xd5, 0xc2, 0xec, 0xa0, 0x5b, 0x57, 0x2d, 0xd2, 0x0e, 0x3a, 0x5b, 0xf0, 0x73, 0x9b, 0x36, 0x0e,
+	0xd3, 0xec, 0xb8, 0x83, 0x28, 0x77, 0xa0, 0xa6, 0x8f, 0x9c, 0xfc, 0x61, 0x19, 0xc4, 0x87, 0xc4,
+	0x32, 0x8e, 0x90, 0xd5, 0x65, 0x7f, 0x4f, 0x63, 0x9a, 0xd3, 0x69, 0xc2, 0x2f, 0xeb, 0x34, 0x27,
+	0x44, 0x8d, 0x2c, 0x80, 0xa8, 0x2b, 0x63, 0x44, 0xf5, 0xb4, 0x0e, 0x71, 0x2c, 0x14, 0x34, 0x25,
+	0x5f, 0xf0, 0x6a, 0x44, 0xbd, 0xfb, 0xe6, 0x19, 0x56, 0xb9, 0x61, 0x24, 0x27, 0x1f, 0x2e, 0x83,
+	0xd8, 0x30, 0x81, 0xbb, 0x10, 0xdb, 0xff, 0xf6, 0xf4, 0x21, 0xd7, 0x25, 0x2e, 0x4f, 0x5f, 0xd4,
+	0xf4, 0x85, 0x53, 0xd3, 0xf7, 0x44, 0x00, 0x1b, 0xa3, 0xf4, 0x21, 0xa4, 0x13, 0xdb, 0x46, 0xd6,
+	0x42, 0x39, 0xb8, 0x05, 0xa2, 0x93, 0xf9, 0x8b, 0x9a, 0x27, 0x8a, 0xb1, 0x64, 0x84, 0x17, 0x90,
+	0x8c, 0xc8, 0x78, 0xd3, 0xfb, 0x18, 0x5c, 0x08, 0xc6, 0x46, 0x16, 0xb9, 0x8b, 0x9f, 0xfa, 0xc9,
+	0x0f, 0x47, 0x63, 0xc9, 0xf0, 0xa6, 0xdd, 0x22, 0xa1, 0xbf, 0x14, 0x80, 0x18, 0x60, 0x97, 0xdc,
+	0xae, 0x83, 0x16, 0x4d, 0xec, 0x11, 0x4f, 0x42, 0x53, 0x3c, 0x81, 0x8c, 0xa1, 0x76, 0x87, 0x51,
+	0x5e, 0x8d, 0xb0, 0x39, 0x92, 0x93, 0xbf, 0x0b, 0x60, 0x6d, 0xc8, 0x93, 0x8c, 0x4d, 0xac, 0xc3,
+	0x57, 0x63, 0xf2, 0x89, 0x6f, 0x8e, 0x66, 0x7e, 0x84, 0xcf, 0xfc, 0xab, 0xf3, 0x66, 0x3e, 0x69,
+	0x77, 0x6c, 0x0c, 0x1d, 0x0b, 0x4d, 0x4d, 0xfd, 0xef, 0x04, 0x70, 0x61, 0x34, 0xf5, 0x21, 0x43,
+	0x79, 0xdc, 0xc6, 0xaf, 0xca, 0xc8, 0xff, 0x51, 0x00, 0xd2, 0xf8, 0x8b, 0x05, 0x23, 0x6a, 0x1c,
+	0x1d, 0xc0, 0xee, 0x3f, 0xf0, 0xcd, 0x72, 0x0a, 0xef, 0x6e, 0xfe, 0x1c, 0x02, 0xb1, 0xc9, 0x37,
+	0x99, 0xf8, 0x36, 0xb8, 0xac, 0xe7, 0x0d, 0xcd, 0xcc, 0x15, 0xee, 0xd5, 0x4c, 0x43, 0x2b, 0x17,
+	0x0b, 0xb5, 0x6a, 0xa1, 0x5c, 0x32, 0xf4, 0xdc, 0x6e, 0xce, 0xc8, 0xc6, 0x97, 0xe4, 0x2b, 0xfd,
+	0x81, 0xb2, 0x39, 0xe9, 0x54, 0x75, 0x68, 0x07, 0x59, 0xb8, 0x89, 0x51, 0x43, 0x7c, 0x0d, 0x5c,
+	0x9a, 0xf6, 0xdf, 0xd7, 0x0a, 0x55, 0x2d, 0x1f, 0x17, 0x64, 0xa9, 0x3f, 0x50, 0x36, 0x26, 0x5d,
+	0xf7, 0xa1, 0xd3, 0x85, 0xb6, 0xf8, 0x0e, 0xd8, 0x9a, 0xf5, 0xba, 0x5f, 0xd3, 0x2a, 0x15, 0x63,
+	0xbf, 0x54, 0x29, 0xc7, 0x97, 0xe7, 0x6d, 0xbb, 0x0f, 0x8f, 0xb4, 0xe0, 0x24, 0xa2, 0x0e, 0x12,
+	0xd3, 0x00, 0x95, 0xdc, 0xbe, 0x51, 0xac, 0x56, 0x6a, 0x99, 0x7c, 0x51, 0xdf, 0x2b, 0xc7, 0x43,
+	0xf2, 0x76, 0x7f, 0xa0, 0x5c, 0x9e, 0x84, 0xf0, 0xde, 0xcf, 0xa4, 0xcb, 0xf8, 0x9d, 0xa3, 0xe2,
+	0xeb, 0xe0, 0x3f, 0x2f, 0x01, 0x89, 0x87, 0xe5, 0xcd, 0xfe, 0x40, 0xb9, 0x38, 0xd7, 0x5b, 0xcc,
+	0xce, 0x6e, 0x9e, 0x35, 0x4c, 0xe3, 0x5e, 0xae, 0x5c, 0x31, 0xb5, 0x4a, 0xae, 0x58, 0x88, 0x47,
+	0x64, 0xa5, 0x3f, 0x50, 0xb6, 0x26, 0xdd, 0x87, 0x8d, 0xd3, 0x7f, 0xca, 0x8b, 0x6f, 0x01, 0x79,
+	0x1a, 0xc5, 0xb8, 0x5f, 0xca, 0x05, 0x08, 0x2b, 0xf2, 0x56, 0x7f, 0xa0, 0x48, 0x93, 0x08, 0xc6,
+	0xe8, 0x43, 0x40, 0x0e, 0x7f, 0xf1, 0x55, 0x62, 0xe9, 0xe6, 0x4f, 0xcb, 0x20, 0x3e, 0x7d, 0xe1,
+	0xc4, 0x0c, 0xb8, 0xa2, 0x17, 0xf7, 0x4b, 0xf9, 0x9c, 0x56, 0xd0, 0x8d, 0xf9, 0x45, 0xf5, 0x53,
+	0x33, 0xe5, 0x38, 0x5e, 0x56, 0x2f, 0xbf, 0x33, 0x18, 0x95, 0xe2, 0x9e, 0x51, 0xa8, 0x95, 0xb4,
+	0x6a, 0xd9, 0xc8, 0xc6, 0x85, 0xf9, 0x20, 0x15, 0x72, 0x88, 0x9c, 0x12, 0xec, 0x52, 0xd4, 0x10,
+	0xdf, 0x07, 0xd7, 0x66, 0x41, 0x34, 0x5d, 0x2f, 0x56, 0x0b, 0x5e, 0x99, 0x34, 0x7d, 0x2f, 0x9f,
+	0x2b, 0x57, 0x8c, 0x6c, 0x7c, 0x59, 0xbe, 0xde, 0x1f, 0x28, 0xc9, 0x69, 0xac, 0xa0, 0x69, 0x67,
+	0x6c, 0x68, 0x1d, 0xda, 0x98, 0xdf, 0xb5, 0x32, 0xb8, 0x3e, 0x0b, 0xb9, 0xab, 0xe5, 0xf3, 0x19,
+	0x4d, 0xdf, 0x9b, 0xc0, 0x0c, 0xc9, 0x37, 0xfa, 0x03, 0xe5, 0xea, 0x34, 0xe6, 0xf0, 0x71, 0x3d,
+	0x06, 0xea, 0xe7, 0x32, 0xf3, 0xbf, 0x47, 0xcf, 0x13, 0xc2, 0xe3, 0xe7, 0x09, 0xe1, 0xb7, 0xe7,
+	0x09, 0xe1, 0xc1, 0x8b, 0xc4, 0xd2, 0xe3, 0x17, 0x89, 0xa5, 0x27, 0x2f, 0x12, 0x4b, 0x1f, 0x89,
+	0xa3, 0xfe, 0xd6, 0x40, 0x3d, 0xff, 0xdd, 0x59, 0x5f, 0xe1, 0x9f, 0x6b, 0xff, 0xff, 0x23, 0x00,
+	0x00, 0xff, 0xff, 0x4a, 0x12, 0xa1, 0x2e, 0xd2, 0x0f, 0x00, 0x00,
+}
+
+func (m *AccountRegistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountRegistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.AddressVersion != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintEvent(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Signerlessly {
+		i--
+		if m.Signerlessly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DestinationCaller) > 0 {
+		i -= len(m.DestinationCaller)
+		copy(dAtA[i:], m.DestinationCaller)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DestinationCaller)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FallbackRecipient) > 0 {
+		i -= len(m.FallbackRecipient)
+		copy(dAtA[i:], m.FallbackRecipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FallbackRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MintRecipient) > 0 {
+		i -= len(m.MintRecipient)
+		copy(dAtA[i:], m.MintRecipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MintRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountCleared) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountCleared) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountCleared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRetryRequested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRetryRequested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRetryRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FallbackPolicyUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FallbackPolicyUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FallbackPolicyUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FallbackPolicy != nil {
+		{
+			size, err := m.FallbackPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardOtherDenomsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardOtherDenomsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardOtherDenomsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardOtherDenoms {
+		i--
+		if m.ForwardOtherDenoms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OtherDenomsForwarded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OtherDenomsForwarded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OtherDenomsForwarded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DestinationCaller) > 0 {
+		i -= len(m.DestinationCaller)
+		copy(dAtA[i:], m.DestinationCaller)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DestinationCaller)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MintRecipient) > 0 {
+		i -= len(m.MintRecipient)
+		copy(dAtA[i:], m.MintRecipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MintRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DestinationCaller) > 0 {
+		i -= len(m.DestinationCaller)
+		copy(dAtA[i:], m.DestinationCaller)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DestinationCaller)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MintRecipient) > 0 {
+		i -= len(m.MintRecipient)
+		copy(dAtA[i:], m.MintRecipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MintRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferFeeCollected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferFeeCollected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferFeeCollected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountDeregistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountDeregistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountDeregistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountPruneFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountPruneFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountPruneFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferBlocked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferBlocked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferBlocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRateLimited) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRateLimited) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRateLimited) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRetriesExhausted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRetriesExhausted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRetriesExhausted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountRegistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvent(uint64(m.DestinationDomain))
+	}
+	l = len(m.MintRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.FallbackRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DestinationCaller)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Signerlessly {
+		n += 2
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovEvent(uint64(m.ExpirationHeight))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovEvent(uint64(m.AddressVersion))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountCleared) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *TransferRetryRequested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvent(uint64(m.DestinationDomain))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *FallbackPolicyUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.FallbackPolicy != nil {
+		l = m.FallbackPolicy.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardOtherDenomsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ForwardOtherDenoms {
+		n += 2
+	}
+	return n
+}
+
+func (m *OtherDenomsForwarded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvent(uint64(m.DestinationDomain))
+	}
+	l = len(m.MintRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DestinationCaller)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvent(uint64(m.Nonce))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *TransferFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvent(uint64(m.DestinationDomain))
+	}
+	l = len(m.MintRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DestinationCaller)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *TransferFeeCollected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvent(uint64(m.DestinationDomain))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountDeregistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvent(uint64(m.DestinationDomain))
+	}
+	return n
+}
+
+func (m *AccountExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvent(uint64(m.DestinationDomain))
+	}
+	return n
+}
+
+func (m *AccountPruneFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvent(uint64(m.DestinationDomain))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovEvent(uint64(m.Attempts))
+	}
+	return n
+}
+
+func (m *TransferBlocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvent(uint64(m.DestinationDomain))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *TransferRateLimited) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvent(uint64(m.DestinationDomain))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferRetriesExhausted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvent(uint64(m.DestinationDomain))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Attempts != 0 {
+		n += 1 + sovEvent(uint64(m.Attempts))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountRegistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintRecipient == nil {
+				m.MintRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationCaller == nil {
+				m.DestinationCaller = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signerlessly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Signerlessly = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountCleared) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountCleared: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountCleared: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ClearingReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRetryRequested) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRetryRequested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRetryRequested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FallbackPolicyUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FallbackPolicyUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FallbackPolicyUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FallbackPolicy == nil {
+				m.FallbackPolicy = &FallbackPolicy{}
+			}
+			if err := m.FallbackPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardOtherDenomsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardOtherDenomsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardOtherDenomsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardOtherDenoms", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForwardOtherDenoms = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OtherDenomsForwarded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OtherDenomsForwarded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OtherDenomsForwarded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferExecuted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferExecuted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintRecipient == nil {
+				m.MintRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationCaller == nil {
+				m.DestinationCaller = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintRecipient == nil {
+				m.MintRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationCaller == nil {
+				m.DestinationCaller = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferFeeCollected) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferFeeCollected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferFeeCollected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountDeregistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountDeregistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountDeregistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountPruneFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountPruneFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountPruneFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *AccountCleared) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
+func (m *TransferBlocked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -382,10 +4601,10 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountRegistered: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferBlocked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferBlocked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -441,9 +4660,9 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -453,29 +4672,29 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.MintRecipient == nil {
-				m.MintRecipient = []byte{}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -503,13 +4722,82 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ComplianceReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRateLimited) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRateLimited: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRateLimited: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -519,31 +4807,29 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
-			if m.DestinationCaller == nil {
-				m.DestinationCaller = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signerlessly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
 			}
-			var v int
+			m.DestinationDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -553,12 +4839,77 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DestinationDomain |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Signerlessly = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -580,7 +4931,7 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountCleared) Unmarshal(dAtA []byte) error {
+func (m *TransferRetriesExhausted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -603,10 +4954,10 @@ func (m *AccountCleared) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountCleared: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferRetriesExhausted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountCleared: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferRetriesExhausted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -642,8 +4993,27 @@ func (m *AccountCleared) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -671,8 +5041,29 @@ func (m *AccountCleared) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -33,15 +33,20 @@ type AccountKeeper interface {
 	AddressCodec() address.Codec
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	HasAccount(ctx context.Context, addr sdk.AccAddress) bool
+	IterateAccounts(ctx context.Context, cb func(account sdk.AccountI) (stop bool))
 	NewAccountWithAddress(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	SetAccount(ctx context.Context, acc sdk.AccountI)
 }
 
 type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
 type FiatTokenfactoryKeeper interface {
+	GetBlacklisted(ctx context.Context, addressBz []byte) (val fiattokenfactorytypes.Blacklisted, found bool)
 	GetMintingDenom(ctx context.Context) (val fiattokenfactorytypes.MintingDenom)
+	GetPaused(ctx context.Context) (val fiattokenfactorytypes.Paused)
 }
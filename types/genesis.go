@@ -24,13 +24,225 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params:  DefaultParams(),
+		Domains: DefaultDomains(),
+	}
 }
 
 func (gs *GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	domains := make(map[uint32]bool, len(gs.Domains))
+	for _, domain := range gs.Domains {
+		if domains[domain.DestinationDomain] {
+			return fmt.Errorf("destination domain %d is registered more than once", domain.DestinationDomain)
+		}
+		domains[domain.DestinationDomain] = true
+
+		if err := domain.Validate(); err != nil {
+			return fmt.Errorf("invalid destination domain: %w", err)
+		}
+	}
+
+	pausedDomains := make(map[uint32]bool, len(gs.PausedDestinationDomains))
+	for _, destinationDomain := range gs.PausedDestinationDomains {
+		if pausedDomains[destinationDomain] {
+			return fmt.Errorf("destination domain %d is paused more than once", destinationDomain)
+		}
+		pausedDomains[destinationDomain] = true
+
+		if !domains[destinationDomain] {
+			return fmt.Errorf("paused destination domain %d is not registered", destinationDomain)
+		}
+	}
+
+	failedTransfers := make(map[string]bool, len(gs.FailedTransfers))
+	for _, failedTransfer := range gs.FailedTransfers {
+		if failedTransfers[failedTransfer.Address] {
+			return fmt.Errorf("failed transfer for address %s is registered more than once", failedTransfer.Address)
+		}
+		failedTransfers[failedTransfer.Address] = true
+
+		if err := failedTransfer.Validate(); err != nil {
+			return fmt.Errorf("invalid failed transfer: %w", err)
+		}
+	}
+
+	failedForwards := make(map[string]bool, len(gs.FailedForwards))
+	for _, failedForward := range gs.FailedForwards {
+		if failedForwards[failedForward.Address] {
+			return fmt.Errorf("failed forward for address %s is registered more than once", failedForward.Address)
+		}
+		failedForwards[failedForward.Address] = true
+
+		if _, _, err := bech32.DecodeAndConvert(failedForward.Address); err != nil {
+			return fmt.Errorf("invalid failed forward address: %w", err)
+		}
+		if failedForward.Attempts == 0 {
+			return fmt.Errorf("failed forward for address %s has no attempts", failedForward.Address)
+		}
+	}
+
+	owedFees := make(map[string]bool, len(gs.OwedFees))
+	for _, owedFee := range gs.OwedFees {
+		if owedFees[owedFee.Address] {
+			return fmt.Errorf("owed fee for address %s is registered more than once", owedFee.Address)
+		}
+		owedFees[owedFee.Address] = true
+
+		if _, _, err := bech32.DecodeAndConvert(owedFee.Address); err != nil {
+			return fmt.Errorf("invalid owed fee address: %w", err)
+		}
+		if owedFee.Amount.IsNil() || owedFee.Amount.IsNegative() {
+			return fmt.Errorf("owed fee for address %s cannot be negative", owedFee.Address)
+		}
+		if owedFee.AssessedAmount.IsNil() || owedFee.AssessedAmount.LT(owedFee.Amount) {
+			return fmt.Errorf("owed fee for address %s cannot exceed its assessed amount", owedFee.Address)
+		}
+	}
+
+	awaitingTransfers := make(map[string]bool, len(gs.AwaitingTransfers))
+	for _, address := range gs.AwaitingTransfers {
+		if awaitingTransfers[address] {
+			return fmt.Errorf("awaiting transfer for address %s is registered more than once", address)
+		}
+		awaitingTransfers[address] = true
+
+		if _, _, err := bech32.DecodeAndConvert(address); err != nil {
+			return fmt.Errorf("invalid awaiting transfer address: %w", err)
+		}
+	}
+
+	if gs.ResumeCursor != nil && gs.ResumeCursor.Address != "" {
+		if _, _, err := bech32.DecodeAndConvert(gs.ResumeCursor.Address); err != nil {
+			return fmt.Errorf("invalid resume cursor address: %w", err)
+		}
+	}
+
+	dirtyAccounts := make(map[string]bool, len(gs.DirtyAccounts))
+	for _, address := range gs.DirtyAccounts {
+		if dirtyAccounts[address] {
+			return fmt.Errorf("dirty account %s is registered more than once", address)
+		}
+		dirtyAccounts[address] = true
+
+		if _, _, err := bech32.DecodeAndConvert(address); err != nil {
+			return fmt.Errorf("invalid dirty account address: %w", err)
+		}
+	}
+
+	if gs.FallbackSweepCursor != "" {
+		if _, _, err := bech32.DecodeAndConvert(gs.FallbackSweepCursor); err != nil {
+			return fmt.Errorf("invalid fallback sweep cursor address: %w", err)
+		}
+	}
+
+	if gs.SweepCursor != nil {
+		if _, _, err := bech32.DecodeAndConvert(gs.SweepCursor.Address); err != nil {
+			return fmt.Errorf("invalid sweep cursor address: %w", err)
+		}
+	}
+
+	for destinationDomain, usage := range gs.RateLimitUsage {
+		if err := usage.Validate(); err != nil {
+			return fmt.Errorf("invalid rate limit usage for destination domain %d: %w", destinationDomain, err)
+		}
+	}
+
+	rateLimitBuckets := make(map[string]bool, len(gs.RateLimitBuckets))
+	for _, bucket := range gs.RateLimitBuckets {
+		key := fmt.Sprintf("%d/%d", bucket.DestinationDomain, bucket.Height)
+		if rateLimitBuckets[key] {
+			return fmt.Errorf("rate limit bucket of destination domain %d at height %d is registered more than once", bucket.DestinationDomain, bucket.Height)
+		}
+		rateLimitBuckets[key] = true
+
+		if err := bucket.Usage.Validate(); err != nil {
+			return fmt.Errorf("invalid rate limit bucket: %w", err)
+		}
+	}
+
+	rateLimitedTransfers := make(map[string]bool, len(gs.RateLimitedTransfers))
+	for _, address := range gs.RateLimitedTransfers {
+		if rateLimitedTransfers[address] {
+			return fmt.Errorf("rate limited transfer for address %s is registered more than once", address)
+		}
+		rateLimitedTransfers[address] = true
+
+		if _, _, err := bech32.DecodeAndConvert(address); err != nil {
+			return fmt.Errorf("invalid rate limited transfer address: %w", err)
+		}
+	}
+
+	queuedIDs := make(map[uint64]bool, len(gs.TransferQueue))
+	queuedAddresses := make(map[string]bool, len(gs.TransferQueue))
+	for _, queuedTransfer := range gs.TransferQueue {
+		if queuedIDs[queuedTransfer.Id] {
+			return fmt.Errorf("queued transfer %d is registered more than once", queuedTransfer.Id)
+		}
+		queuedIDs[queuedTransfer.Id] = true
+		if queuedAddresses[queuedTransfer.Address] {
+			return fmt.Errorf("queued transfer for address %s is registered more than once", queuedTransfer.Address)
+		}
+		queuedAddresses[queuedTransfer.Address] = true
+
+		if _, _, err := bech32.DecodeAndConvert(queuedTransfer.Address); err != nil {
+			return fmt.Errorf("invalid queued transfer address: %w", err)
+		}
+		if queuedTransfer.Id >= gs.TransferQueueSequence {
+			return fmt.Errorf("queued transfer %d is not below the transfer queue sequence %d", queuedTransfer.Id, gs.TransferQueueSequence)
+		}
+	}
+
+	pruneRetries := make(map[string]bool, len(gs.PruneRetries))
+	for _, retry := range gs.PruneRetries {
+		if pruneRetries[retry.Address] {
+			return fmt.Errorf("prune retry for address %s is registered more than once", retry.Address)
+		}
+		pruneRetries[retry.Address] = true
+
+		if _, _, err := bech32.DecodeAndConvert(retry.Address); err != nil {
+			return fmt.Errorf("invalid prune retry address: %w", err)
+		}
+	}
+
+	transferRecords := make(map[uint64]bool, len(gs.TransferHistory))
+	for _, record := range gs.TransferHistory {
+		if transferRecords[record.Id] {
+			return fmt.Errorf("transfer record %d is registered more than once", record.Id)
+		}
+		transferRecords[record.Id] = true
+
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer record: %w", err)
+		}
+	}
+
+	statsBuckets := make(map[string]bool, len(gs.StatsHistory))
+	for _, bucket := range gs.StatsHistory {
+		key := fmt.Sprintf("%d/%d", bucket.DestinationDomain, bucket.StartTime.UnixNano())
+		if statsBuckets[key] {
+			return fmt.Errorf("stats bucket of destination domain %d starting at %s is registered more than once", bucket.DestinationDomain, bucket.StartTime)
+		}
+		statsBuckets[key] = true
+
+		if err := bucket.Validate(); err != nil {
+			return fmt.Errorf("invalid stats bucket: %w", err)
+		}
+		if duration := gs.Params.StatsBucketDuration; duration > 0 && !bucket.StartTime.Truncate(duration).Equal(bucket.StartTime) {
+			return fmt.Errorf("stats bucket of destination domain %d starting at %s is not aligned to the bucket duration %s", bucket.DestinationDomain, bucket.StartTime, duration)
+		}
+	}
+
 	keysNumOfAccounts := make([]uint32, 0, len(gs.NumOfAccounts))
 	for k := range gs.NumOfAccounts {
 		keysNumOfAccounts = append(keysNumOfAccounts, k)
@@ -41,8 +253,8 @@ func (gs *GenesisState) Validate() error {
 		keysNumOfTransfers = append(keysNumOfTransfers, k)
 	}
 
-	keysTotalTransferred := make([]uint32, 0, len(gs.TotalTransferred))
-	for k := range gs.TotalTransferred {
+	keysTotalTransferred := make([]uint32, 0, len(gs.TotalTransferredAmount))
+	for k := range gs.TotalTransferredAmount {
 		keysTotalTransferred = append(keysTotalTransferred, k)
 	}
 
@@ -71,8 +283,11 @@ func (gs *GenesisState) Validate() error {
 	}
 
 	for _, keyTotalTransferred := range keysTotalTransferred {
-		tot := gs.TotalTransferred[keyTotalTransferred]
-		if tot == 0 {
+		tot, ok := math.NewIntFromString(gs.TotalTransferredAmount[keyTotalTransferred])
+		if !ok || tot.IsNegative() {
+			return fmt.Errorf("invalid total transferred for destination domain %d", keyTotalTransferred)
+		}
+		if tot.IsZero() {
 			return fmt.Errorf("trying to register 0 total transferred for destination domain %d", keysTotalTransferred)
 		}
 
@@ -86,11 +301,31 @@ func (gs *GenesisState) Validate() error {
 			return fmt.Errorf("trying to register total transferred without transfers for destination domain %d", keysTotalTransferred)
 		}
 
-		acc, found := gs.NumOfAccounts[keyTotalTransferred]
-		if !found || acc == 0 {
+		// The accounts that received the transfers may have been deregistered since, so the
+		// number of accounts is only required to be tracked for the destination domain.
+		if _, found := gs.NumOfAccounts[keyTotalTransferred]; !found {
 			return fmt.Errorf("cannot have transfers for destination domain %d without registered accounts", keyTotalTransferred)
 		}
 	}
 
+	for destinationDomain, stats := range gs.OutcomeStats {
+		if err := stats.Validate(); err != nil {
+			return fmt.Errorf("invalid outcome stats for destination domain %d: %w", destinationDomain, err)
+		}
+	}
+
+	for destinationDomain, value := range gs.TotalFees {
+		tot, ok := math.NewIntFromString(value)
+		if !ok || tot.IsNegative() {
+			return fmt.Errorf("invalid total fees for destination domain %d", destinationDomain)
+		}
+		if tot.IsZero() {
+			return fmt.Errorf("trying to register 0 total fees for destination domain %d", destinationDomain)
+		}
+		if gs.NumOfTransfers[destinationDomain] == 0 {
+			return fmt.Errorf("cannot have fees for destination domain %d without transfers", destinationDomain)
+		}
+	}
+
 	return nil
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,9 +26,37 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the genesis state of the AutoCCTP module.
 type GenesisState struct {
-	NumOfAccounts    map[uint32]uint64 `protobuf:"bytes,1,rep,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	NumOfTransfers   map[uint32]uint64 `protobuf:"bytes,2,rep,name=num_of_transfers,json=numOfTransfers,proto3" json:"num_of_transfers,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	TotalTransferred map[uint32]uint64 `protobuf:"bytes,3,rep,name=total_transferred,json=totalTransferred,proto3" json:"total_transferred,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	NumOfAccounts            map[uint32]uint64       `protobuf:"bytes,1,rep,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	NumOfTransfers           map[uint32]uint64       `protobuf:"bytes,2,rep,name=num_of_transfers,json=numOfTransfers,proto3" json:"num_of_transfers,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Params                   Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	Domains                  []DomainConfig          `protobuf:"bytes,5,rep,name=domains,proto3" json:"domains"`
+	FailedTransfers          []FailedTransfer        `protobuf:"bytes,6,rep,name=failed_transfers,json=failedTransfers,proto3" json:"failed_transfers"`
+	TransferHistory          []TransferRecord        `protobuf:"bytes,7,rep,name=transfer_history,json=transferHistory,proto3" json:"transfer_history"`
+	Paused                   bool                    `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	PausedDestinationDomains []uint32                `protobuf:"varint,9,rep,packed,name=paused_destination_domains,json=pausedDestinationDomains,proto3" json:"paused_destination_domains,omitempty"`
+	TotalFees                map[uint32]string       `protobuf:"bytes,10,rep,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StatsHistory             []StatsBucket           `protobuf:"bytes,11,rep,name=stats_history,json=statsHistory,proto3" json:"stats_history"`
+	OutcomeStats             map[uint32]OutcomeStats `protobuf:"bytes,12,rep,name=outcome_stats,json=outcomeStats,proto3" json:"outcome_stats" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AwaitingTransfers        []string                `protobuf:"bytes,13,rep,name=awaiting_transfers,json=awaitingTransfers,proto3" json:"awaiting_transfers,omitempty"`
+	// The position of the resumption of the deferred transfers, if in progress.
+	ResumeCursor *ResumeCursor `protobuf:"bytes,14,opt,name=resume_cursor,json=resumeCursor,proto3" json:"resume_cursor,omitempty"`
+	// Whether the minting token was paused in the fiat-tokenfactory at the last end block.
+	TokenPaused          bool                      `protobuf:"varint,15,opt,name=token_paused,json=tokenPaused,proto3" json:"token_paused,omitempty"`
+	RateLimitUsage       map[uint32]RateLimitUsage `protobuf:"bytes,16,rep,name=rate_limit_usage,json=rateLimitUsage,proto3" json:"rate_limit_usage" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RateLimitBuckets     []RateLimitBucket         `protobuf:"bytes,17,rep,name=rate_limit_buckets,json=rateLimitBuckets,proto3" json:"rate_limit_buckets"`
+	RateLimitedTransfers []string                  `protobuf:"bytes,18,rep,name=rate_limited_transfers,json=rateLimitedTransfers,proto3" json:"rate_limited_transfers,omitempty"`
+	TransferQueue        []QueuedTransfer          `protobuf:"bytes,19,rep,name=transfer_queue,json=transferQueue,proto3" json:"transfer_queue"`
+	// The identifier assigned to the next queued transfer.
+	TransferQueueSequence uint64       `protobuf:"varint,20,opt,name=transfer_queue_sequence,json=transferQueueSequence,proto3" json:"transfer_queue_sequence,omitempty"`
+	PruneRetries          []PruneRetry `protobuf:"bytes,21,rep,name=prune_retries,json=pruneRetries,proto3" json:"prune_retries"`
+	DirtyAccounts         []string     `protobuf:"bytes,22,rep,name=dirty_accounts,json=dirtyAccounts,proto3" json:"dirty_accounts,omitempty"`
+	// The position of the sweep of the AutoCCTP accounts balances, if in progress.
+	SweepCursor    *SweepCursor    `protobuf:"bytes,23,opt,name=sweep_cursor,json=sweepCursor,proto3" json:"sweep_cursor,omitempty"`
+	FailedForwards []FailedForward `protobuf:"bytes,24,rep,name=failed_forwards,json=failedForwards,proto3" json:"failed_forwards"`
+	// The address of the last failed transfer checked against the fallback policy, if any.
+	FallbackSweepCursor    string            `protobuf:"bytes,25,opt,name=fallback_sweep_cursor,json=fallbackSweepCursor,proto3" json:"fallback_sweep_cursor,omitempty"`
+	OwedFees               []OwedFee         `protobuf:"bytes,26,rep,name=owed_fees,json=owedFees,proto3" json:"owed_fees"`
+	TotalTransferredAmount map[uint32]string `protobuf:"bytes,27,rep,name=total_transferred_amount,json=totalTransferredAmount,proto3" json:"total_transferred_amount,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,44 +106,358 @@ func (m *GenesisState) GetNumOfTransfers() map[uint32]uint64 {
 	return nil
 }
 
-func (m *GenesisState) GetTotalTransferred() map[uint32]uint64 {
+func (m *GenesisState) GetParams() Params {
 	if m != nil {
-		return m.TotalTransferred
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDomains() []DomainConfig {
+	if m != nil {
+		return m.Domains
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedTransfers() []FailedTransfer {
+	if m != nil {
+		return m.FailedTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferHistory() []TransferRecord {
+	if m != nil {
+		return m.TransferHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisState) GetPausedDestinationDomains() []uint32 {
+	if m != nil {
+		return m.PausedDestinationDomains
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalFees() map[uint32]string {
+	if m != nil {
+		return m.TotalFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStatsHistory() []StatsBucket {
+	if m != nil {
+		return m.StatsHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutcomeStats() map[uint32]OutcomeStats {
+	if m != nil {
+		return m.OutcomeStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAwaitingTransfers() []string {
+	if m != nil {
+		return m.AwaitingTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetResumeCursor() *ResumeCursor {
+	if m != nil {
+		return m.ResumeCursor
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenPaused() bool {
+	if m != nil {
+		return m.TokenPaused
+	}
+	return false
+}
+
+func (m *GenesisState) GetRateLimitUsage() map[uint32]RateLimitUsage {
+	if m != nil {
+		return m.RateLimitUsage
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitBuckets() []RateLimitBucket {
+	if m != nil {
+		return m.RateLimitBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitedTransfers() []string {
+	if m != nil {
+		return m.RateLimitedTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferQueue() []QueuedTransfer {
+	if m != nil {
+		return m.TransferQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferQueueSequence() uint64 {
+	if m != nil {
+		return m.TransferQueueSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPruneRetries() []PruneRetry {
+	if m != nil {
+		return m.PruneRetries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDirtyAccounts() []string {
+	if m != nil {
+		return m.DirtyAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSweepCursor() *SweepCursor {
+	if m != nil {
+		return m.SweepCursor
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedForwards() []FailedForward {
+	if m != nil {
+		return m.FailedForwards
 	}
 	return nil
 }
 
+func (m *GenesisState) GetFallbackSweepCursor() string {
+	if m != nil {
+		return m.FallbackSweepCursor
+	}
+	return ""
+}
+
+func (m *GenesisState) GetOwedFees() []OwedFee {
+	if m != nil {
+		return m.OwedFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalTransferredAmount() map[uint32]string {
+	if m != nil {
+		return m.TotalTransferredAmount
+	}
+	return nil
+}
+
+// ResumeCursor is the position from which the transfers deferred while paused are resumed.
+type ResumeCursor struct {
+	// The address of the last awaiting transfer resumed, or empty to resume from the first one.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *ResumeCursor) Reset()         { *m = ResumeCursor{} }
+func (m *ResumeCursor) String() string { return proto.CompactTextString(m) }
+func (*ResumeCursor) ProtoMessage()    {}
+func (*ResumeCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a4974f5934322b, []int{1}
+}
+func (m *ResumeCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeCursor.Merge(m, src)
+}
+func (m *ResumeCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeCursor proto.InternalMessageInfo
+
+func (m *ResumeCursor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// SweepCursor is the position from which the next sweep of the AutoCCTP accounts balances resumes.
+type SweepCursor struct {
+	// The destination domain of the last account checked.
+	DestinationDomain uint32 `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// The address of the last account checked.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *SweepCursor) Reset()         { *m = SweepCursor{} }
+func (m *SweepCursor) String() string { return proto.CompactTextString(m) }
+func (*SweepCursor) ProtoMessage()    {}
+func (*SweepCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a4974f5934322b, []int{2}
+}
+func (m *SweepCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SweepCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SweepCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SweepCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepCursor.Merge(m, src)
+}
+func (m *SweepCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *SweepCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweepCursor proto.InternalMessageInfo
+
+func (m *SweepCursor) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *SweepCursor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.autocctp.v1.GenesisState")
 	proto.RegisterMapType((map[uint32]uint64)(nil), "noble.autocctp.v1.GenesisState.NumOfAccountsEntry")
 	proto.RegisterMapType((map[uint32]uint64)(nil), "noble.autocctp.v1.GenesisState.NumOfTransfersEntry")
-	proto.RegisterMapType((map[uint32]uint64)(nil), "noble.autocctp.v1.GenesisState.TotalTransferredEntry")
+	proto.RegisterMapType((map[uint32]OutcomeStats)(nil), "noble.autocctp.v1.GenesisState.OutcomeStatsEntry")
+	proto.RegisterMapType((map[uint32]RateLimitUsage)(nil), "noble.autocctp.v1.GenesisState.RateLimitUsageEntry")
+	proto.RegisterMapType((map[uint32]string)(nil), "noble.autocctp.v1.GenesisState.TotalFeesEntry")
+	proto.RegisterMapType((map[uint32]string)(nil), "noble.autocctp.v1.GenesisState.TotalTransferredAmountEntry")
+	proto.RegisterType((*ResumeCursor)(nil), "noble.autocctp.v1.ResumeCursor")
+	proto.RegisterType((*SweepCursor)(nil), "noble.autocctp.v1.SweepCursor")
 }
 
 func init() { proto.RegisterFile("noble/autocctp/v1/genesis.proto", fileDescriptor_c3a4974f5934322b) }
 
 var fileDescriptor_c3a4974f5934322b = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0x4f, 0x2c, 0x2d, 0xc9, 0x4f, 0x4e, 0x2e, 0x29, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x2b, 0xd0,
-	0x83, 0x29, 0xd0, 0x2b, 0x33, 0x54, 0xfa, 0xce, 0xcc, 0xc5, 0xe3, 0x0e, 0x51, 0x14, 0x5c, 0x92,
-	0x58, 0x92, 0x2a, 0x14, 0xc5, 0xc5, 0x9f, 0x57, 0x9a, 0x1b, 0x9f, 0x9f, 0x16, 0x9f, 0x98, 0x9c,
-	0x9c, 0x5f, 0x9a, 0x57, 0x52, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x64, 0xa4, 0x87, 0xa1,
-	0x5b, 0x0f, 0x59, 0xa7, 0x9e, 0x5f, 0x69, 0xae, 0x7f, 0x9a, 0x23, 0x54, 0x93, 0x6b, 0x5e, 0x49,
-	0x51, 0x65, 0x10, 0x6f, 0x1e, 0xb2, 0x98, 0x50, 0x2c, 0x97, 0x00, 0xd4, 0xec, 0x92, 0xa2, 0xc4,
-	0xbc, 0xe2, 0xb4, 0xd4, 0xa2, 0x62, 0x09, 0x26, 0xb0, 0xe1, 0xc6, 0x44, 0x19, 0x1e, 0x02, 0xd3,
-	0x05, 0x31, 0x9d, 0x2f, 0x0f, 0x45, 0x50, 0x28, 0x89, 0x4b, 0xb0, 0x24, 0xbf, 0x24, 0x31, 0x07,
-	0x6e, 0x7a, 0x51, 0x6a, 0x8a, 0x04, 0x33, 0xd8, 0x7c, 0x53, 0x42, 0xe6, 0x87, 0x80, 0x34, 0x86,
-	0x20, 0xf4, 0x41, 0x6c, 0x10, 0x28, 0x41, 0x13, 0x96, 0x72, 0xe0, 0x12, 0xc2, 0xf4, 0xa7, 0x90,
-	0x00, 0x17, 0x73, 0x76, 0x6a, 0xa5, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x6f, 0x10, 0x88, 0x29, 0x24,
-	0xc2, 0xc5, 0x5a, 0x96, 0x98, 0x53, 0x9a, 0x2a, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x12, 0x04, 0xe1,
-	0x58, 0x31, 0x59, 0x30, 0x4a, 0x39, 0x72, 0x09, 0x63, 0xf1, 0x0c, 0x49, 0x46, 0x38, 0x73, 0x89,
-	0x62, 0x75, 0x2f, 0x29, 0x86, 0x38, 0xe9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
-	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
-	0x43, 0x94, 0x10, 0x3c, 0x94, 0x52, 0x52, 0xcb, 0xf4, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0x29, 0xc8, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x38, 0x33, 0xf2, 0xfb, 0x64, 0x02, 0x00,
-	0x00,
+	// 1062 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x52, 0xdb, 0x46,
+	0x14, 0x46, 0x40, 0x00, 0x1f, 0xff, 0x60, 0x2f, 0x3f, 0x59, 0x9c, 0xa9, 0x31, 0x5c, 0xf9, 0xa2,
+	0x98, 0x81, 0x4c, 0x9b, 0x4e, 0x9b, 0x4e, 0x0b, 0xa1, 0x24, 0x74, 0x3a, 0x90, 0x0a, 0xda, 0x8b,
+	0x4c, 0x33, 0xea, 0x22, 0x1d, 0xbb, 0x1a, 0x6c, 0xad, 0xb3, 0xbb, 0x82, 0xe1, 0x01, 0x7a, 0xdf,
+	0xdb, 0xbe, 0x47, 0x1e, 0x22, 0x97, 0x99, 0x5c, 0xf5, 0xaa, 0xd3, 0x81, 0x17, 0xe9, 0x68, 0xa5,
+	0xc5, 0x52, 0x2c, 0x30, 0xb9, 0xf3, 0x9e, 0xfd, 0xbe, 0xef, 0x9c, 0x3d, 0x7f, 0x32, 0xac, 0x06,
+	0xfc, 0xb4, 0x87, 0x9b, 0x2c, 0x54, 0xdc, 0x75, 0xd5, 0x60, 0xf3, 0x7c, 0x6b, 0xb3, 0x8b, 0x01,
+	0x4a, 0x5f, 0xb6, 0x07, 0x82, 0x2b, 0x4e, 0x6a, 0x1a, 0xd0, 0x36, 0x80, 0xf6, 0xf9, 0x56, 0x7d,
+	0xc5, 0xe5, 0xb2, 0xcf, 0xa5, 0xa3, 0x01, 0x9b, 0xf1, 0x21, 0x46, 0xd7, 0x17, 0xbb, 0xbc, 0xcb,
+	0x63, 0x7b, 0xf4, 0x2b, 0xb1, 0x36, 0x46, 0x9d, 0x78, 0xbc, 0xcf, 0xfc, 0xe0, 0xf6, 0xfb, 0x01,
+	0x13, 0xac, 0x6f, 0x54, 0x9b, 0xa3, 0xf7, 0x4a, 0xb0, 0x40, 0x76, 0x50, 0xc4, 0x88, 0xf5, 0xbf,
+	0x17, 0xa0, 0xf4, 0x3c, 0x8e, 0xfb, 0x58, 0x31, 0x85, 0xe4, 0x15, 0xcc, 0x07, 0x61, 0xdf, 0xe1,
+	0x1d, 0x87, 0xb9, 0x2e, 0x0f, 0x03, 0x25, 0xa9, 0xd5, 0x9c, 0x6a, 0x15, 0xb7, 0xb7, 0xdb, 0x23,
+	0x0f, 0x6a, 0xa7, 0x99, 0xed, 0xc3, 0xb0, 0x7f, 0xd4, 0xd9, 0x49, 0x48, 0x3f, 0x04, 0x4a, 0x5c,
+	0xda, 0xe5, 0x20, 0x6d, 0x23, 0xaf, 0xa1, 0x9a, 0x68, 0x9b, 0x28, 0x24, 0x9d, 0xd4, 0xe2, 0x8f,
+	0xef, 0x25, 0x7e, 0x62, 0x58, 0xb1, 0x7a, 0x25, 0xc8, 0x18, 0xc9, 0x13, 0x98, 0x89, 0x5f, 0x4f,
+	0xa7, 0x9b, 0x56, 0xab, 0xb8, 0xbd, 0x92, 0x23, 0xfa, 0x52, 0x03, 0x76, 0xa7, 0xdf, 0xfd, 0xbb,
+	0x3a, 0x61, 0x27, 0x70, 0xf2, 0x1d, 0xcc, 0xc6, 0x69, 0x95, 0xf4, 0x81, 0x0e, 0x67, 0x35, 0x87,
+	0xb9, 0xa7, 0x11, 0xcf, 0x78, 0xd0, 0xf1, 0xbb, 0x09, 0xdf, 0xb0, 0x88, 0x0d, 0xd5, 0x0e, 0xf3,
+	0x7b, 0xe8, 0xa5, 0x1e, 0x36, 0xa3, 0x95, 0xd6, 0x72, 0x94, 0xf6, 0x35, 0xd4, 0xc4, 0x9d, 0x68,
+	0xcd, 0x77, 0x32, 0x56, 0xad, 0x69, 0xc4, 0x9c, 0x3f, 0x7c, 0xa9, 0xb8, 0xb8, 0xa4, 0xb3, 0xb7,
+	0x6a, 0x1a, 0x9e, 0x8d, 0x2e, 0x17, 0x9e, 0xd1, 0x34, 0x02, 0x2f, 0x62, 0x3e, 0x59, 0x8e, 0x32,
+	0x14, 0x4a, 0xf4, 0xe8, 0x5c, 0xd3, 0x6a, 0xcd, 0xd9, 0xc9, 0x89, 0x3c, 0x85, 0x7a, 0xfc, 0xcb,
+	0xf1, 0x50, 0x2a, 0x3f, 0x60, 0xca, 0xe7, 0x81, 0x63, 0x72, 0x52, 0x68, 0x4e, 0xb5, 0xca, 0x36,
+	0x8d, 0x11, 0x7b, 0x43, 0xc0, 0x5e, 0xf2, 0xfa, 0xd7, 0x00, 0x8a, 0x2b, 0xd6, 0x73, 0x3a, 0x88,
+	0x92, 0x82, 0x8e, 0xb1, 0x3d, 0xae, 0xa0, 0x27, 0x11, 0x63, 0x1f, 0x31, 0xae, 0xe5, 0x6e, 0xe5,
+	0xc3, 0xdb, 0x0d, 0x48, 0x26, 0xe2, 0x20, 0x50, 0x76, 0x41, 0x99, 0x7b, 0x72, 0x00, 0x65, 0xa9,
+	0x98, 0x92, 0x37, 0x59, 0x28, 0x6a, 0x0f, 0x8d, 0x1c, 0x0f, 0x91, 0xb4, 0xdc, 0x0d, 0xdd, 0x33,
+	0x54, 0x49, 0x0a, 0x4a, 0x9a, 0x6a, 0xde, 0xff, 0x1b, 0x94, 0x79, 0xa8, 0x5c, 0xde, 0x47, 0x47,
+	0xdb, 0x69, 0x49, 0x4b, 0x6d, 0x8d, 0x0b, 0xf6, 0x28, 0x26, 0x69, 0xf9, 0x38, 0xde, 0x44, 0x9d,
+	0xa7, 0x2e, 0xc8, 0x73, 0x20, 0xec, 0x82, 0xf9, 0xca, 0x0f, 0xba, 0xa9, 0x3e, 0x28, 0x37, 0xa7,
+	0x5a, 0x85, 0x5d, 0xfa, 0xe1, 0xed, 0xc6, 0x62, 0xf2, 0xbe, 0x1d, 0xcf, 0x13, 0x28, 0xe5, 0xb1,
+	0x12, 0x7e, 0xd0, 0xb5, 0x6b, 0x86, 0x33, 0x2c, 0xfd, 0x1e, 0x94, 0x05, 0xca, 0xb0, 0x8f, 0x8e,
+	0x1b, 0x0a, 0xc9, 0x05, 0xad, 0xe8, 0x7e, 0xce, 0xeb, 0x4a, 0x5b, 0xe3, 0x9e, 0x69, 0x98, 0x5d,
+	0x12, 0xa9, 0x13, 0x59, 0x83, 0x92, 0xe2, 0x67, 0x18, 0x38, 0x49, 0xc9, 0xe7, 0x75, 0xc9, 0x8b,
+	0xda, 0xf6, 0x32, 0xae, 0xbb, 0x0b, 0x55, 0xc1, 0x14, 0x3a, 0x3d, 0xbf, 0xef, 0x2b, 0x27, 0x94,
+	0xac, 0x8b, 0xb4, 0x7a, 0xbf, 0x81, 0xb4, 0x99, 0xc2, 0x9f, 0x22, 0xda, 0x2f, 0x11, 0x2b, 0x9d,
+	0x94, 0x8a, 0xc8, 0x5c, 0x91, 0x5f, 0x81, 0xa4, 0x9c, 0x9c, 0xea, 0xea, 0x48, 0x5a, 0xd3, 0x6e,
+	0xd6, 0xf3, 0x9e, 0x64, 0xe8, 0x99, 0x42, 0x56, 0x45, 0xd6, 0x2c, 0xc9, 0x21, 0x2c, 0x0f, 0x75,
+	0x33, 0xa3, 0x47, 0xc6, 0xa4, 0x7c, 0xf1, 0x46, 0x2b, 0x3d, 0x70, 0x87, 0x50, 0xb9, 0x19, 0xb8,
+	0x37, 0x21, 0x86, 0x48, 0x17, 0x6e, 0x1d, 0xb7, 0x9f, 0xa3, 0xfb, 0x8f, 0x47, 0xb8, 0x6c, 0xe8,
+	0xfa, 0x96, 0x7c, 0x09, 0x0f, 0xb3, 0x7a, 0x8e, 0xc4, 0x37, 0x21, 0x06, 0x2e, 0xd2, 0xc5, 0xa6,
+	0xd5, 0x9a, 0xb6, 0x97, 0x32, 0xf8, 0xe3, 0xe4, 0x92, 0xbc, 0x80, 0xf2, 0x40, 0x84, 0x01, 0x3a,
+	0x02, 0x95, 0xf0, 0x51, 0xd2, 0x25, 0x1d, 0xc6, 0x67, 0x79, 0xdb, 0x2c, 0xc2, 0xd9, 0x98, 0x6a,
+	0xc8, 0x81, 0xb1, 0xf8, 0x18, 0xed, 0xb5, 0x8a, 0xe7, 0x0b, 0x75, 0x39, 0x5c, 0xe5, 0xcb, 0x63,
+	0x32, 0x53, 0xd6, 0xf8, 0x9b, 0x85, 0xbd, 0x03, 0x25, 0x79, 0x81, 0x38, 0x30, 0x7d, 0xf8, 0x50,
+	0xf7, 0x61, 0xee, 0xe4, 0x45, 0xb0, 0xa4, 0x0d, 0x8b, 0x72, 0x78, 0x20, 0x47, 0x90, 0x6c, 0x36,
+	0xa7, 0xc3, 0xc5, 0x05, 0x13, 0x9e, 0xa4, 0x54, 0xbf, 0xa7, 0x79, 0xeb, 0x66, 0xdc, 0x8f, 0x81,
+	0xa6, 0x9d, 0x3a, 0x69, 0xa3, 0x24, 0xdb, 0xb0, 0xd4, 0x61, 0xbd, 0xde, 0x29, 0x73, 0xcf, 0x9c,
+	0x4c, 0x70, 0x2b, 0x4d, 0xab, 0x55, 0xb0, 0x17, 0xcc, 0x65, 0x2a, 0x22, 0xf2, 0x2d, 0x14, 0xf8,
+	0x45, 0x14, 0x42, 0xb4, 0xa0, 0xea, 0xda, 0x7d, 0x3d, 0xc7, 0xfd, 0xd1, 0x05, 0x7a, 0xfb, 0x88,
+	0x89, 0xe3, 0x39, 0x1e, 0x1f, 0x25, 0xf9, 0xd3, 0x02, 0x1a, 0x6f, 0x38, 0x53, 0x31, 0x81, 0x9e,
+	0xc3, 0xfa, 0x51, 0x92, 0xe8, 0x23, 0x2d, 0xf7, 0xcd, 0xbd, 0xf6, 0xdd, 0xc9, 0x90, 0xbe, 0xa3,
+	0xd9, 0xf9, 0xcb, 0x6f, 0x59, 0xe5, 0x82, 0xeb, 0xdf, 0x03, 0x19, 0xfd, 0xc8, 0x92, 0x2a, 0x4c,
+	0x9d, 0xe1, 0x25, 0xb5, 0x9a, 0x56, 0xab, 0x6c, 0x47, 0x3f, 0xc9, 0x22, 0x3c, 0x38, 0x67, 0xbd,
+	0x10, 0xe9, 0xa4, 0xee, 0xb3, 0xf8, 0xf0, 0xf5, 0xe4, 0x57, 0x56, 0x7d, 0x07, 0x16, 0x72, 0xbe,
+	0xa4, 0x9f, 0x24, 0xf1, 0x14, 0x2a, 0xd9, 0xdd, 0x3d, 0x8e, 0x5d, 0x48, 0xb3, 0x7f, 0x87, 0xda,
+	0xc8, 0x32, 0xcd, 0x11, 0xf8, 0x22, 0x2d, 0x90, 0xbf, 0xf9, 0xd2, 0x32, 0x69, 0x0f, 0x1e, 0x2c,
+	0xe4, 0xec, 0xa6, 0x1c, 0x1f, 0x4f, 0xb2, 0x3e, 0xd6, 0xee, 0x5a, 0x45, 0x5a, 0x28, 0xed, 0xe5,
+	0x00, 0x1e, 0xdd, 0x51, 0xd1, 0x4f, 0x49, 0xc9, 0x8f, 0xd3, 0x73, 0x53, 0xd5, 0x69, 0xbb, 0x36,
+	0xd2, 0x60, 0xeb, 0x2d, 0x28, 0xa5, 0xd7, 0x3b, 0xa1, 0x30, 0xcb, 0xe2, 0x69, 0xd5, 0xc2, 0x05,
+	0xdb, 0x1c, 0xd7, 0x07, 0x50, 0x4c, 0xb7, 0xfb, 0x06, 0x90, 0xd1, 0xef, 0x78, 0x12, 0x4c, 0xcd,
+	0xfb, 0xf8, 0x03, 0x4e, 0xb6, 0x87, 0xba, 0x3a, 0xb8, 0x3b, 0xf6, 0x83, 0x01, 0xee, 0x7e, 0xfe,
+	0xee, 0xaa, 0x61, 0xbd, 0xbf, 0x6a, 0x58, 0xff, 0x5d, 0x35, 0xac, 0xbf, 0xae, 0x1b, 0x13, 0xef,
+	0xaf, 0x1b, 0x13, 0xff, 0x5c, 0x37, 0x26, 0x5e, 0x91, 0x9b, 0x04, 0x7a, 0x78, 0xbe, 0xa9, 0x2e,
+	0x07, 0x28, 0x4f, 0x67, 0xf4, 0x9f, 0xcd, 0xc7, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x83,
+	0x40, 0x0f, 0x35, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,64 +480,453 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.TotalTransferred) > 0 {
-		for k := range m.TotalTransferred {
-			v := m.TotalTransferred[k]
+	if len(m.TotalTransferredAmount) > 0 {
+		for k := range m.TotalTransferredAmount {
+			v := m.TotalTransferredAmount[k]
 			baseI := i
-			i = encodeVarintGenesis(dAtA, i, uint64(v))
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 			i = encodeVarintGenesis(dAtA, i, uint64(k))
 			i--
 			dAtA[i] = 0x8
 			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.NumOfTransfers) > 0 {
-		for k := range m.NumOfTransfers {
-			v := m.NumOfTransfers[k]
+	if len(m.OwedFees) > 0 {
+		for iNdEx := len(m.OwedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.FallbackSweepCursor) > 0 {
+		i -= len(m.FallbackSweepCursor)
+		copy(dAtA[i:], m.FallbackSweepCursor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FallbackSweepCursor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.FailedForwards) > 0 {
+		for iNdEx := len(m.FailedForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.SweepCursor != nil {
+		{
+			size, err := m.SweepCursor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.DirtyAccounts) > 0 {
+		for iNdEx := len(m.DirtyAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DirtyAccounts[iNdEx])
+			copy(dAtA[i:], m.DirtyAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DirtyAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.PruneRetries) > 0 {
+		for iNdEx := len(m.PruneRetries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruneRetries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.TransferQueueSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TransferQueueSequence))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.TransferQueue) > 0 {
+		for iNdEx := len(m.TransferQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.RateLimitedTransfers) > 0 {
+		for iNdEx := len(m.RateLimitedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RateLimitedTransfers[iNdEx])
+			copy(dAtA[i:], m.RateLimitedTransfers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RateLimitedTransfers[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.RateLimitBuckets) > 0 {
+		for iNdEx := len(m.RateLimitBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.RateLimitUsage) > 0 {
+		for k := range m.RateLimitUsage {
+			v := m.RateLimitUsage[k]
 			baseI := i
-			i = encodeVarintGenesis(dAtA, i, uint64(v))
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 			i = encodeVarintGenesis(dAtA, i, uint64(k))
 			i--
 			dAtA[i] = 0x8
 			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.NumOfAccounts) > 0 {
-		for k := range m.NumOfAccounts {
-			v := m.NumOfAccounts[k]
+	if m.TokenPaused {
+		i--
+		if m.TokenPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ResumeCursor != nil {
+		{
+			size, err := m.ResumeCursor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.AwaitingTransfers) > 0 {
+		for iNdEx := len(m.AwaitingTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AwaitingTransfers[iNdEx])
+			copy(dAtA[i:], m.AwaitingTransfers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AwaitingTransfers[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.OutcomeStats) > 0 {
+		for k := range m.OutcomeStats {
+			v := m.OutcomeStats[k]
 			baseI := i
-			i = encodeVarintGenesis(dAtA, i, uint64(v))
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 			i = encodeVarintGenesis(dAtA, i, uint64(k))
 			i--
 			dAtA[i] = 0x8
 			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x62
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.StatsHistory) > 0 {
+		for iNdEx := len(m.StatsHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StatsHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if len(m.TotalFees) > 0 {
+		for k := range m.TotalFees {
+			v := m.TotalFees[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintGenesis(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PausedDestinationDomains) > 0 {
+		dAtA6 := make([]byte, len(m.PausedDestinationDomains)*10)
+		var j5 int
+		for _, num := range m.PausedDestinationDomains {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGenesis(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TransferHistory) > 0 {
+		for iNdEx := len(m.TransferHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FailedTransfers) > 0 {
+		for iNdEx := len(m.FailedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Domains) > 0 {
+		for iNdEx := len(m.Domains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Domains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.NumOfTransfers) > 0 {
+		for k := range m.NumOfTransfers {
+			v := m.NumOfTransfers[k]
+			baseI := i
+			i = encodeVarintGenesis(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintGenesis(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NumOfAccounts) > 0 {
+		for k := range m.NumOfAccounts {
+			v := m.NumOfAccounts[k]
+			baseI := i
+			i = encodeVarintGenesis(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintGenesis(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResumeCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SweepCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SweepCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SweepCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
@@ -217,14 +950,171 @@ func (m *GenesisState) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
 		}
 	}
-	if len(m.TotalTransferred) > 0 {
-		for k, v := range m.TotalTransferred {
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Domains) > 0 {
+		for _, e := range m.Domains {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedTransfers) > 0 {
+		for _, e := range m.FailedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferHistory) > 0 {
+		for _, e := range m.TransferHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.PausedDestinationDomains) > 0 {
+		l = 0
+		for _, e := range m.PausedDestinationDomains {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.TotalFees) > 0 {
+		for k, v := range m.TotalFees {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovGenesis(uint64(k)) + 1 + len(v) + sovGenesis(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.StatsHistory) > 0 {
+		for _, e := range m.StatsHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutcomeStats) > 0 {
+		for k, v := range m.OutcomeStats {
 			_ = k
 			_ = v
-			mapEntrySize := 1 + sovGenesis(uint64(k)) + 1 + sovGenesis(uint64(v))
+			l = v.Size()
+			mapEntrySize := 1 + sovGenesis(uint64(k)) + 1 + l + sovGenesis(uint64(l))
 			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
 		}
 	}
+	if len(m.AwaitingTransfers) > 0 {
+		for _, s := range m.AwaitingTransfers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ResumeCursor != nil {
+		l = m.ResumeCursor.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TokenPaused {
+		n += 2
+	}
+	if len(m.RateLimitUsage) > 0 {
+		for k, v := range m.RateLimitUsage {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovGenesis(uint64(k)) + 1 + l + sovGenesis(uint64(l))
+			n += mapEntrySize + 2 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RateLimitBuckets) > 0 {
+		for _, e := range m.RateLimitBuckets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitedTransfers) > 0 {
+		for _, s := range m.RateLimitedTransfers {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferQueue) > 0 {
+		for _, e := range m.TransferQueue {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TransferQueueSequence != 0 {
+		n += 2 + sovGenesis(uint64(m.TransferQueueSequence))
+	}
+	if len(m.PruneRetries) > 0 {
+		for _, e := range m.PruneRetries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DirtyAccounts) > 0 {
+		for _, s := range m.DirtyAccounts {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SweepCursor != nil {
+		l = m.SweepCursor.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FailedForwards) > 0 {
+		for _, e := range m.FailedForwards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.FallbackSweepCursor)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.OwedFees) > 0 {
+		for _, e := range m.OwedFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TotalTransferredAmount) > 0 {
+		for k, v := range m.TotalTransferredAmount {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovGenesis(uint64(k)) + 1 + len(v) + sovGenesis(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ResumeCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *SweepCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		n += 1 + sovGenesis(uint64(m.DestinationDomain))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -253,19 +1143,798 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NumOfAccounts == nil {
+				m.NumOfAccounts = make(map[uint32]uint64)
+			}
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NumOfAccounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NumOfTransfers == nil {
+				m.NumOfTransfers = make(map[uint32]uint64)
+			}
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NumOfTransfers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domains = append(m.Domains, DomainConfig{})
+			if err := m.Domains[len(m.Domains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedTransfers = append(m.FailedTransfers, FailedTransfer{})
+			if err := m.FailedTransfers[len(m.FailedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferHistory = append(m.TransferHistory, TransferRecord{})
+			if err := m.TransferHistory[len(m.TransferHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 9:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedDestinationDomains = append(m.PausedDestinationDomains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PausedDestinationDomains) == 0 {
+					m.PausedDestinationDomains = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedDestinationDomains = append(m.PausedDestinationDomains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedDestinationDomains", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalFees == nil {
+				m.TotalFees = make(map[uint32]string)
+			}
+			var mapkey uint32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TotalFees[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatsHistory = append(m.StatsHistory, StatsBucket{})
+			if err := m.StatsHistory[len(m.StatsHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutcomeStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutcomeStats == nil {
+				m.OutcomeStats = make(map[uint32]OutcomeStats)
+			}
+			var mapkey uint32
+			mapvalue := &OutcomeStats{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &OutcomeStats{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.OutcomeStats[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwaitingTransfers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AwaitingTransfers = append(m.AwaitingTransfers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeCursor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResumeCursor == nil {
+				m.ResumeCursor = &ResumeCursor{}
+			}
+			if err := m.ResumeCursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TokenPaused = bool(v != 0)
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitUsage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -292,11 +1961,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NumOfAccounts == nil {
-				m.NumOfAccounts = make(map[uint32]uint64)
+			if m.RateLimitUsage == nil {
+				m.RateLimitUsage = make(map[uint32]RateLimitUsage)
 			}
 			var mapkey uint32
-			var mapvalue uint64
+			mapvalue := &RateLimitUsage{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -331,6 +2000,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 						}
 					}
 				} else if fieldNum == 2 {
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenesis
@@ -340,11 +2010,26 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RateLimitUsage{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -360,11 +2045,298 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.NumOfAccounts[mapkey] = mapvalue
+			m.RateLimitUsage[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitBuckets = append(m.RateLimitBuckets, RateLimitBucket{})
+			if err := m.RateLimitBuckets[len(m.RateLimitBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitedTransfers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitedTransfers = append(m.RateLimitedTransfers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferQueue = append(m.TransferQueue, QueuedTransfer{})
+			if err := m.TransferQueue[len(m.TransferQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferQueueSequence", wireType)
+			}
+			m.TransferQueueSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferQueueSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneRetries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruneRetries = append(m.PruneRetries, PruneRetry{})
+			if err := m.PruneRetries[len(m.PruneRetries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirtyAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DirtyAccounts = append(m.DirtyAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweepCursor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SweepCursor == nil {
+				m.SweepCursor = &SweepCursor{}
+			}
+			if err := m.SweepCursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedForwards = append(m.FailedForwards, FailedForward{})
+			if err := m.FailedForwards[len(m.FailedForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackSweepCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackSweepCursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumOfTransfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwedFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -391,79 +2363,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NumOfTransfers == nil {
-				m.NumOfTransfers = make(map[uint32]uint64)
-			}
-			var mapkey uint32
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenesis(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.OwedFees = append(m.OwedFees, OwedFee{})
+			if err := m.OwedFees[len(m.OwedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NumOfTransfers[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferred", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferredAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -490,11 +2397,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TotalTransferred == nil {
-				m.TotalTransferred = make(map[uint32]uint64)
+			if m.TotalTransferredAmount == nil {
+				m.TotalTransferredAmount = make(map[uint32]string)
 			}
 			var mapkey uint32
-			var mapvalue uint64
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -529,6 +2436,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 						}
 					}
 				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenesis
@@ -538,11 +2446,24 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -558,7 +2479,190 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.TotalTransferred[mapkey] = mapvalue
+			m.TotalTransferredAmount[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SweepCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SweepCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SweepCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21,10 +21,14 @@
 package types_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	"autocctp.dev/types"
 )
 
@@ -39,6 +43,264 @@ func TestGenesisState_Validate(t *testing.T) {
 			genesisModifier: func(g *types.GenesisState) {},
 			errContains:     "",
 		},
+		{
+			name: "fails when params are not set",
+			genesisModifier: func(g *types.GenesisState) {
+				g.Params = types.Params{}
+			},
+			errContains: "invalid params",
+		},
+		{
+			name: "fails when minimum transfer amount is negative",
+			genesisModifier: func(g *types.GenesisState) {
+				g.Params = types.NewParams(math.NewInt(-1), types.DefaultMaxTransferAttempts, types.DefaultRetryBaseDelay, types.DefaultRetryMaxDelay, false, types.DefaultMaxTransferHistory)
+			},
+			errContains: "minimum transfer amount must be positive",
+		},
+		{
+			name: "fails when max transfer attempts is zero",
+			genesisModifier: func(g *types.GenesisState) {
+				g.Params.MaxTransferAttempts = 0
+			},
+			errContains: "max transfer attempts must be positive",
+		},
+		{
+			name: "fails when retry max delay is lower than the base delay",
+			genesisModifier: func(g *types.GenesisState) {
+				g.Params = types.NewParams(math.NewInt(1), types.DefaultMaxTransferAttempts, 10, 5, false, types.DefaultMaxTransferHistory)
+			},
+			errContains: "retry max delay cannot be lower than the retry base delay",
+		},
+		{
+			name: "fails when the fee recipient is not valid",
+			genesisModifier: func(g *types.GenesisState) {
+				g.Params.FeeRecipient = "noble1invalid"
+			},
+			errContains: "invalid fee recipient",
+		},
+		{
+			name: "fails when a transfer fee is defined twice",
+			genesisModifier: func(g *types.GenesisState) {
+				g.Params.TransferFees = []types.TransferFee{{DestinationDomain: 0}, {DestinationDomain: 0}}
+			},
+			errContains: "defined more than once",
+		},
+		{
+			name: "fails when a transfer fee exceeds the basis points",
+			genesisModifier: func(g *types.GenesisState) {
+				g.Params.TransferFees = []types.TransferFee{{DestinationDomain: 0, BasisPoints: types.MaxBasisPoints + 1}}
+			},
+			errContains: "basis points cannot exceed",
+		},
+		{
+			name: "fails when a transfer fee has a negative flat amount",
+			genesisModifier: func(g *types.GenesisState) {
+				g.Params.TransferFees = []types.TransferFee{{DestinationDomain: 0, FlatAmount: math.NewInt(-1)}}
+			},
+			errContains: "flat amount cannot be negative",
+		},
+		{
+			name: "fails when the stats bucket duration is negative",
+			genesisModifier: func(g *types.GenesisState) {
+				g.Params.StatsBucketDuration = -time.Hour
+			},
+			errContains: "stats bucket duration cannot be negative",
+		},
+		{
+			name: "fails when a stats bucket is registered twice",
+			genesisModifier: func(g *types.GenesisState) {
+				bucket := types.StatsBucket{StartTime: time.Unix(0, 0).UTC(), Transfers: 1, Amount: math.NewInt(100)}
+				g.StatsHistory = []types.StatsBucket{bucket, bucket}
+			},
+			errContains: "registered more than once",
+		},
+		{
+			name: "fails when a stats bucket has no transfers",
+			genesisModifier: func(g *types.GenesisState) {
+				g.StatsHistory = []types.StatsBucket{{StartTime: time.Unix(0, 0).UTC(), Amount: math.NewInt(100)}}
+			},
+			errContains: "transfers must be positive",
+		},
+		{
+			name: "fails when a rate limit bucket is registered twice",
+			genesisModifier: func(g *types.GenesisState) {
+				bucket := types.RateLimitBucket{Height: 1, Usage: types.NewRateLimitUsage(1, math.NewInt(100))}
+				g.RateLimitBuckets = []types.RateLimitBucket{bucket, bucket}
+			},
+			errContains: "registered more than once",
+		},
+		{
+			name: "fails when the rate limit usage is negative",
+			genesisModifier: func(g *types.GenesisState) {
+				g.RateLimitUsage = map[uint32]types.RateLimitUsage{0: types.NewRateLimitUsage(1, math.NewInt(-1))}
+			},
+			errContains: "amount cannot be negative",
+		},
+		{
+			name: "fails when a queued transfer is not below the sequence",
+			genesisModifier: func(g *types.GenesisState) {
+				g.TransferQueue = []types.QueuedTransfer{{Id: 1, Address: "noble1g7gxa90tjrxm7vwzqc407s34faseku7g4pdvse"}}
+				g.TransferQueueSequence = 1
+			},
+			errContains: "is not below the transfer queue sequence",
+		},
+		{
+			name: "fails when a prune retry is registered twice",
+			genesisModifier: func(g *types.GenesisState) {
+				retry := types.PruneRetry{Address: "noble1g7gxa90tjrxm7vwzqc407s34faseku7g4pdvse", Height: 1, Attempts: 1}
+				g.PruneRetries = []types.PruneRetry{retry, retry}
+			},
+			errContains: "registered more than once",
+		},
+		{
+			name: "fails when the sweep cursor address is invalid",
+			genesisModifier: func(g *types.GenesisState) {
+				g.SweepCursor = &types.SweepCursor{Address: "invalid"}
+			},
+			errContains: "invalid sweep cursor address",
+		},
+		{
+			name: "fails when a stats bucket is not aligned to the bucket duration",
+			genesisModifier: func(g *types.GenesisState) {
+				g.StatsHistory = []types.StatsBucket{{StartTime: time.Unix(0, 0).Add(time.Hour).UTC(), Transfers: 1, Amount: math.NewInt(100)}}
+			},
+			errContains: "is not aligned to the bucket duration",
+		},
+		{
+			name: "fails when a failed forward has no attempts",
+			genesisModifier: func(g *types.GenesisState) {
+				g.FailedForwards = []types.FailedForward{{Address: "noble1g7gxa90tjrxm7vwzqc407s34faseku7g4pdvse"}}
+			},
+			errContains: "has no attempts",
+		},
+		{
+			name: "fails when an owed fee exceeds its assessed amount",
+			genesisModifier: func(g *types.GenesisState) {
+				g.OwedFees = []types.OwedFee{{Address: "noble1g7gxa90tjrxm7vwzqc407s34faseku7g4pdvse", Amount: math.NewInt(100), AssessedAmount: math.NewInt(10)}}
+			},
+			errContains: "cannot exceed its assessed amount",
+		},
+		{
+			name: "valid when outcome stats are registered",
+			genesisModifier: func(g *types.GenesisState) {
+				g.OutcomeStats = map[uint32]types.OutcomeStats{
+					0: {FailedTransfers: 2, FallbackClears: 1, FallbackAmount: math.NewInt(100), SignedRegistrations: 1},
+				}
+			},
+		},
+		{
+			name: "fails when the outcome stats have a negative fallback amount",
+			genesisModifier: func(g *types.GenesisState) {
+				g.OutcomeStats = map[uint32]types.OutcomeStats{0: {FallbackClears: 1, FallbackAmount: math.NewInt(-1)}}
+			},
+			errContains: "fallback amount cannot be negative",
+		},
+		{
+			name: "fails when the outcome stats have a fallback amount without fallback clears",
+			genesisModifier: func(g *types.GenesisState) {
+				g.OutcomeStats = map[uint32]types.OutcomeStats{0: {FallbackAmount: math.NewInt(100), ManualRetries: 1}}
+			},
+			errContains: "without fallback clears",
+		},
+		{
+			name: "fails when the outcome stats are empty",
+			genesisModifier: func(g *types.GenesisState) {
+				g.OutcomeStats = map[uint32]types.OutcomeStats{0: types.NewOutcomeStats()}
+			},
+			errContains: "outcome stats cannot be empty",
+		},
+		{
+			name: "fails when total fees are registered for a domain without transfers",
+			genesisModifier: func(g *types.GenesisState) {
+				g.TotalFees = map[uint32]string{0: "10"}
+			},
+			errContains: "without transfers",
+		},
+		{
+			name: "fails when a failed transfer is registered twice",
+			genesisModifier: func(g *types.GenesisState) {
+				failedTransfer := types.NewFailedTransfer(
+					"noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za", math.NewInt(1_000_000), errors.New("error"), 1, 1, 11,
+				)
+				g.FailedTransfers = append(g.FailedTransfers, failedTransfer, failedTransfer)
+			},
+			errContains: "registered more than once",
+		},
+		{
+			name: "fails when a failed transfer has no attempts",
+			genesisModifier: func(g *types.GenesisState) {
+				g.FailedTransfers = append(g.FailedTransfers, types.NewFailedTransfer(
+					"noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za", math.NewInt(1_000_000), errors.New("error"), 1, 0, 11,
+				))
+			},
+			errContains: "attempts must be positive",
+		},
+		{
+			name: "pass with a valid failed transfer",
+			genesisModifier: func(g *types.GenesisState) {
+				g.FailedTransfers = append(g.FailedTransfers, types.NewFailedTransfer(
+					"noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za", math.NewInt(1_000_000), errors.New("error"), 1, 1, 11,
+				))
+			},
+			errContains: "",
+		},
+		{
+			name: "fails when a destination domain is registered twice",
+			genesisModifier: func(g *types.GenesisState) {
+				g.Domains = append(g.Domains, g.Domains[0])
+			},
+			errContains: "registered more than once",
+		},
+		{
+			name: "fails when noble is registered as destination domain",
+			genesisModifier: func(g *types.GenesisState) {
+				g.Domains = append(g.Domains, types.NewDomainConfig(types.NOBLE, "Noble", types.AddressEncodingBech32))
+			},
+			errContains: "cannot be source domain",
+		},
+		{
+			name: "fails when a destination domain has no address encoding",
+			genesisModifier: func(g *types.GenesisState) {
+				g.Domains = append(g.Domains, types.NewDomainConfig(types.UNICHAIN+1, "Unknown", types.AddressEncodingUnspecified))
+			},
+			errContains: "address encoding",
+		},
+		{
+			name: "fails when a paused destination domain is not registered",
+			genesisModifier: func(g *types.GenesisState) {
+				g.PausedDestinationDomains = []uint32{uint32(types.UNICHAIN + 1)}
+			},
+			errContains: "is not registered",
+		},
+		{
+			name: "fails when a destination domain is paused twice",
+			genesisModifier: func(g *types.GenesisState) {
+				g.PausedDestinationDomains = []uint32{uint32(types.ETHEREUM), uint32(types.ETHEREUM)}
+			},
+			errContains: "paused more than once",
+		},
+		{
+			name: "fails when a transfer record has no outcome",
+			genesisModifier: func(g *types.GenesisState) {
+				g.TransferHistory = append(g.TransferHistory, types.TransferRecord{
+					Address: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+					Amount:  math.NewInt(1_000_000),
+				})
+			},
+			errContains: "invalid outcome",
+		},
+		{
+			name: "fails when a transfer record is registered twice",
+			genesisModifier: func(g *types.GenesisState) {
+				record := types.TransferRecord{
+					Address: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+					Amount:  math.NewInt(1_000_000),
+					Outcome: types.TransferOutcomeExecuted,
+				}
+				g.TransferHistory = append(g.TransferHistory, record, record)
+			},
+			errContains: "registered more than once",
+		},
 		{
 			name: "fails when num of transfers keys > total transferred keys",
 			genesisModifier: func(g *types.GenesisState) {
@@ -50,7 +312,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			name: "fails when num of transfers keys < total transferred keys",
 			genesisModifier: func(g *types.GenesisState) {
 				g.NumOfTransfers = map[uint32]uint64{0: 10}
-				g.TotalTransferred = map[uint32]uint64{0: 10, 1: 10}
+				g.TotalTransferredAmount = map[uint32]string{0: "10", 1: "10"}
 			},
 			errContains: "should have the same number of stored destinations",
 		},
@@ -59,7 +321,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			genesisModifier: func(g *types.GenesisState) {
 				g.NumOfAccounts = map[uint32]uint64{0: 10}
 				g.NumOfTransfers = map[uint32]uint64{0: 10, 1: 10}
-				g.TotalTransferred = map[uint32]uint64{0: 10, 1: 10}
+				g.TotalTransferredAmount = map[uint32]string{0: "10", 1: "10"}
 			},
 			errContains: "domains without accounts",
 		},
@@ -68,16 +330,53 @@ func TestGenesisState_Validate(t *testing.T) {
 			genesisModifier: func(g *types.GenesisState) {
 				g.NumOfAccounts = map[uint32]uint64{0: 10}
 				g.NumOfTransfers = map[uint32]uint64{0: 10}
-				g.TotalTransferred = map[uint32]uint64{0: 0}
+				g.TotalTransferredAmount = map[uint32]string{0: "0"}
 			},
 			errContains: "trying to register 0 total transferred",
 		},
+		{
+			name: "fails when total transferred is not an integer",
+			genesisModifier: func(g *types.GenesisState) {
+				g.NumOfAccounts = map[uint32]uint64{0: 10}
+				g.NumOfTransfers = map[uint32]uint64{0: 10}
+				g.TotalTransferredAmount = map[uint32]string{0: "1.5"}
+			},
+			errContains: "invalid total transferred",
+		},
+		{
+			name: "fails when total transferred is negative",
+			genesisModifier: func(g *types.GenesisState) {
+				g.NumOfAccounts = map[uint32]uint64{0: 10}
+				g.NumOfTransfers = map[uint32]uint64{0: 10}
+				g.TotalTransferredAmount = map[uint32]string{0: "-10"}
+			},
+			errContains: "invalid total transferred",
+		},
+		{
+			name: "valid when total transferred exceeds uint64",
+			genesisModifier: func(g *types.GenesisState) {
+				g.NumOfAccounts = map[uint32]uint64{0: 10}
+				g.NumOfTransfers = map[uint32]uint64{0: 10}
+				g.TotalTransferredAmount = map[uint32]string{0: "18446744073709551616"}
+				g.TotalFees = map[uint32]string{0: "18446744073709551616"}
+			},
+		},
+		{
+			name: "fails when total fees are not an integer",
+			genesisModifier: func(g *types.GenesisState) {
+				g.NumOfAccounts = map[uint32]uint64{0: 10}
+				g.NumOfTransfers = map[uint32]uint64{0: 10}
+				g.TotalTransferredAmount = map[uint32]string{0: "10"}
+				g.TotalFees = map[uint32]string{0: "ten"}
+			},
+			errContains: "invalid total fees",
+		},
 		{
 			name: "fails when there are total transferred but not num of transfers",
 			genesisModifier: func(g *types.GenesisState) {
 				g.NumOfAccounts = map[uint32]uint64{0: 10}
 				g.NumOfTransfers = map[uint32]uint64{1: 10}
-				g.TotalTransferred = map[uint32]uint64{0: 10}
+				g.TotalTransferredAmount = map[uint32]string{0: "10"}
 			},
 			errContains: "but not in num of transfers",
 		},
@@ -86,7 +385,7 @@ func TestGenesisState_Validate(t *testing.T) {
 			genesisModifier: func(g *types.GenesisState) {
 				g.NumOfAccounts = map[uint32]uint64{0: 10}
 				g.NumOfTransfers = map[uint32]uint64{0: 0}
-				g.TotalTransferred = map[uint32]uint64{0: 10}
+				g.TotalTransferredAmount = map[uint32]string{0: "10"}
 			},
 			errContains: "without transfers",
 		},
@@ -95,18 +394,18 @@ func TestGenesisState_Validate(t *testing.T) {
 			genesisModifier: func(g *types.GenesisState) {
 				g.NumOfAccounts = map[uint32]uint64{1: 10}
 				g.NumOfTransfers = map[uint32]uint64{0: 10}
-				g.TotalTransferred = map[uint32]uint64{0: 10}
+				g.TotalTransferredAmount = map[uint32]string{0: "10"}
 			},
 			errContains: "without registered accounts",
 		},
 		{
-			name: "fails when there are transfers for domain with zero accounts",
+			name: "pass when there are transfers for domain with zero accounts",
 			genesisModifier: func(g *types.GenesisState) {
 				g.NumOfAccounts = map[uint32]uint64{0: 0}
 				g.NumOfTransfers = map[uint32]uint64{0: 10}
-				g.TotalTransferred = map[uint32]uint64{0: 10}
+				g.TotalTransferredAmount = map[uint32]string{0: "10"}
 			},
-			errContains: "without registered accounts",
+			errContains: "",
 		},
 	}
 
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AutoCCTPHooks defines the hooks other modules can implement to react to the lifecycle of
+// the AutoCCTP accounts and transfers.
+type AutoCCTPHooks interface {
+	// AfterAccountRegistered is called after an AutoCCTP account is registered.
+	AfterAccountRegistered(ctx context.Context, account Account) error
+	// BeforeTransferExecuted is called before the balance of an AutoCCTP account is
+	// transferred via CCTP. Returning an error vetoes the transfer, which is deferred
+	// without being recorded as failed.
+	BeforeTransferExecuted(ctx context.Context, account Account, coin sdk.Coin) error
+	// AfterTransferExecuted is called after a CCTP transfer is executed.
+	AfterTransferExecuted(ctx context.Context, account Account, coin sdk.Coin, nonce uint64) error
+	// AfterTransferFailed is called after a CCTP transfer fails.
+	AfterTransferFailed(ctx context.Context, account Account, coin sdk.Coin, transferErr error) error
+	// AfterAccountCleared is called after the coins of an AutoCCTP account are sent to the
+	// fallback recipient.
+	AfterAccountCleared(ctx context.Context, account Account, coins sdk.Coins, reason ClearingReason) error
+}
+
+// AutoCCTPHooksWrapper is a wrapper used to provide the hooks via depinject.
+type AutoCCTPHooksWrapper struct{ AutoCCTPHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (AutoCCTPHooksWrapper) IsOnePerModuleType() {}
+
+var _ AutoCCTPHooks = MultiAutoCCTPHooks{}
+
+// MultiAutoCCTPHooks combines multiple hooks, which are called in order. The first error
+// returned stops the execution of the following hooks.
+type MultiAutoCCTPHooks []AutoCCTPHooks
+
+func NewMultiAutoCCTPHooks(hooks ...AutoCCTPHooks) MultiAutoCCTPHooks {
+	return hooks
+}
+
+func (h MultiAutoCCTPHooks) AfterAccountRegistered(ctx context.Context, account Account) error {
+	for i := range h {
+		if err := h[i].AfterAccountRegistered(ctx, account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiAutoCCTPHooks) BeforeTransferExecuted(ctx context.Context, account Account, coin sdk.Coin) error {
+	for i := range h {
+		if err := h[i].BeforeTransferExecuted(ctx, account, coin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiAutoCCTPHooks) AfterTransferExecuted(ctx context.Context, account Account, coin sdk.Coin, nonce uint64) error {
+	for i := range h {
+		if err := h[i].AfterTransferExecuted(ctx, account, coin, nonce); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiAutoCCTPHooks) AfterTransferFailed(ctx context.Context, account Account, coin sdk.Coin, transferErr error) error {
+	for i := range h {
+		if err := h[i].AfterTransferFailed(ctx, account, coin, transferErr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiAutoCCTPHooks) AfterAccountCleared(ctx context.Context, account Account, coins sdk.Coins, reason ClearingReason) error {
+	for i := range h {
+		if err := h[i].AfterAccountCleared(ctx, account, coins, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
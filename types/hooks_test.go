@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"autocctp.dev/testutil/mocks"
+	"autocctp.dev/types"
+)
+
+func TestMultiAutoCCTPHooks(t *testing.T) {
+	// ARRANGE
+	first := mocks.NewAutoCCTPHooks()
+	second := mocks.NewAutoCCTPHooks()
+	hooks := types.NewMultiAutoCCTPHooks(first, second)
+	coin := sdk.NewInt64Coin("uusdc", 1)
+
+	// ACT
+	err := hooks.BeforeTransferExecuted(context.Background(), types.Account{}, coin)
+
+	// ASSERT
+	require.NoError(t, err, "expected no error calling the hooks")
+	require.Equal(t, 1, first.Calls["BeforeTransferExecuted"], "expected the first hook to be called")
+	require.Equal(t, 1, second.Calls["BeforeTransferExecuted"], "expected the second hook to be called")
+
+	// ARRANGE
+	first.VetoErr = errors.New("vetoed")
+
+	// ACT
+	err = hooks.BeforeTransferExecuted(context.Background(), types.Account{}, coin)
+
+	// ASSERT: The first error stops the execution of the following hooks.
+	require.ErrorContains(t, err, "vetoed", "expected the veto error")
+	require.Equal(t, 2, first.Calls["BeforeTransferExecuted"], "expected the first hook to be called")
+	require.Equal(t, 1, second.Calls["BeforeTransferExecuted"], "expected the second hook to not be called")
+}
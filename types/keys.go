@@ -23,9 +23,56 @@ package types
 const ModuleName = "autocctp"
 
 var (
+	ParamsKey     = []byte("params")
+	DomainsPrefix = []byte("domains")
+
+	PausedKey           = []byte("global_paused")
+	PausedDomainsPrefix = []byte("paused_domains")
+	TokenPausedKey      = []byte("token_paused")
+
 	NumOfAccountsPrefix    = []byte("num_of_accounts")
 	NumOfTransfersPrefix   = []byte("num_of_transfers")
 	TotalTransferredPrefix = []byte("total_transferred")
+	TotalFeesPrefix        = []byte("total_fees")
+	StatsHistoryPrefix     = []byte("stats_history")
+	OutcomeStatsPrefix     = []byte("outcome_stats")
+
+	AccountsByDestinationDomainPrefix = []byte("accounts_by_destination_domain")
+	AccountsByMintRecipientPrefix     = []byte("accounts_by_mint_recipient")
+	AccountsByFallbackRecipientPrefix = []byte("accounts_by_fallback_recipient")
+	AccountsByExpirationHeightPrefix  = []byte("accounts_by_expiration_height")
+	AccountsByExpirationTimePrefix    = []byte("accounts_by_expiration_time")
+	PruneRetriesPrefix                = []byte("prune_retries")
+
+	FailedTransfersPrefix              = []byte("failed_transfers")
+	FailedTransfersByRetryHeightPrefix = []byte("retries_by_height")
+	FallbackSweepCursorKey             = []byte("fallback_sweep_cursor")
+
+	FailedForwardsPrefix              = []byte("failed_forwards")
+	FailedForwardsByRetryHeightPrefix = []byte("forward_retries_by_height")
+
+	OwedFeesPrefix = []byte("owed_fees")
+
+	RateLimitUsagePrefix       = []byte("rate_limit_usage")
+	RateLimitBucketsPrefix     = []byte("rate_limit_buckets")
+	RateLimitedTransfersPrefix = []byte("rate_limited_transfers")
+
+	TransferHistoryPrefix      = []byte("transfer_history")
+	TransferHistorySequenceKey = []byte("next_transfer_record_id")
+
+	TransferQueuePrefix          = []byte("transfer_queue")
+	TransferQueueByAddressPrefix = []byte("queued_transfers_by_address")
+	TransferQueueSequenceKey     = []byte("next_queued_transfer_id")
+
+	AwaitingTransfersPrefix = []byte("awaiting_transfers")
+	ResumeCursorKey         = []byte("resume_cursor")
+
+	DirtyAccountsPrefix = []byte("dirty_accounts")
+	SweepCursorKey      = []byte("sweep_cursor")
 
-	PendingTransfersPrefix = []byte("pending_transfers")
+	PendingTransfersPrefix          = []byte("pending_transfers")
+	PendingTransfersByAddressPrefix = []byte("pending_by_address")
+	PendingTransfersSequenceKey     = []byte("next_pending_transfer_id")
+	PendingForwardsPrefix           = []byte("pending_forwards")
+	TransfersExecutedKey            = []byte("transfers_executed")
 )
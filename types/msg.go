@@ -20,6 +20,8 @@
 
 package types
 
+import "time"
+
 // GetAccountProperties returns the account properties from the message.
 func (msg MsgRegisterAccount) GetAccountProperties() AccountProperties {
 	return AccountProperties{
@@ -27,6 +29,10 @@ func (msg MsgRegisterAccount) GetAccountProperties() AccountProperties {
 		MintRecipient:     msg.MintRecipient,
 		FallbackRecipient: msg.FallbackRecipient,
 		DestinationCaller: msg.DestinationCaller,
+		ExpirationHeight:  msg.ExpirationHeight,
+		ExpirationTime:    expirationTimeOrZero(msg.ExpirationTime),
+		AddressVersion:    msg.AddressVersion,
+		Salt:              msg.Salt,
 	}
 }
 
@@ -37,5 +43,17 @@ func (msg MsgRegisterAccountSignerlessly) GetAccountProperties() AccountProperti
 		MintRecipient:     msg.MintRecipient,
 		FallbackRecipient: msg.FallbackRecipient,
 		DestinationCaller: msg.DestinationCaller,
+		ExpirationHeight:  msg.ExpirationHeight,
+		ExpirationTime:    expirationTimeOrZero(msg.ExpirationTime),
+		AddressVersion:    msg.AddressVersion,
+		Salt:              msg.Salt,
+	}
+}
+
+// expirationTimeOrZero returns the optional expiration time, or the zero time if nil.
+func expirationTimeOrZero(expirationTime *time.Time) time.Time {
+	if expirationTime == nil {
+		return time.Time{}
 	}
+	return expirationTime.UTC()
 }
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// NewParams returns a new Params instance.
+func NewParams(
+	minimumTransferAmount math.Int,
+	maxTransferAttempts, retryBaseDelay, retryMaxDelay uint64,
+	splitOversizedTransfers bool,
+	maxTransferHistory uint64,
+) Params {
+	return Params{
+		MinimumTransferAmount:   minimumTransferAmount,
+		MaxTransferAttempts:     maxTransferAttempts,
+		RetryBaseDelay:          retryBaseDelay,
+		RetryMaxDelay:           retryMaxDelay,
+		SplitOversizedTransfers: splitOversizedTransfers,
+		MaxTransferHistory:      maxTransferHistory,
+	}
+}
+
+// DefaultParams returns the default AutoCCTP module parameters.
+func DefaultParams() Params {
+	params := NewParams(
+		math.NewInt(DefaultMinimumTransferAmount),
+		DefaultMaxTransferAttempts,
+		DefaultRetryBaseDelay,
+		DefaultRetryMaxDelay,
+		false,
+		DefaultMaxTransferHistory,
+	)
+	params.MaxTransfersPerBlock = DefaultMaxTransfersPerBlock
+	params.TransferGasLimit = DefaultTransferGasLimit
+	params.SweepBatchSize = DefaultSweepBatchSize
+	params.StatsBucketDuration = DefaultStatsBucketDuration
+	params.StatsHistoryRetention = DefaultStatsHistoryRetention
+	params.MaxPrunedAccountsPerBlock = DefaultMaxPrunedAccountsPerBlock
+	params.FallbackSweepBatchSize = DefaultFallbackSweepBatchSize
+
+	return params
+}
+
+// Validate returns an error if any of the parameters is not valid.
+func (p Params) Validate() error {
+	if p.MinimumTransferAmount.IsNil() || !p.MinimumTransferAmount.IsPositive() {
+		return errors.New("minimum transfer amount must be positive")
+	}
+
+	if p.MaxTransferAttempts == 0 {
+		return errors.New("max transfer attempts must be positive")
+	}
+
+	if p.RetryBaseDelay == 0 {
+		return errors.New("retry base delay must be positive")
+	}
+
+	if p.RetryMaxDelay < p.RetryBaseDelay {
+		return errors.New("retry max delay cannot be lower than the retry base delay")
+	}
+
+	if p.StatsBucketDuration < 0 {
+		return errors.New("stats bucket duration cannot be negative")
+	}
+
+	if p.StatsHistoryRetention < 0 {
+		return errors.New("stats history retention cannot be negative")
+	}
+
+	if p.FeeRecipient != "" {
+		if _, _, err := bech32.DecodeAndConvert(p.FeeRecipient); err != nil {
+			return fmt.Errorf("invalid fee recipient: %w", err)
+		}
+	}
+
+	transferFees := make(map[uint32]bool, len(p.TransferFees))
+	for _, transferFee := range p.TransferFees {
+		if transferFees[transferFee.DestinationDomain] {
+			return fmt.Errorf("transfer fee for destination domain %d is defined more than once", transferFee.DestinationDomain)
+		}
+		transferFees[transferFee.DestinationDomain] = true
+
+		if err := transferFee.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer fee for destination domain %d: %w", transferFee.DestinationDomain, err)
+		}
+	}
+
+	return nil
+}
+
+// GetTransferFee returns the fee associated with the destination domain, or nil if the
+// transfers to the destination domain are not charged.
+func (p Params) GetTransferFee(destinationDomain uint32) *TransferFee {
+	for _, transferFee := range p.TransferFees {
+		if transferFee.DestinationDomain == destinationDomain {
+			return &transferFee
+		}
+	}
+
+	return nil
+}
+
+// RetryDelay returns the number of blocks to wait before retrying a transfer which failed
+// the given number of attempts. The delay grows exponentially, starting from the base delay,
+// and is capped at the max delay.
+func (p Params) RetryDelay(attempts uint64) uint64 {
+	delay := p.RetryBaseDelay
+	for i := uint64(1); i < attempts && delay < p.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, p.RetryMaxDelay)
+}
+
+// Validate returns an error if the amounts of the fee are negative or the basis points
+// exceed the whole transferred amount.
+func (f TransferFee) Validate() error {
+	if !f.FlatAmount.IsNil() && f.FlatAmount.IsNegative() {
+		return errors.New("flat amount cannot be negative")
+	}
+
+	if !f.MaxAmount.IsNil() && f.MaxAmount.IsNegative() {
+		return errors.New("max amount cannot be negative")
+	}
+
+	if f.BasisPoints > MaxBasisPoints {
+		return fmt.Errorf("basis points cannot exceed %d", MaxBasisPoints)
+	}
+
+	return nil
+}
+
+// Amount returns the fee charged for transferring the given amount.
+func (f TransferFee) Amount(amount math.Int) math.Int {
+	fee := amount.MulRaw(int64(f.BasisPoints)).QuoRaw(MaxBasisPoints)
+	if !f.FlatAmount.IsNil() {
+		fee = fee.Add(f.FlatAmount)
+	}
+
+	if !f.MaxAmount.IsNil() && f.MaxAmount.IsPositive() {
+		fee = math.MinInt(fee, f.MaxAmount)
+	}
+
+	return fee
+}
@@ -0,0 +1,1247 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/autocctp/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the governance controlled parameters of the AutoCCTP module.
+type Params struct {
+	// The minimum amount of the minting denom that can be transferred via AutoCCTP.
+	MinimumTransferAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=minimum_transfer_amount,json=minimumTransferAmount,proto3,customtype=cosmossdk.io/math.Int" json:"minimum_transfer_amount"`
+	// The maximum number of attempts executed for an automatic transfer before giving up
+	// the automatic retries.
+	MaxTransferAttempts uint64 `protobuf:"varint,2,opt,name=max_transfer_attempts,json=maxTransferAttempts,proto3" json:"max_transfer_attempts,omitempty"`
+	// The number of blocks to wait before retrying a failed transfer for the first time.
+	// The delay is doubled after every failed attempt.
+	RetryBaseDelay uint64 `protobuf:"varint,3,opt,name=retry_base_delay,json=retryBaseDelay,proto3" json:"retry_base_delay,omitempty"`
+	// The maximum number of blocks to wait before retrying a failed transfer.
+	RetryMaxDelay uint64 `protobuf:"varint,4,opt,name=retry_max_delay,json=retryMaxDelay,proto3" json:"retry_max_delay,omitempty"`
+	// If true, deposits pushing the balance of an AutoCCTP account above the CCTP per message
+	// burn limit are accepted, and the balance is transferred in multiple chunks of at most
+	// the limit each.
+	SplitOversizedTransfers bool `protobuf:"varint,5,opt,name=split_oversized_transfers,json=splitOversizedTransfers,proto3" json:"split_oversized_transfers,omitempty"`
+	// The maximum number of transfer records kept in the history of every AutoCCTP account.
+	// Older records are pruned. If zero, the history is not recorded.
+	MaxTransferHistory uint64 `protobuf:"varint,6,opt,name=max_transfer_history,json=maxTransferHistory,proto3" json:"max_transfer_history,omitempty"`
+	// The address receiving the fees deducted from the automatic transfers. If empty, the
+	// fees are sent to the module account.
+	FeeRecipient string `protobuf:"bytes,7,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+	// The fees deducted from the automatic transfers, per destination domain. Transfers to
+	// destination domains without an entry are not charged.
+	TransferFees []TransferFee `protobuf:"bytes,8,rep,name=transfer_fees,json=transferFees,proto3" json:"transfer_fees"`
+	// The maximum number of CCTP burns executed at the end of every block, counting every
+	// chunk of a split transfer. The transfers exceeding the limit are queued and processed in
+	// the following blocks. If zero, the transfers are not limited.
+	MaxTransfersPerBlock uint64 `protobuf:"varint,9,opt,name=max_transfers_per_block,json=maxTransfersPerBlock,proto3" json:"max_transfers_per_block,omitempty"`
+	// The maximum amount of gas consumed by every CCTP transfer executed at the end of the
+	// block. Transfers exceeding the limit are recorded as failed. If zero, the gas is not
+	// limited.
+	TransferGasLimit uint64 `protobuf:"varint,10,opt,name=transfer_gas_limit,json=transferGasLimit,proto3" json:"transfer_gas_limit,omitempty"`
+	// The number of AutoCCTP accounts checked at the beginning of every block for balances
+	// that were not marked for clearing. If zero, the accounts are not checked.
+	SweepBatchSize uint64 `protobuf:"varint,11,opt,name=sweep_batch_size,json=sweepBatchSize,proto3" json:"sweep_batch_size,omitempty"`
+	// The duration of the time buckets in which the transfers executed to every destination
+	// domain are aggregated. If zero, the statistics history is not recorded.
+	StatsBucketDuration time.Duration `protobuf:"bytes,12,opt,name=stats_bucket_duration,json=statsBucketDuration,proto3,stdduration" json:"stats_bucket_duration"`
+	// The time for which the buckets of the statistics history are kept. Older buckets are
+	// pruned at the end of the block. If zero, the buckets are never pruned.
+	StatsHistoryRetention time.Duration `protobuf:"bytes,13,opt,name=stats_history_retention,json=statsHistoryRetention,proto3,stdduration" json:"stats_history_retention"`
+	// The maximum number of expired AutoCCTP accounts pruned at the end of every block. The
+	// remaining accounts are pruned in the following blocks. If zero, the pruning is not
+	// limited.
+	MaxPrunedAccountsPerBlock uint64 `protobuf:"varint,14,opt,name=max_pruned_accounts_per_block,json=maxPrunedAccountsPerBlock,proto3" json:"max_pruned_accounts_per_block,omitempty"`
+	// The maximum number of failed transfers checked at the end of every block against the
+	// fallback policy of their account. The remaining failed transfers are checked in the
+	// following blocks. If zero, the failed transfers checked are not limited.
+	FallbackSweepBatchSize uint64 `protobuf:"varint,15,opt,name=fallback_sweep_batch_size,json=fallbackSweepBatchSize,proto3" json:"fallback_sweep_batch_size,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc70f6fcbdd0eb49, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxTransferAttempts() uint64 {
+	if m != nil {
+		return m.MaxTransferAttempts
+	}
+	return 0
+}
+
+func (m *Params) GetRetryBaseDelay() uint64 {
+	if m != nil {
+		return m.RetryBaseDelay
+	}
+	return 0
+}
+
+func (m *Params) GetRetryMaxDelay() uint64 {
+	if m != nil {
+		return m.RetryMaxDelay
+	}
+	return 0
+}
+
+func (m *Params) GetSplitOversizedTransfers() bool {
+	if m != nil {
+		return m.SplitOversizedTransfers
+	}
+	return false
+}
+
+func (m *Params) GetMaxTransferHistory() uint64 {
+	if m != nil {
+		return m.MaxTransferHistory
+	}
+	return 0
+}
+
+func (m *Params) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetTransferFees() []TransferFee {
+	if m != nil {
+		return m.TransferFees
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTransfersPerBlock() uint64 {
+	if m != nil {
+		return m.MaxTransfersPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetTransferGasLimit() uint64 {
+	if m != nil {
+		return m.TransferGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetSweepBatchSize() uint64 {
+	if m != nil {
+		return m.SweepBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetStatsBucketDuration() time.Duration {
+	if m != nil {
+		return m.StatsBucketDuration
+	}
+	return 0
+}
+
+func (m *Params) GetStatsHistoryRetention() time.Duration {
+	if m != nil {
+		return m.StatsHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunedAccountsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedAccountsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetFallbackSweepBatchSize() uint64 {
+	if m != nil {
+		return m.FallbackSweepBatchSize
+	}
+	return 0
+}
+
+// TransferFee defines the fee deducted from the automatic transfers to a destination
+// domain. The fee is the sum of the flat amount and the basis points of the transferred
+// amount, capped at the max amount if positive.
+type TransferFee struct {
+	DestinationDomain uint32                `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	FlatAmount        cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=flat_amount,json=flatAmount,proto3,customtype=cosmossdk.io/math.Int" json:"flat_amount"`
+	BasisPoints       uint32                `protobuf:"varint,3,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	MaxAmount         cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+}
+
+func (m *TransferFee) Reset()         { *m = TransferFee{} }
+func (m *TransferFee) String() string { return proto.CompactTextString(m) }
+func (*TransferFee) ProtoMessage()    {}
+func (*TransferFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc70f6fcbdd0eb49, []int{1}
+}
+func (m *TransferFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFee.Merge(m, src)
+}
+func (m *TransferFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFee proto.InternalMessageInfo
+
+func (m *TransferFee) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *TransferFee) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "noble.autocctp.v1.Params")
+	proto.RegisterType((*TransferFee)(nil), "noble.autocctp.v1.TransferFee")
+}
+
+func init() { proto.RegisterFile("noble/autocctp/v1/params.proto", fileDescriptor_fc70f6fcbdd0eb49) }
+
+var fileDescriptor_fc70f6fcbdd0eb49 = []byte{
+	// 761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xf3, 0x44,
+	0x14, 0x8d, 0xfb, 0x85, 0xfe, 0x4c, 0x92, 0xfe, 0x4c, 0x13, 0xe2, 0x54, 0xc2, 0x0d, 0x5d, 0xa0,
+	0x08, 0xb5, 0x76, 0x5b, 0xc4, 0x02, 0x24, 0x24, 0x6a, 0x55, 0x40, 0x25, 0x50, 0x83, 0x8b, 0x84,
+	0x04, 0x8b, 0xd1, 0xd8, 0xbe, 0x49, 0x46, 0xb1, 0x3d, 0xd6, 0xcc, 0x24, 0x24, 0xdd, 0xf2, 0x02,
+	0x2c, 0x79, 0x04, 0x96, 0x08, 0xf5, 0x21, 0xba, 0xac, 0xba, 0x42, 0x2c, 0x0a, 0x6a, 0x17, 0xbc,
+	0x06, 0xf2, 0xd8, 0x0e, 0xa9, 0xba, 0xf9, 0xd4, 0x4d, 0x14, 0x9f, 0x73, 0xe6, 0xdc, 0x33, 0xf7,
+	0xce, 0x45, 0x56, 0xc2, 0xfd, 0x08, 0x1c, 0x3a, 0x51, 0x3c, 0x08, 0x54, 0xea, 0x4c, 0x4f, 0x9c,
+	0x94, 0x0a, 0x1a, 0x4b, 0x3b, 0x15, 0x5c, 0x71, 0xbc, 0xa3, 0x79, 0xbb, 0xe4, 0xed, 0xe9, 0xc9,
+	0xde, 0x0e, 0x8d, 0x59, 0xc2, 0x1d, 0xfd, 0x9b, 0xab, 0xf6, 0x3a, 0x01, 0x97, 0x31, 0x97, 0x44,
+	0x7f, 0x39, 0xf9, 0x47, 0x41, 0x35, 0x87, 0x7c, 0xc8, 0x73, 0x3c, 0xfb, 0x57, 0xa0, 0xd6, 0x90,
+	0xf3, 0x61, 0x04, 0x8e, 0xfe, 0xf2, 0x27, 0x03, 0x27, 0x9c, 0x08, 0xaa, 0x18, 0x4f, 0x72, 0xfe,
+	0xe0, 0x8f, 0x35, 0xb4, 0xda, 0xd7, 0x39, 0xf0, 0x08, 0xb5, 0x63, 0x96, 0xb0, 0x78, 0x12, 0x13,
+	0x25, 0x68, 0x22, 0x07, 0x20, 0x08, 0x8d, 0xf9, 0x24, 0x51, 0xa6, 0xd1, 0x35, 0x7a, 0x1b, 0xee,
+	0xf1, 0xed, 0xc3, 0x7e, 0xe5, 0xaf, 0x87, 0xfd, 0x56, 0x5e, 0x57, 0x86, 0x63, 0x9b, 0x71, 0x27,
+	0xa6, 0x6a, 0x64, 0x5f, 0x24, 0xea, 0xfe, 0xe6, 0x08, 0x15, 0x81, 0x2e, 0x12, 0xf5, 0xdb, 0xbf,
+	0xbf, 0x7f, 0x68, 0x78, 0xad, 0xc2, 0xf0, 0xbb, 0xc2, 0xef, 0x4c, 0xdb, 0xe1, 0x53, 0xd4, 0x8a,
+	0xe9, 0x6c, 0xa9, 0x8a, 0x52, 0x10, 0xa7, 0x4a, 0x9a, 0x2b, 0x5d, 0xa3, 0x57, 0xf5, 0x76, 0x63,
+	0x3a, 0x5b, 0x9c, 0x28, 0x28, 0xdc, 0x43, 0xdb, 0x02, 0x94, 0x98, 0x13, 0x9f, 0x4a, 0x20, 0x21,
+	0x44, 0x74, 0x6e, 0xbe, 0xd1, 0xf2, 0x4d, 0x8d, 0xbb, 0x54, 0xc2, 0x79, 0x86, 0xe2, 0x0f, 0xd0,
+	0x56, 0xae, 0xcc, 0x6a, 0xe4, 0xc2, 0xaa, 0x16, 0x36, 0x34, 0xfc, 0x0d, 0x9d, 0xe5, 0xba, 0x4f,
+	0x51, 0x47, 0xa6, 0x11, 0x53, 0x84, 0x4f, 0x41, 0x48, 0x76, 0x0d, 0xe1, 0x22, 0x91, 0x34, 0xdf,
+	0xe9, 0x1a, 0xbd, 0x75, 0xaf, 0xad, 0x05, 0x97, 0x25, 0x5f, 0x86, 0x92, 0xf8, 0x18, 0x35, 0x9f,
+	0xdd, 0x60, 0xc4, 0xa4, 0xe2, 0x62, 0x6e, 0xae, 0xea, 0x42, 0x78, 0xe9, 0x02, 0x5f, 0xe5, 0x0c,
+	0xfe, 0x0c, 0x35, 0x06, 0x00, 0x44, 0x40, 0xc0, 0x52, 0x06, 0x89, 0x32, 0xd7, 0x74, 0x4f, 0xcd,
+	0xfb, 0x9b, 0xa3, 0x66, 0xd1, 0xb6, 0xb3, 0x30, 0x14, 0x20, 0xe5, 0x95, 0x12, 0x2c, 0x19, 0x7a,
+	0xf5, 0x01, 0x80, 0x57, 0xaa, 0xf1, 0x05, 0x6a, 0x2c, 0x8a, 0x0d, 0x00, 0xa4, 0xb9, 0xde, 0x7d,
+	0xd3, 0xab, 0x9d, 0x5a, 0xf6, 0x8b, 0x67, 0x63, 0x97, 0x95, 0xbf, 0x00, 0x70, 0xab, 0xd9, 0xc8,
+	0xbc, 0xba, 0xfa, 0x1f, 0x92, 0xf8, 0x63, 0xd4, 0x5e, 0xce, 0x2e, 0x49, 0x0a, 0x82, 0xf8, 0x11,
+	0x0f, 0xc6, 0xe6, 0x86, 0x8e, 0xdf, 0x5c, 0x8a, 0x2f, 0xfb, 0x20, 0xdc, 0x8c, 0xc3, 0x87, 0x08,
+	0x2f, 0x12, 0x0c, 0xa9, 0x24, 0x11, 0x8b, 0x99, 0x32, 0x91, 0x3e, 0xb1, 0x5d, 0x32, 0x5f, 0x52,
+	0xf9, 0x75, 0x86, 0x67, 0xe3, 0x92, 0x3f, 0x01, 0xa4, 0xc4, 0xa7, 0x2a, 0x18, 0x91, 0xac, 0x7d,
+	0x66, 0x2d, 0x1f, 0x97, 0xc6, 0xdd, 0x0c, 0xbe, 0x62, 0xd7, 0x80, 0xbf, 0x47, 0x2d, 0xa9, 0xa8,
+	0x92, 0xc4, 0x9f, 0x04, 0x63, 0x50, 0xa4, 0x7c, 0xa0, 0x66, 0xbd, 0x6b, 0xf4, 0x6a, 0xa7, 0x1d,
+	0x3b, 0x7f, 0xc1, 0x76, 0xf9, 0x82, 0xed, 0xf3, 0x42, 0xe0, 0xae, 0x67, 0x97, 0xfb, 0xf5, 0xef,
+	0x7d, 0xc3, 0xdb, 0xd5, 0x0e, 0xae, 0x36, 0x28, 0x69, 0xfc, 0x23, 0x6a, 0xe7, 0xc6, 0xc5, 0x70,
+	0x88, 0x00, 0x05, 0x89, 0xb6, 0x6e, 0xbc, 0xbd, 0x75, 0x1e, 0xae, 0x98, 0xa2, 0x57, 0x3a, 0xe0,
+	0xcf, 0xd1, 0x7b, 0x59, 0x13, 0x53, 0x31, 0x49, 0x20, 0x24, 0x34, 0x08, 0xb2, 0x87, 0xbd, 0xdc,
+	0xca, 0x4d, 0x7d, 0xd9, 0x4e, 0x4c, 0x67, 0x7d, 0xad, 0x39, 0x2b, 0x24, 0x8b, 0x7e, 0x7e, 0x82,
+	0x3a, 0x03, 0x1a, 0x45, 0x3e, 0x0d, 0xc6, 0xe4, 0x45, 0xab, 0xb6, 0xf4, 0xe9, 0x77, 0x4b, 0xc1,
+	0xd5, 0xb3, 0x96, 0x1d, 0xfc, 0xbc, 0x82, 0x6a, 0x4b, 0x53, 0xc6, 0x47, 0x08, 0x87, 0x20, 0x15,
+	0x4b, 0x74, 0x78, 0x12, 0xf2, 0x98, 0xb2, 0x44, 0x2f, 0x6d, 0xc3, 0xdb, 0x59, 0x62, 0xce, 0x35,
+	0x81, 0xbf, 0x45, 0xb5, 0x41, 0x44, 0x55, 0xb9, 0xdc, 0x2b, 0xaf, 0x5c, 0x6e, 0x94, 0x99, 0x14,
+	0x1b, 0xfd, 0x3e, 0xaa, 0xfb, 0x54, 0x32, 0x49, 0x52, 0xce, 0x12, 0x25, 0xf5, 0x66, 0x36, 0xbc,
+	0x9a, 0xc6, 0xfa, 0x1a, 0xc2, 0x97, 0x08, 0x65, 0x1d, 0x2b, 0x8a, 0x56, 0x5f, 0x59, 0x74, 0x23,
+	0xa6, 0xb3, 0xbc, 0xa6, 0x7b, 0x78, 0xfb, 0x68, 0x19, 0x77, 0x8f, 0x96, 0xf1, 0xcf, 0xa3, 0x65,
+	0xfc, 0xf2, 0x64, 0x55, 0xee, 0x9e, 0xac, 0xca, 0x9f, 0x4f, 0x56, 0xe5, 0x07, 0xbc, 0x58, 0x87,
+	0x10, 0xa6, 0x8e, 0x9a, 0xa7, 0x20, 0xfd, 0x55, 0x3d, 0xe4, 0x8f, 0xfe, 0x0b, 0x00, 0x00, 0xff,
+	0xff, 0x58, 0xbe, 0x51, 0x7c, 0x88, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FallbackSweepBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FallbackSweepBatchSize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxPrunedAccountsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrunedAccountsPerBlock))
+		i--
+		dAtA[i] = 0x70
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StatsHistoryRetention, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StatsHistoryRetention):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x6a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StatsBucketDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StatsBucketDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x62
+	if m.SweepBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SweepBatchSize))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TransferGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TransferGasLimit))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxTransfersPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTransfersPerBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TransferFees) > 0 {
+		for iNdEx := len(m.TransferFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxTransferHistory != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTransferHistory))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SplitOversizedTransfers {
+		i--
+		if m.SplitOversizedTransfers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RetryMaxDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetryMaxDelay))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RetryBaseDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetryBaseDelay))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTransferAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTransferAttempts))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinimumTransferAmount.Size()
+		i -= size
+		if _, err := m.MinimumTransferAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BasisPoints != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.FlatAmount.Size()
+		i -= size
+		if _, err := m.FlatAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.DestinationDomain != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinimumTransferAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxTransferAttempts != 0 {
+		n += 1 + sovParams(uint64(m.MaxTransferAttempts))
+	}
+	if m.RetryBaseDelay != 0 {
+		n += 1 + sovParams(uint64(m.RetryBaseDelay))
+	}
+	if m.RetryMaxDelay != 0 {
+		n += 1 + sovParams(uint64(m.RetryMaxDelay))
+	}
+	if m.SplitOversizedTransfers {
+		n += 2
+	}
+	if m.MaxTransferHistory != 0 {
+		n += 1 + sovParams(uint64(m.MaxTransferHistory))
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.TransferFees) > 0 {
+		for _, e := range m.TransferFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxTransfersPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxTransfersPerBlock))
+	}
+	if m.TransferGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.TransferGasLimit))
+	}
+	if m.SweepBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.SweepBatchSize))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StatsBucketDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StatsHistoryRetention)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxPrunedAccountsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrunedAccountsPerBlock))
+	}
+	if m.FallbackSweepBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.FallbackSweepBatchSize))
+	}
+	return n
+}
+
+func (m *TransferFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		n += 1 + sovParams(uint64(m.DestinationDomain))
+	}
+	l = m.FlatAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BasisPoints != 0 {
+		n += 1 + sovParams(uint64(m.BasisPoints))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumTransferAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumTransferAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransferAttempts", wireType)
+			}
+			m.MaxTransferAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTransferAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBaseDelay", wireType)
+			}
+			m.RetryBaseDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryBaseDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryMaxDelay", wireType)
+			}
+			m.RetryMaxDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryMaxDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitOversizedTransfers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SplitOversizedTransfers = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransferHistory", wireType)
+			}
+			m.MaxTransferHistory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTransferHistory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferFees = append(m.TransferFees, TransferFee{})
+			if err := m.TransferFees[len(m.TransferFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransfersPerBlock", wireType)
+			}
+			m.MaxTransfersPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTransfersPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferGasLimit", wireType)
+			}
+			m.TransferGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweepBatchSize", wireType)
+			}
+			m.SweepBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SweepBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsBucketDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StatsBucketDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsHistoryRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StatsHistoryRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedAccountsPerBlock", wireType)
+			}
+			m.MaxPrunedAccountsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedAccountsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackSweepBatchSize", wireType)
+			}
+			m.FallbackSweepBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FallbackSweepBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"autocctp.dev/types"
+)
+
+func TestParams_RetryDelay(t *testing.T) {
+	params := types.NewParams(math.NewInt(1), types.DefaultMaxTransferAttempts, 10, 100, false, types.DefaultMaxTransferHistory)
+
+	testCases := []struct {
+		attempts uint64
+		expected uint64
+	}{
+		{attempts: 0, expected: 10},
+		{attempts: 1, expected: 10},
+		{attempts: 2, expected: 20},
+		{attempts: 3, expected: 40},
+		{attempts: 4, expected: 80},
+		{attempts: 5, expected: 100},
+		{attempts: 1_000, expected: 100},
+	}
+
+	for _, tC := range testCases {
+		require.Equal(t, tC.expected, params.RetryDelay(tC.attempts), "expected a different delay for %d attempts", tC.attempts)
+	}
+}
+
+func TestTransferFee_Amount(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fee      types.TransferFee
+		amount   int64
+		expected int64
+	}{
+		{
+			name:     "flat amount",
+			fee:      types.TransferFee{FlatAmount: math.NewInt(100)},
+			amount:   1_000_000,
+			expected: 100,
+		},
+		{
+			name:     "basis points",
+			fee:      types.TransferFee{BasisPoints: 25},
+			amount:   1_000_000,
+			expected: 2_500,
+		},
+		{
+			name:     "flat amount and basis points",
+			fee:      types.TransferFee{FlatAmount: math.NewInt(100), BasisPoints: 25},
+			amount:   1_000_000,
+			expected: 2_600,
+		},
+		{
+			name:     "basis points capped at the max amount",
+			fee:      types.TransferFee{BasisPoints: 25, MaxAmount: math.NewInt(1_000)},
+			amount:   1_000_000,
+			expected: 1_000,
+		},
+		{
+			name:     "basis points rounded down",
+			fee:      types.TransferFee{BasisPoints: 1},
+			amount:   9_999,
+			expected: 0,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			require.Equal(t, tC.expected, tC.fee.Amount(math.NewInt(tC.amount)).Int64(), "expected a different fee")
+		})
+	}
+}
@@ -20,9 +20,12 @@
 
 package types
 
-func (q QueryAddress) GetAccountProperties() (AccountProperties, error) {
+// GetAccountProperties returns the account properties from the query, parsing the addresses
+// according to the registry entry of the destination domain.
+func (q QueryAddress) GetAccountProperties(domain *DomainConfig) (AccountProperties, error) {
 	accountProperties, err := ValidateAndParseAccountFields(
 		q.DestinationDomain,
+		domain,
 		q.MintRecipient,
 		q.FallbackRecipient,
 		q.DestinationCaller,
@@ -30,6 +33,10 @@ func (q QueryAddress) GetAccountProperties() (AccountProperties, error) {
 	if err != nil {
 		return AccountProperties{}, err
 	}
+	accountProperties.ExpirationHeight = q.ExpirationHeight
+	accountProperties.ExpirationTime = expirationTimeOrZero(q.ExpirationTime)
+	accountProperties.AddressVersion = q.AddressVersion
+	accountProperties.Salt = q.Salt
 
 	return *accountProperties, nil
 }
@@ -5,26 +5,32 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -43,6 +49,15 @@ type QueryAddress struct {
 	// If specified, represents the only address allowed to complete the transfer on the
 	// destination domain.
 	DestinationCaller string `protobuf:"bytes,4,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	// An optional block height from which the account expires. Zero means no expiration.
+	ExpirationHeight uint64 `protobuf:"varint,5,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// An optional time from which the account expires.
+	ExpirationTime *time.Time `protobuf:"bytes,6,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+	// The version of the scheme used to derive the account address.
+	AddressVersion uint32 `protobuf:"varint,7,opt,name=address_version,json=addressVersion,proto3" json:"address_version,omitempty"`
+	// An optional salt used to derive a different address for the same properties. Only
+	// supported from the address version 1.
+	Salt []byte `protobuf:"bytes,8,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
 func (m *QueryAddress) Reset()         { *m = QueryAddress{} }
@@ -223,8 +238,14 @@ type DomainStats struct {
 	Accounts uint64 `protobuf:"varint,1,opt,name=accounts,proto3" json:"accounts,omitempty"`
 	// The number of transfers executed.
 	Transfers uint64 `protobuf:"varint,2,opt,name=transfers,proto3" json:"transfers,omitempty"`
+	// The total amount of fees collected.
+	TotalFees cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_fees,json=totalFees,proto3,customtype=cosmossdk.io/math.Int" json:"total_fees"`
+	// The capacity left in the rate limit window, if the domain is rate limited.
+	RateLimitCapacity *RateLimitCapacity `protobuf:"bytes,5,opt,name=rate_limit_capacity,json=rateLimitCapacity,proto3" json:"rate_limit_capacity,omitempty"`
+	// The outcomes of the transfers and registrations.
+	Outcomes OutcomeStats `protobuf:"bytes,6,opt,name=outcomes,proto3" json:"outcomes"`
 	// The total amount transferred.
-	TotalTransferred uint64 `protobuf:"varint,3,opt,name=total_transferred,json=totalTransferred,proto3" json:"total_transferred,omitempty"`
+	TotalTransferredAmount cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=total_transferred_amount,json=totalTransferredAmount,proto3,customtype=cosmossdk.io/math.Int" json:"total_transferred_amount"`
 }
 
 func (m *DomainStats) Reset()         { *m = DomainStats{} }
@@ -274,11 +295,18 @@ func (m *DomainStats) GetTransfers() uint64 {
 	return 0
 }
 
-func (m *DomainStats) GetTotalTransferred() uint64 {
+func (m *DomainStats) GetRateLimitCapacity() *RateLimitCapacity {
 	if m != nil {
-		return m.TotalTransferred
+		return m.RateLimitCapacity
 	}
-	return 0
+	return nil
+}
+
+func (m *DomainStats) GetOutcomes() OutcomeStats {
+	if m != nil {
+		return m.Outcomes
+	}
+	return OutcomeStats{}
 }
 
 // QueryStatsByDestinationDomain is the request message for querying stats by a specific destination domain.
@@ -327,8 +355,14 @@ type QueryStatsByDestinationDomainResponse struct {
 	Accounts uint64 `protobuf:"varint,1,opt,name=accounts,proto3" json:"accounts,omitempty"`
 	// The number of transfers executed.
 	Transfers uint64 `protobuf:"varint,2,opt,name=transfers,proto3" json:"transfers,omitempty"`
+	// The total amount of fees collected.
+	TotalFees cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_fees,json=totalFees,proto3,customtype=cosmossdk.io/math.Int" json:"total_fees"`
+	// The capacity left in the rate limit window, if the domain is rate limited.
+	RateLimitCapacity *RateLimitCapacity `protobuf:"bytes,5,opt,name=rate_limit_capacity,json=rateLimitCapacity,proto3" json:"rate_limit_capacity,omitempty"`
+	// The outcomes of the transfers and registrations.
+	Outcomes OutcomeStats `protobuf:"bytes,6,opt,name=outcomes,proto3" json:"outcomes"`
 	// The total amount transferred.
-	TotalTransferred uint64 `protobuf:"varint,3,opt,name=total_transferred,json=totalTransferred,proto3" json:"total_transferred,omitempty"`
+	TotalTransferredAmount cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=total_transferred_amount,json=totalTransferredAmount,proto3,customtype=cosmossdk.io/math.Int" json:"total_transferred_amount"`
 }
 
 func (m *QueryStatsByDestinationDomainResponse) Reset()         { *m = QueryStatsByDestinationDomainResponse{} }
@@ -378,626 +412,6444 @@ func (m *QueryStatsByDestinationDomainResponse) GetTransfers() uint64 {
 	return 0
 }
 
-func (m *QueryStatsByDestinationDomainResponse) GetTotalTransferred() uint64 {
+func (m *QueryStatsByDestinationDomainResponse) GetRateLimitCapacity() *RateLimitCapacity {
 	if m != nil {
-		return m.TotalTransferred
+		return m.RateLimitCapacity
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryAddress)(nil), "noble.autocctp.v1.QueryAddress")
-	proto.RegisterType((*QueryAddressResponse)(nil), "noble.autocctp.v1.QueryAddressResponse")
-	proto.RegisterType((*QueryStats)(nil), "noble.autocctp.v1.QueryStats")
-	proto.RegisterType((*QueryStatsResponse)(nil), "noble.autocctp.v1.QueryStatsResponse")
-	proto.RegisterMapType((map[uint32]DomainStats)(nil), "noble.autocctp.v1.QueryStatsResponse.DestinationDomainStatsEntry")
-	proto.RegisterType((*DomainStats)(nil), "noble.autocctp.v1.DomainStats")
-	proto.RegisterType((*QueryStatsByDestinationDomain)(nil), "noble.autocctp.v1.QueryStatsByDestinationDomain")
-	proto.RegisterType((*QueryStatsByDestinationDomainResponse)(nil), "noble.autocctp.v1.QueryStatsByDestinationDomainResponse")
+func (m *QueryStatsByDestinationDomainResponse) GetOutcomes() OutcomeStats {
+	if m != nil {
+		return m.Outcomes
+	}
+	return OutcomeStats{}
 }
 
-func init() { proto.RegisterFile("noble/autocctp/v1/query.proto", fileDescriptor_483d98375be4f886) }
-
-var fileDescriptor_483d98375be4f886 = []byte{
-	// 679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x25, 0x4d, 0x7f, 0x5c, 0x5b, 0xd4, 0x9c, 0x2a, 0x64, 0x5c, 0xe2, 0x14, 0xa3, 0x88,
-	0x0a, 0xd1, 0x98, 0x06, 0x86, 0xaa, 0x5b, 0x43, 0xf9, 0x03, 0x70, 0x41, 0x20, 0x96, 0xe8, 0x62,
-	0x5f, 0x23, 0xab, 0xce, 0x5d, 0xf0, 0x5d, 0x22, 0xa2, 0xaa, 0x03, 0xb0, 0x94, 0x0d, 0x89, 0x7f,
-	0xa0, 0x12, 0x0b, 0x23, 0x03, 0x12, 0x3b, 0x53, 0x07, 0x86, 0x0a, 0x16, 0x26, 0x84, 0x5a, 0x24,
-	0xf8, 0x23, 0x18, 0x90, 0xcf, 0x3f, 0x5b, 0xa7, 0x81, 0x4e, 0x2c, 0x91, 0xfd, 0xbe, 0xef, 0xbd,
-	0xfb, 0xde, 0xe7, 0xf7, 0x2e, 0xb0, 0x4c, 0x59, 0xcb, 0x25, 0x06, 0xee, 0x09, 0x66, 0x59, 0xa2,
-	0x6b, 0xf4, 0x57, 0x8c, 0x27, 0x3d, 0xe2, 0x0d, 0x6a, 0x5d, 0x8f, 0x09, 0x86, 0x4a, 0x12, 0xae,
-	0x45, 0x70, 0xad, 0xbf, 0xa2, 0x96, 0x70, 0xc7, 0xa1, 0xcc, 0x90, 0xbf, 0x01, 0x4b, 0x5d, 0xb0,
-	0x18, 0xef, 0x30, 0x1e, 0x64, 0x9e, 0x2a, 0xa1, 0x5e, 0x0a, 0xc0, 0xa6, 0x7c, 0x33, 0x82, 0x97,
-	0x10, 0x9a, 0x6f, 0xb3, 0x36, 0x0b, 0xe2, 0xfe, 0x53, 0x18, 0xbd, 0xdc, 0x66, 0xac, 0xed, 0x6b,
-	0xea, 0x3a, 0x06, 0xa6, 0x94, 0x09, 0x2c, 0x1c, 0x46, 0xc3, 0x1c, 0xfd, 0x13, 0x80, 0x33, 0xf7,
-	0xfc, 0xf2, 0xeb, 0xb6, 0xed, 0x11, 0xce, 0xd1, 0x32, 0x44, 0x36, 0xe1, 0xc2, 0xa1, 0x92, 0xd6,
-	0xb4, 0x59, 0x07, 0x3b, 0x54, 0x01, 0x8b, 0x60, 0x69, 0xd6, 0x2c, 0xa5, 0x90, 0x0d, 0x09, 0xa0,
-	0x2a, 0xbc, 0xd0, 0x71, 0xa8, 0x68, 0x7a, 0xc4, 0x72, 0xba, 0x0e, 0xa1, 0x42, 0xc9, 0x2f, 0x82,
-	0xa5, 0x29, 0x73, 0xd6, 0x8f, 0x9a, 0x51, 0xd0, 0xaf, 0xba, 0x85, 0x5d, 0xb7, 0x85, 0xad, 0xed,
-	0x14, 0xb5, 0x20, 0xa9, 0xa5, 0x08, 0x39, 0x41, 0x4f, 0x8b, 0xb0, 0xb0, 0xeb, 0x12, 0x4f, 0x19,
-	0x0b, 0xe8, 0x29, 0xe4, 0x8e, 0x04, 0xd6, 0x26, 0xf7, 0xf6, 0x2b, 0xb9, 0x5f, 0xfb, 0x95, 0x9c,
-	0xee, 0xc0, 0xf9, 0x74, 0x37, 0x26, 0xe1, 0x5d, 0x46, 0x39, 0x41, 0x75, 0x38, 0x81, 0x83, 0x90,
-	0x6c, 0x65, 0xaa, 0xa1, 0x7c, 0x7e, 0xbf, 0x3c, 0x1f, 0xba, 0x17, 0x92, 0x37, 0x85, 0xe7, 0xd0,
-	0xb6, 0x19, 0x11, 0x51, 0x19, 0x8e, 0x93, 0xa7, 0x0e, 0x17, 0x5c, 0xb6, 0x34, 0xd9, 0x28, 0xbe,
-	0xfd, 0xf9, 0xee, 0x3a, 0x30, 0xc3, 0xa0, 0x3e, 0x03, 0xa1, 0x3c, 0x6a, 0x53, 0x60, 0xc1, 0xf5,
-	0x17, 0x79, 0x88, 0x92, 0xd7, 0xf8, 0xdc, 0x67, 0x00, 0x2a, 0x59, 0x3b, 0x9b, 0xdc, 0x27, 0x29,
-	0x60, 0xb1, 0xb0, 0x34, 0x5d, 0x5f, 0xaf, 0x65, 0x86, 0xa2, 0x96, 0xad, 0x54, 0xdb, 0x38, 0x6d,
-	0xbd, 0x84, 0xef, 0x52, 0xe1, 0x0d, 0x1a, 0x63, 0x07, 0xdf, 0x2a, 0x39, 0xf3, 0xa2, 0x3d, 0x94,
-	0xa2, 0x3a, 0x70, 0x61, 0x44, 0x32, 0x9a, 0x83, 0x85, 0x6d, 0x32, 0x08, 0xbf, 0xb0, 0xff, 0x88,
-	0x6e, 0xc3, 0x62, 0x1f, 0xbb, 0x3d, 0x22, 0xfb, 0x9e, 0xae, 0x6b, 0x43, 0x04, 0xa6, 0xaa, 0x98,
-	0x01, 0x79, 0x2d, 0xbf, 0x0a, 0xf4, 0x97, 0x00, 0x4e, 0xa7, 0x20, 0x74, 0x05, 0x4e, 0x62, 0xcb,
-	0x62, 0x3d, 0x2a, 0x02, 0xdf, 0xc7, 0x22, 0x13, 0xe3, 0x30, 0xba, 0x0a, 0xa7, 0x84, 0x87, 0x29,
-	0xdf, 0x22, 0x5e, 0x60, 0x74, 0xcc, 0x49, 0xe2, 0xa8, 0x0e, 0x4b, 0x82, 0x09, 0xec, 0x36, 0xa3,
-	0x90, 0x47, 0x6c, 0x39, 0x3d, 0x31, 0x79, 0x4e, 0xe2, 0xf7, 0x13, 0x58, 0x7f, 0x04, 0xcb, 0x89,
-	0x8d, 0x8d, 0x41, 0xc6, 0x82, 0x73, 0x4e, 0x7a, 0x6a, 0xc8, 0xde, 0x00, 0x58, 0x1d, 0x59, 0x3a,
-	0xfe, 0xfc, 0xff, 0xb1, 0xff, 0xfa, 0xef, 0x02, 0x2c, 0x4a, 0x95, 0xe8, 0x03, 0x80, 0x13, 0xd1,
-	0x7a, 0x57, 0xce, 0x9a, 0xb6, 0x90, 0xa0, 0x5e, 0xfb, 0x0b, 0x21, 0xea, 0x4d, 0x6f, 0xed, 0xf9,
-	0x07, 0x3f, 0xff, 0xf2, 0xe3, 0x75, 0xfe, 0x21, 0x7a, 0x60, 0x64, 0x2f, 0xbe, 0x70, 0x8f, 0x8c,
-	0x9d, 0xac, 0xcb, 0xbb, 0xc6, 0xce, 0xc9, 0x5b, 0x63, 0xd7, 0xd8, 0xc9, 0xde, 0x0f, 0xbb, 0x48,
-	0xc0, 0x62, 0x30, 0x48, 0xe5, 0x91, 0x4b, 0xa2, 0x56, 0xff, 0x69, 0x87, 0xf4, 0x6a, 0x22, 0x59,
-	0x45, 0xca, 0x10, 0xc9, 0x72, 0x2f, 0xd1, 0x47, 0x00, 0x95, 0x33, 0xa7, 0xe6, 0xe6, 0xc8, 0xa3,
-	0x86, 0x64, 0xa8, 0xab, 0xe7, 0xcd, 0x88, 0xf5, 0xae, 0x25, 0x7a, 0x0d, 0xb4, 0x7c, 0x96, 0xde,
-	0xa1, 0x06, 0x37, 0x6e, 0x1c, 0x1c, 0x69, 0xe0, 0xf0, 0x48, 0x03, 0xdf, 0x8f, 0x34, 0xf0, 0xea,
-	0x58, 0xcb, 0x1d, 0x1e, 0x6b, 0xb9, 0xaf, 0xc7, 0x5a, 0xee, 0x31, 0x8a, 0x85, 0xd8, 0xa4, 0x6f,
-	0x88, 0x41, 0x97, 0xf0, 0xd6, 0xb8, 0xfc, 0x37, 0xb8, 0xf5, 0x27, 0x00, 0x00, 0xff, 0xff, 0x94,
-	0x69, 0x73, 0x2d, 0xc0, 0x06, 0x00, 0x00,
+// QueryStatsHistory is the request message for querying the statistics history of a
+// destination domain.
+type QueryStatsHistory struct {
+	// The destination domain for which the statistics history is requested.
+	DestinationDomain uint32 `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// If set, only the buckets starting at or after this time are returned.
+	StartTime *time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// If set, only the buckets starting before this time are returned.
+	EndTime    *time.Time         `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryStatsHistory) Reset()         { *m = QueryStatsHistory{} }
+func (m *QueryStatsHistory) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsHistory) ProtoMessage()    {}
+func (*QueryStatsHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{7}
+}
+func (m *QueryStatsHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatsHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatsHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStatsHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsHistory.Merge(m, src)
+}
+func (m *QueryStatsHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatsHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsHistory.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryStatsHistory proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries Address.
-	Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error)
-	// Queries Stats.
-	Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error)
-	// Queries StatsByDestinationDomain.
-	StatsByDestinationDomain(ctx context.Context, in *QueryStatsByDestinationDomain, opts ...grpc.CallOption) (*QueryStatsByDestinationDomainResponse, error)
+func (m *QueryStatsHistory) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryStatsHistory) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryStatsHistory) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
 }
 
-func (c *queryClient) Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error) {
-	out := new(QueryAddressResponse)
-	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/Address", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStatsHistory) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
-	out := new(QueryStatsResponse)
-	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/Stats", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryStatsHistoryResponse is the response message containing the statistics history of a
+// destination domain, ordered by start time.
+type QueryStatsHistoryResponse struct {
+	Buckets    []StatsBucket       `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStatsHistoryResponse) Reset()         { *m = QueryStatsHistoryResponse{} }
+func (m *QueryStatsHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStatsHistoryResponse) ProtoMessage()    {}
+func (*QueryStatsHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{8}
+}
+func (m *QueryStatsHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStatsHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStatsHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryStatsHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStatsHistoryResponse.Merge(m, src)
+}
+func (m *QueryStatsHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStatsHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStatsHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) StatsByDestinationDomain(ctx context.Context, in *QueryStatsByDestinationDomain, opts ...grpc.CallOption) (*QueryStatsByDestinationDomainResponse, error) {
-	out := new(QueryStatsByDestinationDomainResponse)
-	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/StatsByDestinationDomain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStatsHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryStatsHistoryResponse) GetBuckets() []StatsBucket {
+	if m != nil {
+		return m.Buckets
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries Address.
-	Address(context.Context, *QueryAddress) (*QueryAddressResponse, error)
-	// Queries Stats.
-	Stats(context.Context, *QueryStats) (*QueryStatsResponse, error)
-	// Queries StatsByDestinationDomain.
-	StatsByDestinationDomain(context.Context, *QueryStatsByDestinationDomain) (*QueryStatsByDestinationDomainResponse, error)
+func (m *QueryStatsHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryParams is the request message for querying the module parameters.
+type QueryParams struct {
 }
 
-func (*UnimplementedQueryServer) Address(ctx context.Context, req *QueryAddress) (*QueryAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Address not implemented")
+func (m *QueryParams) Reset()         { *m = QueryParams{} }
+func (m *QueryParams) String() string { return proto.CompactTextString(m) }
+func (*QueryParams) ProtoMessage()    {}
+func (*QueryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{9}
 }
-func (*UnimplementedQueryServer) Stats(ctx context.Context, req *QueryStats) (*QueryStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+func (m *QueryParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) StatsByDestinationDomain(ctx context.Context, req *QueryStatsByDestinationDomain) (*QueryStatsByDestinationDomainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StatsByDestinationDomain not implemented")
+func (m *QueryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParams.Merge(m, src)
+}
+func (m *QueryParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParams.DiscardUnknown(m)
 }
 
-func _Query_Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAddress)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Address(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.autocctp.v1.Query/Address",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Address(ctx, req.(*QueryAddress))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryParams proto.InternalMessageInfo
+
+// QueryParamsResponse is the response message containing the module parameters.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStats)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Stats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.autocctp.v1.Query/Stats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Stats(ctx, req.(*QueryStats))
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{10}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func _Query_StatsByDestinationDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatsByDestinationDomain)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StatsByDestinationDomain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.autocctp.v1.Query/StatsByDestinationDomain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StatsByDestinationDomain(ctx, req.(*QueryStatsByDestinationDomain))
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return Params{}
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.autocctp.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Address",
-			Handler:    _Query_Address_Handler,
-		},
-		{
-			MethodName: "Stats",
-			Handler:    _Query_Stats_Handler,
-		},
-		{
-			MethodName: "StatsByDestinationDomain",
-			Handler:    _Query_StatsByDestinationDomain_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/autocctp/v1/query.proto",
+// QueryDomains is the request message for querying the destination domains registry.
+type QueryDomains struct {
 }
 
-func (m *QueryAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDomains) Reset()         { *m = QueryDomains{} }
+func (m *QueryDomains) String() string { return proto.CompactTextString(m) }
+func (*QueryDomains) ProtoMessage()    {}
+func (*QueryDomains) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{11}
+}
+func (m *QueryDomains) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomains) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomains.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryDomains) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomains.Merge(m, src)
+}
+func (m *QueryDomains) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomains) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomains.DiscardUnknown(m)
 }
 
-func (m *QueryAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDomains proto.InternalMessageInfo
+
+// QueryDomainsResponse is the response message containing all the entries of the
+// destination domains registry.
+type QueryDomainsResponse struct {
+	Domains []DomainConfig `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains"`
 }
 
-func (m *QueryAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DestinationCaller) > 0 {
-		i -= len(m.DestinationCaller)
-		copy(dAtA[i:], m.DestinationCaller)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationCaller)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.FallbackRecipient) > 0 {
-		i -= len(m.FallbackRecipient)
-		copy(dAtA[i:], m.FallbackRecipient)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FallbackRecipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MintRecipient) > 0 {
-		i -= len(m.MintRecipient)
-		copy(dAtA[i:], m.MintRecipient)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MintRecipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.DestinationDomain != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DestinationDomain))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryDomainsResponse) Reset()         { *m = QueryDomainsResponse{} }
+func (m *QueryDomainsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainsResponse) ProtoMessage()    {}
+func (*QueryDomainsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{12}
+}
+func (m *QueryDomainsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDomainsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainsResponse.Merge(m, src)
+}
+func (m *QueryDomainsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDomainsResponse proto.InternalMessageInfo
+
+func (m *QueryDomainsResponse) GetDomains() []DomainConfig {
+	if m != nil {
+		return m.Domains
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDomain is the request message for querying a specific entry of the destination
+// domains registry.
+type QueryDomain struct {
+	// The destination domain for which the registry entry is requested.
+	DestinationDomain uint32 `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
 }
 
-func (m *QueryAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Exists {
-		i--
-		if m.Exists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryDomain) Reset()         { *m = QueryDomain{} }
+func (m *QueryDomain) String() string { return proto.CompactTextString(m) }
+func (*QueryDomain) ProtoMessage()    {}
+func (*QueryDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{13}
+}
+func (m *QueryDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomain.Merge(m, src)
 }
-
-func (m *QueryStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDomain) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomain.DiscardUnknown(m)
 }
 
-func (m *QueryStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryDomain proto.InternalMessageInfo
 
-func (m *QueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDomainResponse is the response message containing a destination domains
+// registry entry.
+type QueryDomainResponse struct {
+	Domain DomainConfig `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
 }
 
-func (m *QueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DestinationDomainStats) > 0 {
-		for k := range m.DestinationDomainStats {
-			v := m.DestinationDomainStats[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintQuery(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDomainResponse) Reset()         { *m = QueryDomainResponse{} }
+func (m *QueryDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDomainResponse) ProtoMessage()    {}
+func (*QueryDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{14}
+}
+func (m *QueryDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDomainResponse.Merge(m, src)
+}
+func (m *QueryDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDomainResponse.DiscardUnknown(m)
 }
 
-func (m *DomainStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDomainResponse proto.InternalMessageInfo
+
+func (m *QueryDomainResponse) GetDomain() DomainConfig {
+	if m != nil {
+		return m.Domain
 	}
-	return dAtA[:n], nil
+	return DomainConfig{}
 }
 
-func (m *DomainStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFailedTransfers is the request message for querying the failed transfers.
+type QueryFailedTransfers struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *DomainStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TotalTransferred != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalTransferred))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Transfers != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Transfers))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Accounts != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Accounts))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryFailedTransfers) Reset()         { *m = QueryFailedTransfers{} }
+func (m *QueryFailedTransfers) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedTransfers) ProtoMessage()    {}
+func (*QueryFailedTransfers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{15}
+}
+func (m *QueryFailedTransfers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedTransfers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedTransfers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFailedTransfers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedTransfers.Merge(m, src)
+}
+func (m *QueryFailedTransfers) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedTransfers) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedTransfers.DiscardUnknown(m)
 }
 
-func (m *QueryStatsByDestinationDomain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFailedTransfers proto.InternalMessageInfo
+
+func (m *QueryFailedTransfers) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStatsByDestinationDomain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFailedTransfersResponse is the response message containing the failed transfers.
+type QueryFailedTransfersResponse struct {
+	FailedTransfers []FailedTransfer    `protobuf:"bytes,1,rep,name=failed_transfers,json=failedTransfers,proto3" json:"failed_transfers"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStatsByDestinationDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DestinationDomain != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.DestinationDomain))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryFailedTransfersResponse) Reset()         { *m = QueryFailedTransfersResponse{} }
+func (m *QueryFailedTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedTransfersResponse) ProtoMessage()    {}
+func (*QueryFailedTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{16}
+}
+func (m *QueryFailedTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFailedTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedTransfersResponse.Merge(m, src)
+}
+func (m *QueryFailedTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedTransfersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatsByDestinationDomainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFailedTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryFailedTransfersResponse) GetFailedTransfers() []FailedTransfer {
+	if m != nil {
+		return m.FailedTransfers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStatsByDestinationDomainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFailedTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryStatsByDestinationDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TotalTransferred != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalTransferred))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Transfers != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Transfers))
-		i--
-		dAtA[i] = 0x10
+// QueryFailedTransfer is the request message for querying the failed transfer of a
+// specific AutoCCTP account.
+type QueryFailedTransfer struct {
+	// The AutoCCTP account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryFailedTransfer) Reset()         { *m = QueryFailedTransfer{} }
+func (m *QueryFailedTransfer) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedTransfer) ProtoMessage()    {}
+func (*QueryFailedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{17}
+}
+func (m *QueryFailedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Accounts != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Accounts))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryFailedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedTransfer.Merge(m, src)
+}
+func (m *QueryFailedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedTransfer proto.InternalMessageInfo
+
+// QueryFailedTransferResponse is the response message containing the failed transfer
+// of an AutoCCTP account.
+type QueryFailedTransferResponse struct {
+	FailedTransfer FailedTransfer `protobuf:"bytes,1,opt,name=failed_transfer,json=failedTransfer,proto3" json:"failed_transfer"`
+}
+
+func (m *QueryFailedTransferResponse) Reset()         { *m = QueryFailedTransferResponse{} }
+func (m *QueryFailedTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedTransferResponse) ProtoMessage()    {}
+func (*QueryFailedTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{18}
+}
+func (m *QueryFailedTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFailedTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedTransferResponse.Merge(m, src)
+}
+func (m *QueryFailedTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedTransferResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryFailedTransferResponse proto.InternalMessageInfo
+
+func (m *QueryFailedTransferResponse) GetFailedTransfer() FailedTransfer {
+	if m != nil {
+		return m.FailedTransfer
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return FailedTransfer{}
 }
-func (m *QueryAddress) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryTransferHistory is the request message for querying the transfer history of an
+// AutoCCTP account.
+type QueryTransferHistory struct {
+	// The AutoCCTP account address.
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTransferHistory) Reset()         { *m = QueryTransferHistory{} }
+func (m *QueryTransferHistory) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferHistory) ProtoMessage()    {}
+func (*QueryTransferHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{19}
+}
+func (m *QueryTransferHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.DestinationDomain != 0 {
-		n += 1 + sovQuery(uint64(m.DestinationDomain))
+}
+func (m *QueryTransferHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferHistory.Merge(m, src)
+}
+func (m *QueryTransferHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferHistory proto.InternalMessageInfo
+
+func (m *QueryTransferHistory) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	l = len(m.MintRecipient)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryTransferHistory) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	l = len(m.FallbackRecipient)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryTransferHistoryResponse is the response message containing the transfer history
+// of an AutoCCTP account.
+type QueryTransferHistoryResponse struct {
+	Records    []TransferRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTransferHistoryResponse) Reset()         { *m = QueryTransferHistoryResponse{} }
+func (m *QueryTransferHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferHistoryResponse) ProtoMessage()    {}
+func (*QueryTransferHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{20}
+}
+func (m *QueryTransferHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.DestinationCaller)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryTransferHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferHistoryResponse.Merge(m, src)
+}
+func (m *QueryTransferHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryTransferHistoryResponse) GetRecords() []TransferRecord {
+	if m != nil {
+		return m.Records
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTransferHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryAccount is the request message for querying an AutoCCTP account.
+type QueryAccount struct {
+	// The AutoCCTP account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccount) Reset()         { *m = QueryAccount{} }
+func (m *QueryAccount) String() string { return proto.CompactTextString(m) }
+func (*QueryAccount) ProtoMessage()    {}
+func (*QueryAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{21}
+}
+func (m *QueryAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Exists {
-		n += 2
+}
+func (m *QueryAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccount.Merge(m, src)
+}
+func (m *QueryAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccount proto.InternalMessageInfo
+
+// QueryAccountResponse is the response message containing an AutoCCTP account and its
+// balance.
+type QueryAccountResponse struct {
+	Account Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	// The balance of the minting denom held by the account.
+	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *QueryAccountResponse) Reset()         { *m = QueryAccountResponse{} }
+func (m *QueryAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountResponse) ProtoMessage()    {}
+func (*QueryAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{22}
+}
+func (m *QueryAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountResponse.Merge(m, src)
+}
+func (m *QueryAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStats) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryAccountResponse proto.InternalMessageInfo
+
+func (m *QueryAccountResponse) GetAccount() Account {
+	if m != nil {
+		return m.Account
 	}
-	var l int
-	_ = l
-	return n
+	return Account{}
 }
 
-func (m *QueryStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
 	}
-	var l int
-	_ = l
-	if len(m.DestinationDomainStats) > 0 {
-		for k, v := range m.DestinationDomainStats {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + l + sovQuery(uint64(l))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+	return types.Coin{}
+}
+
+// QueryAccounts is the request message for querying the registered AutoCCTP accounts. All
+// the filters are optional.
+type QueryAccounts struct {
+	// If specified, only the accounts associated with the destination domain are returned.
+	// The value is a string to distinguish the domain 0 from an unspecified filter.
+	DestinationDomain string `protobuf:"bytes,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// If specified, only the accounts associated with the mint recipient are returned. The
+	// address is parsed according to the destination domain encoding, or as an hex address if
+	// the destination domain is not specified.
+	MintRecipient string `protobuf:"bytes,2,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+	// If specified, only the accounts associated with the fallback recipient are returned.
+	FallbackRecipient string             `protobuf:"bytes,3,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
+	Pagination        *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccounts) Reset()         { *m = QueryAccounts{} }
+func (m *QueryAccounts) String() string { return proto.CompactTextString(m) }
+func (*QueryAccounts) ProtoMessage()    {}
+func (*QueryAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{23}
+}
+func (m *QueryAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccounts.Merge(m, src)
+}
+func (m *QueryAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccounts.DiscardUnknown(m)
 }
 
-func (m *DomainStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Accounts != 0 {
-		n += 1 + sovQuery(uint64(m.Accounts))
-	}
-	if m.Transfers != 0 {
-		n += 1 + sovQuery(uint64(m.Transfers))
+var xxx_messageInfo_QueryAccounts proto.InternalMessageInfo
+
+func (m *QueryAccounts) GetDestinationDomain() string {
+	if m != nil {
+		return m.DestinationDomain
 	}
-	if m.TotalTransferred != 0 {
-		n += 1 + sovQuery(uint64(m.TotalTransferred))
+	return ""
+}
+
+func (m *QueryAccounts) GetMintRecipient() string {
+	if m != nil {
+		return m.MintRecipient
 	}
-	return n
+	return ""
 }
 
-func (m *QueryStatsByDestinationDomain) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccounts) GetFallbackRecipient() string {
+	if m != nil {
+		return m.FallbackRecipient
 	}
-	var l int
+	return ""
+}
+
+func (m *QueryAccounts) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountsResponse is the response message containing the registered AutoCCTP accounts.
+type QueryAccountsResponse struct {
+	Accounts   []Account           `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountsResponse) Reset()         { *m = QueryAccountsResponse{} }
+func (m *QueryAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsResponse) ProtoMessage()    {}
+func (*QueryAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{24}
+}
+func (m *QueryAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountsResponse.Merge(m, src)
+}
+func (m *QueryAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountsResponse) GetAccounts() []Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAwaitingTransfers is the request message for querying the AutoCCTP accounts holding
+// at least the minimum transfer amount whose transfer was deferred, or did not complete, at
+// the end of a block.
+type QueryAwaitingTransfers struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAwaitingTransfers) Reset()         { *m = QueryAwaitingTransfers{} }
+func (m *QueryAwaitingTransfers) String() string { return proto.CompactTextString(m) }
+func (*QueryAwaitingTransfers) ProtoMessage()    {}
+func (*QueryAwaitingTransfers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{25}
+}
+func (m *QueryAwaitingTransfers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAwaitingTransfers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAwaitingTransfers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAwaitingTransfers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAwaitingTransfers.Merge(m, src)
+}
+func (m *QueryAwaitingTransfers) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAwaitingTransfers) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAwaitingTransfers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAwaitingTransfers proto.InternalMessageInfo
+
+func (m *QueryAwaitingTransfers) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAwaitingTransfersResponse is the response message containing the AutoCCTP accounts
+// awaiting the execution of the automatic transfer.
+type QueryAwaitingTransfersResponse struct {
+	AwaitingTransfers []AwaitingTransfer  `protobuf:"bytes,1,rep,name=awaiting_transfers,json=awaitingTransfers,proto3" json:"awaiting_transfers"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAwaitingTransfersResponse) Reset()         { *m = QueryAwaitingTransfersResponse{} }
+func (m *QueryAwaitingTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAwaitingTransfersResponse) ProtoMessage()    {}
+func (*QueryAwaitingTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{26}
+}
+func (m *QueryAwaitingTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAwaitingTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAwaitingTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAwaitingTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAwaitingTransfersResponse.Merge(m, src)
+}
+func (m *QueryAwaitingTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAwaitingTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAwaitingTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAwaitingTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryAwaitingTransfersResponse) GetAwaitingTransfers() []AwaitingTransfer {
+	if m != nil {
+		return m.AwaitingTransfers
+	}
+	return nil
+}
+
+func (m *QueryAwaitingTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryQueuedTransfers is the request message for querying the automatic transfers queued
+// for execution in the following blocks.
+type QueryQueuedTransfers struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedTransfers) Reset()         { *m = QueryQueuedTransfers{} }
+func (m *QueryQueuedTransfers) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedTransfers) ProtoMessage()    {}
+func (*QueryQueuedTransfers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{27}
+}
+func (m *QueryQueuedTransfers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedTransfers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedTransfers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedTransfers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedTransfers.Merge(m, src)
+}
+func (m *QueryQueuedTransfers) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedTransfers) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedTransfers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedTransfers proto.InternalMessageInfo
+
+func (m *QueryQueuedTransfers) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryQueuedTransfersResponse is the response message containing the queued transfers,
+// in the order of execution.
+type QueryQueuedTransfersResponse struct {
+	QueuedTransfers []QueuedTransfer    `protobuf:"bytes,1,rep,name=queued_transfers,json=queuedTransfers,proto3" json:"queued_transfers"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedTransfersResponse) Reset()         { *m = QueryQueuedTransfersResponse{} }
+func (m *QueryQueuedTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedTransfersResponse) ProtoMessage()    {}
+func (*QueryQueuedTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{28}
+}
+func (m *QueryQueuedTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedTransfersResponse.Merge(m, src)
+}
+func (m *QueryQueuedTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedTransfersResponse) GetQueuedTransfers() []QueuedTransfer {
+	if m != nil {
+		return m.QueuedTransfers
+	}
+	return nil
+}
+
+func (m *QueryQueuedTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPaused is the request message for querying the pause state of the automatic transfers.
+type QueryPaused struct {
+}
+
+func (m *QueryPaused) Reset()         { *m = QueryPaused{} }
+func (m *QueryPaused) String() string { return proto.CompactTextString(m) }
+func (*QueryPaused) ProtoMessage()    {}
+func (*QueryPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{29}
+}
+func (m *QueryPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaused.Merge(m, src)
+}
+func (m *QueryPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaused proto.InternalMessageInfo
+
+// QueryPausedResponse is the response message containing the pause state of the automatic
+// transfers.
+type QueryPausedResponse struct {
+	// A flag indicating whether the automatic transfers are paused for all destination domains.
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// The destination domains for which the automatic transfers are paused.
+	PausedDestinationDomains []uint32 `protobuf:"varint,2,rep,packed,name=paused_destination_domains,json=pausedDestinationDomains,proto3" json:"paused_destination_domains,omitempty"`
+}
+
+func (m *QueryPausedResponse) Reset()         { *m = QueryPausedResponse{} }
+func (m *QueryPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedResponse) ProtoMessage()    {}
+func (*QueryPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_483d98375be4f886, []int{30}
+}
+func (m *QueryPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedResponse.Merge(m, src)
+}
+func (m *QueryPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedResponse proto.InternalMessageInfo
+
+func (m *QueryPausedResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *QueryPausedResponse) GetPausedDestinationDomains() []uint32 {
+	if m != nil {
+		return m.PausedDestinationDomains
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryAddress)(nil), "noble.autocctp.v1.QueryAddress")
+	proto.RegisterType((*QueryAddressResponse)(nil), "noble.autocctp.v1.QueryAddressResponse")
+	proto.RegisterType((*QueryStats)(nil), "noble.autocctp.v1.QueryStats")
+	proto.RegisterType((*QueryStatsResponse)(nil), "noble.autocctp.v1.QueryStatsResponse")
+	proto.RegisterMapType((map[uint32]DomainStats)(nil), "noble.autocctp.v1.QueryStatsResponse.DestinationDomainStatsEntry")
+	proto.RegisterType((*DomainStats)(nil), "noble.autocctp.v1.DomainStats")
+	proto.RegisterType((*QueryStatsByDestinationDomain)(nil), "noble.autocctp.v1.QueryStatsByDestinationDomain")
+	proto.RegisterType((*QueryStatsByDestinationDomainResponse)(nil), "noble.autocctp.v1.QueryStatsByDestinationDomainResponse")
+	proto.RegisterType((*QueryStatsHistory)(nil), "noble.autocctp.v1.QueryStatsHistory")
+	proto.RegisterType((*QueryStatsHistoryResponse)(nil), "noble.autocctp.v1.QueryStatsHistoryResponse")
+	proto.RegisterType((*QueryParams)(nil), "noble.autocctp.v1.QueryParams")
+	proto.RegisterType((*QueryParamsResponse)(nil), "noble.autocctp.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryDomains)(nil), "noble.autocctp.v1.QueryDomains")
+	proto.RegisterType((*QueryDomainsResponse)(nil), "noble.autocctp.v1.QueryDomainsResponse")
+	proto.RegisterType((*QueryDomain)(nil), "noble.autocctp.v1.QueryDomain")
+	proto.RegisterType((*QueryDomainResponse)(nil), "noble.autocctp.v1.QueryDomainResponse")
+	proto.RegisterType((*QueryFailedTransfers)(nil), "noble.autocctp.v1.QueryFailedTransfers")
+	proto.RegisterType((*QueryFailedTransfersResponse)(nil), "noble.autocctp.v1.QueryFailedTransfersResponse")
+	proto.RegisterType((*QueryFailedTransfer)(nil), "noble.autocctp.v1.QueryFailedTransfer")
+	proto.RegisterType((*QueryFailedTransferResponse)(nil), "noble.autocctp.v1.QueryFailedTransferResponse")
+	proto.RegisterType((*QueryTransferHistory)(nil), "noble.autocctp.v1.QueryTransferHistory")
+	proto.RegisterType((*QueryTransferHistoryResponse)(nil), "noble.autocctp.v1.QueryTransferHistoryResponse")
+	proto.RegisterType((*QueryAccount)(nil), "noble.autocctp.v1.QueryAccount")
+	proto.RegisterType((*QueryAccountResponse)(nil), "noble.autocctp.v1.QueryAccountResponse")
+	proto.RegisterType((*QueryAccounts)(nil), "noble.autocctp.v1.QueryAccounts")
+	proto.RegisterType((*QueryAccountsResponse)(nil), "noble.autocctp.v1.QueryAccountsResponse")
+	proto.RegisterType((*QueryAwaitingTransfers)(nil), "noble.autocctp.v1.QueryAwaitingTransfers")
+	proto.RegisterType((*QueryAwaitingTransfersResponse)(nil), "noble.autocctp.v1.QueryAwaitingTransfersResponse")
+	proto.RegisterType((*QueryQueuedTransfers)(nil), "noble.autocctp.v1.QueryQueuedTransfers")
+	proto.RegisterType((*QueryQueuedTransfersResponse)(nil), "noble.autocctp.v1.QueryQueuedTransfersResponse")
+	proto.RegisterType((*QueryPaused)(nil), "noble.autocctp.v1.QueryPaused")
+	proto.RegisterType((*QueryPausedResponse)(nil), "noble.autocctp.v1.QueryPausedResponse")
+}
+
+func init() { proto.RegisterFile("noble/autocctp/v1/query.proto", fileDescriptor_483d98375be4f886) }
+
+var fileDescriptor_483d98375be4f886 = []byte{
+	// 1868 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xdd, 0x6f, 0x23, 0x57,
+	0x15, 0xcf, 0xc4, 0x4e, 0xec, 0x1c, 0x6f, 0x92, 0xf5, 0xed, 0x76, 0xe5, 0x4c, 0x36, 0xb6, 0x3b,
+	0xdb, 0x6c, 0x4c, 0xe9, 0x7a, 0x36, 0x06, 0x44, 0xb5, 0xac, 0xa8, 0xe2, 0x2d, 0xa1, 0x8b, 0x2a,
+	0xb5, 0x9d, 0x4d, 0x69, 0xe1, 0x01, 0x73, 0x6d, 0xdf, 0x38, 0x43, 0xec, 0x19, 0x67, 0xe6, 0x3a,
+	0x6c, 0x14, 0x22, 0x51, 0x78, 0x60, 0x9f, 0x50, 0x05, 0x0f, 0x3c, 0x81, 0xfa, 0x50, 0x3e, 0xc4,
+	0x13, 0x0f, 0x95, 0x78, 0xe0, 0x0d, 0x78, 0xe8, 0x63, 0x55, 0x5e, 0x10, 0x42, 0x0b, 0xda, 0x45,
+	0x82, 0x27, 0xfe, 0x06, 0x34, 0xf7, 0x63, 0x66, 0x3c, 0x1f, 0x8e, 0x93, 0xa6, 0x48, 0x48, 0xbc,
+	0x44, 0x9e, 0x7b, 0x7e, 0xe7, 0xde, 0xdf, 0xf9, 0xb8, 0xf7, 0x9c, 0x7b, 0x03, 0x6b, 0x96, 0xdd,
+	0xee, 0x13, 0x1d, 0x8f, 0xa8, 0xdd, 0xe9, 0xd0, 0xa1, 0x7e, 0xb8, 0xa9, 0x1f, 0x8c, 0x88, 0x73,
+	0x54, 0x1f, 0x3a, 0x36, 0xb5, 0x51, 0x91, 0x89, 0xeb, 0x52, 0x5c, 0x3f, 0xdc, 0x54, 0x8b, 0x78,
+	0x60, 0x5a, 0xb6, 0xce, 0xfe, 0x72, 0x94, 0xfa, 0x5c, 0xc7, 0x76, 0x07, 0xb6, 0xab, 0xb7, 0xb1,
+	0x4b, 0xb8, 0xba, 0x7e, 0xb8, 0xd9, 0x26, 0x14, 0x6f, 0xea, 0x43, 0xdc, 0x33, 0x2d, 0x4c, 0x4d,
+	0xdb, 0x12, 0xd8, 0x72, 0x18, 0x2b, 0x51, 0x1d, 0xdb, 0x94, 0xf2, 0x55, 0x21, 0x97, 0xd3, 0x84,
+	0xe9, 0xa8, 0x2b, 0x5c, 0xd8, 0x62, 0x5f, 0x3a, 0xff, 0x10, 0xa2, 0x2b, 0x3d, 0xbb, 0x67, 0xf3,
+	0x71, 0xef, 0x97, 0x18, 0xbd, 0xd6, 0xb3, 0xed, 0x9e, 0x67, 0xdf, 0xd0, 0xd4, 0xb1, 0x65, 0xd9,
+	0x94, 0x51, 0x91, 0x3a, 0x15, 0x21, 0x65, 0x5f, 0xed, 0xd1, 0xae, 0x4e, 0xcd, 0x01, 0x71, 0x29,
+	0x1e, 0x0c, 0x25, 0x20, 0xee, 0x1d, 0xdc, 0xe9, 0xd8, 0x23, 0x8b, 0x4a, 0x6b, 0xe2, 0x80, 0xae,
+	0x3d, 0xc0, 0xbe, 0x35, 0x09, 0xf2, 0x21, 0x76, 0xf0, 0x40, 0x32, 0xa8, 0xc6, 0xe5, 0xd4, 0xc1,
+	0x96, 0xbb, 0x4b, 0x1c, 0x8e, 0xd0, 0xde, 0xce, 0xc0, 0xa5, 0xd7, 0x3d, 0x17, 0x6c, 0x75, 0xbb,
+	0x0e, 0x71, 0x5d, 0x74, 0x13, 0x50, 0x97, 0xb8, 0x54, 0x78, 0xb5, 0xc5, 0x97, 0x2b, 0x29, 0x55,
+	0xa5, 0xb6, 0x68, 0x14, 0x43, 0x92, 0x97, 0x98, 0x00, 0xad, 0xc3, 0xd2, 0xc0, 0xb4, 0x68, 0xcb,
+	0x21, 0x1d, 0x73, 0x68, 0x12, 0x8b, 0x96, 0x66, 0xab, 0x4a, 0x6d, 0xc1, 0x58, 0xf4, 0x46, 0x0d,
+	0x39, 0xe8, 0xcd, 0xba, 0x8b, 0xfb, 0xfd, 0x36, 0xee, 0xec, 0x87, 0xa0, 0x19, 0x06, 0x2d, 0x4a,
+	0xc9, 0x18, 0x3c, 0x4c, 0xa2, 0x83, 0xfb, 0x7d, 0xe2, 0x94, 0xb2, 0x1c, 0x1e, 0x92, 0xdc, 0x65,
+	0x02, 0xf4, 0x69, 0x28, 0x92, 0x07, 0x43, 0xd3, 0xe1, 0xe8, 0x3d, 0x62, 0xf6, 0xf6, 0x68, 0x69,
+	0xae, 0xaa, 0xd4, 0xb2, 0xc6, 0xe5, 0x40, 0xf0, 0x32, 0x1b, 0x47, 0xf7, 0x60, 0x39, 0x04, 0xf6,
+	0x42, 0x52, 0x9a, 0xaf, 0x2a, 0xb5, 0x42, 0x43, 0xad, 0xf3, 0x78, 0xd5, 0x65, 0xbc, 0xea, 0x3b,
+	0x32, 0x5e, 0xcd, 0xec, 0x3b, 0x7f, 0xab, 0x28, 0xc6, 0x52, 0xa0, 0xe8, 0x89, 0xd0, 0x06, 0x2c,
+	0x63, 0xee, 0xb6, 0xd6, 0x21, 0x71, 0x5c, 0xd3, 0xb6, 0x4a, 0x39, 0xe6, 0xa8, 0x25, 0x31, 0xfc,
+	0x55, 0x3e, 0x8a, 0x10, 0x64, 0x5d, 0xdc, 0xa7, 0xa5, 0x7c, 0x55, 0xa9, 0x5d, 0x32, 0xd8, 0xef,
+	0xdb, 0xf9, 0x87, 0xef, 0x56, 0x66, 0xfe, 0xf5, 0x6e, 0x65, 0x46, 0x33, 0xe1, 0x4a, 0x38, 0x04,
+	0x06, 0x71, 0x87, 0xb6, 0xe5, 0x12, 0xd4, 0x80, 0x9c, 0x98, 0x87, 0xf9, 0x7f, 0xa1, 0x59, 0xfa,
+	0xe8, 0xfd, 0x9b, 0x57, 0x44, 0x5a, 0x0a, 0xf0, 0x7d, 0xea, 0x98, 0x56, 0xcf, 0x90, 0x40, 0xb4,
+	0x06, 0xf3, 0xe4, 0x81, 0xe9, 0x52, 0x97, 0xc5, 0x21, 0xdf, 0x9c, 0xfb, 0xd5, 0x3f, 0x7f, 0xf3,
+	0x9c, 0x62, 0x88, 0x41, 0xed, 0x12, 0x00, 0x5b, 0xea, 0x3e, 0xc5, 0xd4, 0xd5, 0xbe, 0x3f, 0x0b,
+	0x28, 0xf8, 0xf4, 0xd7, 0x7d, 0x5b, 0x81, 0x52, 0x3c, 0x07, 0x5a, 0xae, 0x07, 0x2a, 0x29, 0xd5,
+	0x4c, 0xad, 0xd0, 0xd8, 0xaa, 0xc7, 0x76, 0x6e, 0x3d, 0x3e, 0x53, 0xfd, 0xa5, 0x68, 0xbe, 0x30,
+	0xf1, 0x97, 0x2c, 0xea, 0x1c, 0x35, 0xb3, 0x1f, 0x3c, 0xaa, 0xcc, 0x18, 0x57, 0xbb, 0x89, 0x10,
+	0xd5, 0x84, 0xd5, 0x09, 0xca, 0xe8, 0x32, 0x64, 0xf6, 0xc9, 0x91, 0x48, 0x4b, 0xef, 0x27, 0xfa,
+	0x2c, 0xcc, 0x1d, 0xe2, 0xfe, 0x88, 0x30, 0xbb, 0x0b, 0x8d, 0x72, 0x02, 0xc1, 0xd0, 0x2c, 0x06,
+	0x07, 0xdf, 0x9e, 0x7d, 0x41, 0xd1, 0xfe, 0x98, 0x81, 0x42, 0x48, 0x84, 0x9e, 0x81, 0xbc, 0xd8,
+	0x85, 0xdc, 0xef, 0x59, 0xe9, 0x44, 0x7f, 0x18, 0x5d, 0x87, 0x05, 0xb9, 0x8f, 0xb8, 0xa3, 0x7d,
+	0x4c, 0x30, 0x8e, 0x5e, 0x05, 0xa0, 0x36, 0xc5, 0xfd, 0xd6, 0x2e, 0x21, 0x2e, 0x4f, 0xde, 0xe6,
+	0x2d, 0xcf, 0xe8, 0xbf, 0x3c, 0xaa, 0x3c, 0xcd, 0xa3, 0xe8, 0x76, 0xf7, 0xeb, 0xa6, 0xad, 0x0f,
+	0x30, 0xdd, 0xab, 0xdf, 0xb3, 0xe8, 0x47, 0xef, 0xdf, 0x04, 0x11, 0xde, 0x7b, 0x16, 0x95, 0x13,
+	0x7a, 0x73, 0x6c, 0x13, 0xe2, 0xa2, 0x1d, 0x78, 0xca, 0xc1, 0x94, 0xb4, 0xfa, 0xe6, 0xc0, 0xa4,
+	0xad, 0x0e, 0x1e, 0xe2, 0x8e, 0x49, 0x8f, 0x58, 0xa2, 0x17, 0x1a, 0xcf, 0x26, 0x18, 0x6c, 0x60,
+	0x4a, 0x5e, 0xf1, 0xc0, 0x77, 0x05, 0xd6, 0x28, 0x3a, 0xd1, 0x21, 0xb4, 0x05, 0x79, 0x7b, 0x44,
+	0x3b, 0xf6, 0x80, 0xb8, 0x62, 0x23, 0x54, 0x12, 0xa6, 0x7a, 0x95, 0x43, 0x98, 0x87, 0x44, 0xe8,
+	0x7c, 0x35, 0xf4, 0x2d, 0x28, 0x71, 0x4b, 0xa5, 0xf1, 0x0e, 0xe9, 0xb6, 0xf0, 0xc0, 0xf3, 0x15,
+	0xdb, 0x10, 0xe7, 0xb1, 0xfb, 0x2a, 0x9b, 0x71, 0x27, 0x98, 0x70, 0x8b, 0xcd, 0xf7, 0x95, 0x6c,
+	0x3e, 0x73, 0x39, 0x6b, 0x14, 0x63, 0xeb, 0x69, 0x6f, 0xc1, 0x5a, 0x90, 0x81, 0xcd, 0xa3, 0x58,
+	0xf6, 0x9c, 0xf1, 0x64, 0x0b, 0xed, 0xcf, 0x47, 0x19, 0x58, 0x9f, 0x38, 0xb5, 0xbf, 0x73, 0xfe,
+	0x9f, 0x3a, 0xff, 0x93, 0xa9, 0xf3, 0x70, 0x16, 0x8a, 0x41, 0x80, 0x5f, 0x36, 0x5d, 0x6a, 0x3b,
+	0x47, 0x67, 0xad, 0x84, 0x2f, 0x02, 0xb8, 0x14, 0x3b, 0x94, 0x97, 0x94, 0xd9, 0x29, 0x4b, 0xca,
+	0x02, 0xd3, 0x61, 0xd5, 0xe4, 0x0b, 0x90, 0x27, 0x56, 0x97, 0xab, 0x67, 0xa6, 0x54, 0xcf, 0x11,
+	0xab, 0xcb, 0x94, 0xb7, 0x01, 0x82, 0x5e, 0x88, 0x65, 0x4c, 0xa1, 0x71, 0xa3, 0x2e, 0x3c, 0xe3,
+	0x35, 0x43, 0x75, 0xde, 0xe8, 0x88, 0x96, 0xa8, 0xfe, 0x1a, 0xee, 0x11, 0x83, 0x1c, 0x8c, 0x88,
+	0x4b, 0x8d, 0x90, 0xa6, 0xf6, 0x9e, 0x02, 0x2b, 0x31, 0x57, 0xf8, 0xf9, 0xfd, 0x45, 0xc8, 0xb5,
+	0x47, 0x9d, 0x7d, 0xe2, 0xd7, 0x81, 0xa4, 0x63, 0x96, 0x07, 0x9a, 0xc1, 0x44, 0xb8, 0xa5, 0x12,
+	0xfa, 0xf2, 0x18, 0x4b, 0xee, 0xa3, 0x8d, 0x53, 0x59, 0xf2, 0xc5, 0xc7, 0x68, 0x2e, 0x42, 0x81,
+	0xb1, 0x7c, 0x8d, 0x75, 0x3b, 0xda, 0x7d, 0x78, 0x2a, 0xf4, 0xe9, 0xd3, 0xbd, 0x03, 0xf3, 0xbc,
+	0x1d, 0x62, 0x51, 0x2b, 0x34, 0x56, 0x12, 0xd8, 0x72, 0x95, 0xe6, 0x82, 0x47, 0x54, 0xd4, 0x4a,
+	0xae, 0xa3, 0x2d, 0x89, 0xce, 0x88, 0xc7, 0xd7, 0xd5, 0xde, 0x14, 0x65, 0x5a, 0x7c, 0xfb, 0xab,
+	0xbc, 0x08, 0x39, 0x9e, 0x1b, 0xd2, 0x29, 0x95, 0xd4, 0xda, 0x73, 0xd7, 0xb6, 0x76, 0xcd, 0x9e,
+	0xf4, 0x8a, 0xd0, 0xd2, 0xb6, 0x85, 0x31, 0x1f, 0xf7, 0x9c, 0xfa, 0x9a, 0xf0, 0x42, 0xe4, 0x50,
+	0x6a, 0xc2, 0x7c, 0x68, 0x8e, 0x29, 0xe8, 0x85, 0x7d, 0xc1, 0x35, 0xb5, 0x6f, 0x08, 0xdb, 0xb7,
+	0xb1, 0xd9, 0x27, 0xdd, 0x1d, 0xff, 0xa0, 0x1a, 0x4f, 0x3b, 0xe5, 0xdc, 0x69, 0xf7, 0x3b, 0x05,
+	0xae, 0x25, 0x2d, 0xe0, 0x1b, 0x61, 0xc0, 0xe5, 0x5d, 0x26, 0x6a, 0x05, 0xa7, 0x27, 0xf7, 0xf6,
+	0x33, 0x09, 0xe6, 0x8c, 0xcf, 0x22, 0xfc, 0xbd, 0xbc, 0x1b, 0x21, 0x7f, 0x61, 0xd9, 0x28, 0xd3,
+	0x6f, 0x7c, 0xd9, 0xf3, 0xf4, 0x6f, 0xa1, 0x68, 0x52, 0x58, 0x4d, 0x98, 0xd4, 0x77, 0xc8, 0x1b,
+	0xb0, 0x1c, 0x71, 0x88, 0x70, 0xff, 0x14, 0xfe, 0x08, 0x05, 0x78, 0x69, 0xdc, 0x29, 0xda, 0x8f,
+	0x14, 0x11, 0x69, 0x39, 0x22, 0x4f, 0xc3, 0xf3, 0x34, 0xa3, 0xdb, 0x09, 0x0e, 0x3e, 0x4f, 0x76,
+	0xfc, 0x5a, 0x66, 0x47, 0x84, 0x94, 0xef, 0x8c, 0x2d, 0xc8, 0x39, 0xa4, 0x63, 0x3b, 0xdd, 0x49,
+	0x49, 0x11, 0xb8, 0xd0, 0x43, 0xca, 0x4d, 0x28, 0xf4, 0x2e, 0x2e, 0x19, 0x5e, 0x91, 0x17, 0x2a,
+	0x5e, 0xf1, 0x3f, 0x66, 0x16, 0xfc, 0x44, 0xc6, 0x43, 0x4c, 0x17, 0x3e, 0x75, 0x44, 0x4f, 0x21,
+	0xe2, 0xae, 0x26, 0x98, 0x2c, 0x94, 0xc2, 0x01, 0x97, 0x5a, 0xec, 0x2c, 0xc7, 0x7d, 0x6c, 0x75,
+	0x64, 0xb1, 0x5a, 0x19, 0xb3, 0x56, 0xda, 0x79, 0xd7, 0x36, 0xad, 0x31, 0x7d, 0xa1, 0xa4, 0xfd,
+	0x55, 0x81, 0xc5, 0x30, 0xb3, 0x49, 0x57, 0xc7, 0x85, 0xff, 0xde, 0xd5, 0xf1, 0xa2, 0x0a, 0xe1,
+	0xcf, 0x14, 0x78, 0x7a, 0xcc, 0xbc, 0x50, 0x55, 0x09, 0x37, 0x79, 0x99, 0x53, 0x5c, 0x2f, 0x1a,
+	0x1e, 0xbf, 0xff, 0xbb, 0xb0, 0x3c, 0xfb, 0x26, 0x5c, 0xe5, 0xfc, 0xbe, 0x8d, 0x4d, 0x6a, 0x5a,
+	0xbd, 0x8b, 0x3f, 0x94, 0xff, 0xa0, 0x40, 0x39, 0x79, 0x09, 0xdf, 0x17, 0x6f, 0x01, 0xc2, 0x42,
+	0x18, 0x3b, 0x98, 0xaf, 0x27, 0x79, 0x25, 0x32, 0x93, 0x70, 0x4f, 0x11, 0xc7, 0x8c, 0xb8, 0x30,
+	0x3f, 0xc9, 0xd2, 0xf5, 0xfa, 0x88, 0x8c, 0x3e, 0xd1, 0xd2, 0x15, 0x59, 0x20, 0x5c, 0xba, 0x0e,
+	0x98, 0x68, 0xaa, 0xd2, 0x35, 0x3e, 0x8b, 0x2c, 0x5d, 0x07, 0x11, 0xf2, 0x9f, 0x40, 0x23, 0x35,
+	0x72, 0x49, 0x57, 0x73, 0xfc, 0x46, 0xca, 0xfb, 0xf4, 0x4d, 0x58, 0xf3, 0x1a, 0x29, 0x6f, 0x84,
+	0xf9, 0x29, 0x78, 0x55, 0xe0, 0x83, 0xe8, 0x0e, 0xa8, 0xfc, 0x57, 0x2b, 0xbe, 0xff, 0xbd, 0x4b,
+	0x4e, 0xa6, 0xb6, 0x68, 0x94, 0x38, 0x22, 0x76, 0x77, 0x72, 0x1b, 0xff, 0x2e, 0xc2, 0x1c, 0x5b,
+	0x14, 0xfd, 0x56, 0x81, 0x9c, 0x7c, 0x87, 0xaa, 0xa4, 0xbd, 0x30, 0x08, 0x80, 0xba, 0x71, 0x0a,
+	0x40, 0x92, 0xd7, 0xda, 0x0f, 0x3d, 0xb2, 0xdf, 0xfb, 0xd3, 0x3f, 0x7e, 0x3c, 0xfb, 0x26, 0x7a,
+	0x43, 0x4f, 0x78, 0x73, 0xe3, 0x0a, 0xfa, 0x71, 0x9c, 0xfd, 0x89, 0x7e, 0x3c, 0x7e, 0x46, 0x9d,
+	0xe8, 0xc7, 0xf1, 0xd3, 0xe8, 0x04, 0x51, 0x98, 0xe3, 0x8f, 0x07, 0x6b, 0x13, 0x1f, 0x46, 0xd4,
+	0xf5, 0xa9, 0xde, 0x4d, 0xb4, 0xf5, 0x80, 0xb2, 0x8a, 0x4a, 0x09, 0x94, 0xd9, 0x5b, 0x0c, 0xfa,
+	0xbd, 0x02, 0xa5, 0xd4, 0xeb, 0xee, 0xad, 0x89, 0x4b, 0x25, 0x68, 0xa8, 0x2f, 0x9c, 0x55, 0xc3,
+	0xe7, 0x7b, 0x3b, 0xe0, 0xab, 0xa3, 0x9b, 0x69, 0x7c, 0x13, 0x1d, 0x8c, 0x7e, 0xa1, 0xc0, 0xa5,
+	0xb1, 0x7b, 0xd7, 0xb3, 0x13, 0x69, 0x08, 0x94, 0xfa, 0xfc, 0x34, 0x28, 0x9f, 0x60, 0x33, 0x20,
+	0xf8, 0x79, 0xf4, 0xb9, 0x33, 0x11, 0xd4, 0xf7, 0x04, 0xaf, 0x07, 0x30, 0xcf, 0x2f, 0x0b, 0xa8,
+	0x9c, 0xb6, 0x36, 0x97, 0xab, 0x37, 0x26, 0xcb, 0x7d, 0x56, 0x37, 0x02, 0x56, 0xab, 0x68, 0x45,
+	0x4f, 0x7b, 0xcc, 0x45, 0xdf, 0x81, 0x9c, 0xd8, 0x2c, 0xe9, 0xdb, 0x42, 0x00, 0xd2, 0xb7, 0x45,
+	0xe4, 0xda, 0xa2, 0x6d, 0x04, 0x8b, 0x5f, 0x43, 0xaa, 0x9e, 0xf6, 0xd2, 0xec, 0xa2, 0x1f, 0x2a,
+	0x30, 0x2f, 0x72, 0xaa, 0x3c, 0x79, 0xf2, 0x74, 0xc3, 0x23, 0xf9, 0x72, 0x27, 0x58, 0x7b, 0x13,
+	0xe9, 0xe9, 0x6b, 0x27, 0x67, 0xcc, 0x4f, 0x15, 0x58, 0x8e, 0x5e, 0x44, 0x52, 0xcd, 0x8e, 0x00,
+	0x55, 0x7d, 0x4a, 0xa0, 0xcf, 0xf5, 0x56, 0xc0, 0x75, 0x1d, 0x5d, 0x4f, 0xe0, 0x1a, 0xbd, 0x97,
+	0xa0, 0xf7, 0x14, 0x58, 0x8a, 0x5c, 0x05, 0x6e, 0x4c, 0xb7, 0xaa, 0x5a, 0x9f, 0x0e, 0x37, 0xfd,
+	0xc6, 0x8b, 0x92, 0xd3, 0x8f, 0xc5, 0x69, 0x77, 0x82, 0x7e, 0xa9, 0xc0, 0x72, 0xb4, 0xcb, 0x4f,
+	0x75, 0x63, 0x04, 0x98, 0xee, 0xc6, 0x94, 0x16, 0xfd, 0x74, 0xa6, 0x92, 0x62, 0x4b, 0xec, 0xb7,
+	0x10, 0xd3, 0x1f, 0x78, 0x75, 0x41, 0xb4, 0xad, 0xe9, 0x75, 0x81, 0x03, 0x26, 0xd4, 0x85, 0xf1,
+	0x0e, 0x5a, 0x6b, 0x04, 0x8c, 0x36, 0xd0, 0xba, 0x9e, 0xfa, 0xbf, 0x98, 0xb0, 0xcf, 0xbe, 0xab,
+	0x40, 0xde, 0xef, 0x77, 0xab, 0xa7, 0xac, 0xe4, 0xaa, 0xb5, 0xd3, 0x10, 0x3e, 0x99, 0x5a, 0x40,
+	0x66, 0x0d, 0xad, 0x4e, 0x20, 0x83, 0x7e, 0xae, 0x40, 0x31, 0xde, 0xf3, 0x7d, 0x2a, 0x75, 0xa5,
+	0x28, 0x54, 0xdd, 0x9c, 0x1a, 0x7a, 0x06, 0x57, 0xc5, 0x9a, 0x40, 0xb6, 0x4b, 0xa3, 0x3d, 0x57,
+	0x6a, 0x6c, 0x22, 0xc0, 0xf4, 0xf4, 0x4a, 0x69, 0xb2, 0x4e, 0xdf, 0xa5, 0xd1, 0x16, 0x8c, 0x1f,
+	0xe7, 0xac, 0x7d, 0x99, 0x70, 0x9c, 0x7b, 0xf2, 0x49, 0xc7, 0x79, 0xb8, 0x4b, 0x9a, 0xe6, 0x38,
+	0xf7, 0xf0, 0xcd, 0xe7, 0x3f, 0x78, 0x5c, 0x56, 0x3e, 0x7c, 0x5c, 0x56, 0xfe, 0xfe, 0xb8, 0xac,
+	0xbc, 0xf3, 0xa4, 0x3c, 0xf3, 0xe1, 0x93, 0xf2, 0xcc, 0x9f, 0x9f, 0x94, 0x67, 0xbe, 0x8e, 0xfc,
+	0x25, 0xba, 0xe4, 0x50, 0xa7, 0x47, 0x43, 0xe2, 0xb6, 0xe7, 0xd9, 0xe3, 0xdf, 0x67, 0xfe, 0x13,
+	0x00, 0x00, 0xff, 0xff, 0x9a, 0x3b, 0x7d, 0xe0, 0x4b, 0x1d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries Address.
+	Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error)
+	// Queries Stats.
+	Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error)
+	// Queries StatsByDestinationDomain.
+	StatsByDestinationDomain(ctx context.Context, in *QueryStatsByDestinationDomain, opts ...grpc.CallOption) (*QueryStatsByDestinationDomainResponse, error)
+	// Queries StatsHistory.
+	StatsHistory(ctx context.Context, in *QueryStatsHistory, opts ...grpc.CallOption) (*QueryStatsHistoryResponse, error)
+	// Queries Params.
+	Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries Domains.
+	Domains(ctx context.Context, in *QueryDomains, opts ...grpc.CallOption) (*QueryDomainsResponse, error)
+	// Queries Domain.
+	Domain(ctx context.Context, in *QueryDomain, opts ...grpc.CallOption) (*QueryDomainResponse, error)
+	// Queries FailedTransfers.
+	FailedTransfers(ctx context.Context, in *QueryFailedTransfers, opts ...grpc.CallOption) (*QueryFailedTransfersResponse, error)
+	// Queries FailedTransfer.
+	FailedTransfer(ctx context.Context, in *QueryFailedTransfer, opts ...grpc.CallOption) (*QueryFailedTransferResponse, error)
+	// Queries TransferHistory.
+	TransferHistory(ctx context.Context, in *QueryTransferHistory, opts ...grpc.CallOption) (*QueryTransferHistoryResponse, error)
+	// Queries Account.
+	Account(ctx context.Context, in *QueryAccount, opts ...grpc.CallOption) (*QueryAccountResponse, error)
+	// Queries Accounts.
+	Accounts(ctx context.Context, in *QueryAccounts, opts ...grpc.CallOption) (*QueryAccountsResponse, error)
+	// Queries AwaitingTransfers.
+	AwaitingTransfers(ctx context.Context, in *QueryAwaitingTransfers, opts ...grpc.CallOption) (*QueryAwaitingTransfersResponse, error)
+	// Queries QueuedTransfers.
+	QueuedTransfers(ctx context.Context, in *QueryQueuedTransfers, opts ...grpc.CallOption) (*QueryQueuedTransfersResponse, error)
+	// Queries Paused.
+	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error) {
+	out := new(QueryAddressResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/Address", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
+	out := new(QueryStatsResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StatsByDestinationDomain(ctx context.Context, in *QueryStatsByDestinationDomain, opts ...grpc.CallOption) (*QueryStatsByDestinationDomainResponse, error) {
+	out := new(QueryStatsByDestinationDomainResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/StatsByDestinationDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StatsHistory(ctx context.Context, in *QueryStatsHistory, opts ...grpc.CallOption) (*QueryStatsHistoryResponse, error) {
+	out := new(QueryStatsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/StatsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Domains(ctx context.Context, in *QueryDomains, opts ...grpc.CallOption) (*QueryDomainsResponse, error) {
+	out := new(QueryDomainsResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/Domains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Domain(ctx context.Context, in *QueryDomain, opts ...grpc.CallOption) (*QueryDomainResponse, error) {
+	out := new(QueryDomainResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/Domain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedTransfers(ctx context.Context, in *QueryFailedTransfers, opts ...grpc.CallOption) (*QueryFailedTransfersResponse, error) {
+	out := new(QueryFailedTransfersResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/FailedTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedTransfer(ctx context.Context, in *QueryFailedTransfer, opts ...grpc.CallOption) (*QueryFailedTransferResponse, error) {
+	out := new(QueryFailedTransferResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/FailedTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferHistory(ctx context.Context, in *QueryTransferHistory, opts ...grpc.CallOption) (*QueryTransferHistoryResponse, error) {
+	out := new(QueryTransferHistoryResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/TransferHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Account(ctx context.Context, in *QueryAccount, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
+	out := new(QueryAccountResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/Account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Accounts(ctx context.Context, in *QueryAccounts, opts ...grpc.CallOption) (*QueryAccountsResponse, error) {
+	out := new(QueryAccountsResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/Accounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AwaitingTransfers(ctx context.Context, in *QueryAwaitingTransfers, opts ...grpc.CallOption) (*QueryAwaitingTransfersResponse, error) {
+	out := new(QueryAwaitingTransfersResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/AwaitingTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedTransfers(ctx context.Context, in *QueryQueuedTransfers, opts ...grpc.CallOption) (*QueryQueuedTransfersResponse, error) {
+	out := new(QueryQueuedTransfersResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/QueuedTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
+	out := new(QueryPausedResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Query/Paused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries Address.
+	Address(context.Context, *QueryAddress) (*QueryAddressResponse, error)
+	// Queries Stats.
+	Stats(context.Context, *QueryStats) (*QueryStatsResponse, error)
+	// Queries StatsByDestinationDomain.
+	StatsByDestinationDomain(context.Context, *QueryStatsByDestinationDomain) (*QueryStatsByDestinationDomainResponse, error)
+	// Queries StatsHistory.
+	StatsHistory(context.Context, *QueryStatsHistory) (*QueryStatsHistoryResponse, error)
+	// Queries Params.
+	Params(context.Context, *QueryParams) (*QueryParamsResponse, error)
+	// Queries Domains.
+	Domains(context.Context, *QueryDomains) (*QueryDomainsResponse, error)
+	// Queries Domain.
+	Domain(context.Context, *QueryDomain) (*QueryDomainResponse, error)
+	// Queries FailedTransfers.
+	FailedTransfers(context.Context, *QueryFailedTransfers) (*QueryFailedTransfersResponse, error)
+	// Queries FailedTransfer.
+	FailedTransfer(context.Context, *QueryFailedTransfer) (*QueryFailedTransferResponse, error)
+	// Queries TransferHistory.
+	TransferHistory(context.Context, *QueryTransferHistory) (*QueryTransferHistoryResponse, error)
+	// Queries Account.
+	Account(context.Context, *QueryAccount) (*QueryAccountResponse, error)
+	// Queries Accounts.
+	Accounts(context.Context, *QueryAccounts) (*QueryAccountsResponse, error)
+	// Queries AwaitingTransfers.
+	AwaitingTransfers(context.Context, *QueryAwaitingTransfers) (*QueryAwaitingTransfersResponse, error)
+	// Queries QueuedTransfers.
+	QueuedTransfers(context.Context, *QueryQueuedTransfers) (*QueryQueuedTransfersResponse, error)
+	// Queries Paused.
+	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Address(ctx context.Context, req *QueryAddress) (*QueryAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Address not implemented")
+}
+func (*UnimplementedQueryServer) Stats(ctx context.Context, req *QueryStats) (*QueryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (*UnimplementedQueryServer) StatsByDestinationDomain(ctx context.Context, req *QueryStatsByDestinationDomain) (*QueryStatsByDestinationDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsByDestinationDomain not implemented")
+}
+func (*UnimplementedQueryServer) StatsHistory(ctx context.Context, req *QueryStatsHistory) (*QueryStatsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsHistory not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParams) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Domains(ctx context.Context, req *QueryDomains) (*QueryDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Domains not implemented")
+}
+func (*UnimplementedQueryServer) Domain(ctx context.Context, req *QueryDomain) (*QueryDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Domain not implemented")
+}
+func (*UnimplementedQueryServer) FailedTransfers(ctx context.Context, req *QueryFailedTransfers) (*QueryFailedTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedTransfers not implemented")
+}
+func (*UnimplementedQueryServer) FailedTransfer(ctx context.Context, req *QueryFailedTransfer) (*QueryFailedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedTransfer not implemented")
+}
+func (*UnimplementedQueryServer) TransferHistory(ctx context.Context, req *QueryTransferHistory) (*QueryTransferHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferHistory not implemented")
+}
+func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccount) (*QueryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+}
+func (*UnimplementedQueryServer) Accounts(ctx context.Context, req *QueryAccounts) (*QueryAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+}
+func (*UnimplementedQueryServer) AwaitingTransfers(ctx context.Context, req *QueryAwaitingTransfers) (*QueryAwaitingTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AwaitingTransfers not implemented")
+}
+func (*UnimplementedQueryServer) QueuedTransfers(ctx context.Context, req *QueryQueuedTransfers) (*QueryQueuedTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedTransfers not implemented")
+}
+func (*UnimplementedQueryServer) Paused(ctx context.Context, req *QueryPaused) (*QueryPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Address(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/Address",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Address(ctx, req.(*QueryAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStats)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stats(ctx, req.(*QueryStats))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StatsByDestinationDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsByDestinationDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StatsByDestinationDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/StatsByDestinationDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatsByDestinationDomain(ctx, req.(*QueryStatsByDestinationDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/StatsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatsHistory(ctx, req.(*QueryStatsHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Domains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomains)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Domains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/Domains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Domains(ctx, req.(*QueryDomains))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Domain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Domain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/Domain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Domain(ctx, req.(*QueryDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedTransfers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/FailedTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedTransfers(ctx, req.(*QueryFailedTransfers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/FailedTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedTransfer(ctx, req.(*QueryFailedTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferHistory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/TransferHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferHistory(ctx, req.(*QueryTransferHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/Account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Account(ctx, req.(*QueryAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Accounts(ctx, req.(*QueryAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AwaitingTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAwaitingTransfers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AwaitingTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/AwaitingTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AwaitingTransfers(ctx, req.(*QueryAwaitingTransfers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedTransfers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/QueuedTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedTransfers(ctx, req.(*QueryQueuedTransfers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Query/Paused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paused(ctx, req.(*QueryPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.autocctp.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Address",
+			Handler:    _Query_Address_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Query_Stats_Handler,
+		},
+		{
+			MethodName: "StatsByDestinationDomain",
+			Handler:    _Query_StatsByDestinationDomain_Handler,
+		},
+		{
+			MethodName: "StatsHistory",
+			Handler:    _Query_StatsHistory_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Domains",
+			Handler:    _Query_Domains_Handler,
+		},
+		{
+			MethodName: "Domain",
+			Handler:    _Query_Domain_Handler,
+		},
+		{
+			MethodName: "FailedTransfers",
+			Handler:    _Query_FailedTransfers_Handler,
+		},
+		{
+			MethodName: "FailedTransfer",
+			Handler:    _Query_FailedTransfer_Handler,
+		},
+		{
+			MethodName: "TransferHistory",
+			Handler:    _Query_TransferHistory_Handler,
+		},
+		{
+			MethodName: "Account",
+			Handler:    _Query_Account_Handler,
+		},
+		{
+			MethodName: "Accounts",
+			Handler:    _Query_Accounts_Handler,
+		},
+		{
+			MethodName: "AwaitingTransfers",
+			Handler:    _Query_AwaitingTransfers_Handler,
+		},
+		{
+			MethodName: "QueuedTransfers",
+			Handler:    _Query_QueuedTransfers_Handler,
+		},
+		{
+			MethodName: "Paused",
+			Handler:    _Query_Paused_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/autocctp/v1/query.proto",
+}
+
+func (m *QueryAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AddressVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DestinationCaller) > 0 {
+		i -= len(m.DestinationCaller)
+		copy(dAtA[i:], m.DestinationCaller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationCaller)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FallbackRecipient) > 0 {
+		i -= len(m.FallbackRecipient)
+		copy(dAtA[i:], m.FallbackRecipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FallbackRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MintRecipient) > 0 {
+		i -= len(m.MintRecipient)
+		copy(dAtA[i:], m.MintRecipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MintRecipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exists {
+		i--
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestinationDomainStats) > 0 {
+		for k := range m.DestinationDomainStats {
+			v := m.DestinationDomainStats[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintQuery(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalTransferredAmount.Size()
+		i -= size
+		if _, err := m.TotalTransferredAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Outcomes.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RateLimitCapacity != nil {
+		{
+			size, err := m.RateLimitCapacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.TotalFees.Size()
+		i -= size
+		if _, err := m.TotalFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Transfers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Transfers))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Accounts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Accounts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsByDestinationDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsByDestinationDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsByDestinationDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsByDestinationDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsByDestinationDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsByDestinationDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalTransferredAmount.Size()
+		i -= size
+		if _, err := m.TotalTransferredAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Outcomes.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RateLimitCapacity != nil {
+		{
+			size, err := m.RateLimitCapacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.TotalFees.Size()
+		i -= size
+		if _, err := m.TotalFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Transfers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Transfers))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Accounts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Accounts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndTime != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomains) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomains) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domains) > 0 {
+		for iNdEx := len(m.Domains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Domains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedTransfers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedTransfers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedTransfers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedTransfers) > 0 {
+		for iNdEx := len(m.FailedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FailedTransfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FallbackRecipient) > 0 {
+		i -= len(m.FallbackRecipient)
+		copy(dAtA[i:], m.FallbackRecipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FallbackRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MintRecipient) > 0 {
+		i -= len(m.MintRecipient)
+		copy(dAtA[i:], m.MintRecipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MintRecipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DestinationDomain) > 0 {
+		i -= len(m.DestinationDomain)
+		copy(dAtA[i:], m.DestinationDomain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationDomain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAwaitingTransfers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAwaitingTransfers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAwaitingTransfers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAwaitingTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAwaitingTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAwaitingTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AwaitingTransfers) > 0 {
+		for iNdEx := len(m.AwaitingTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AwaitingTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedTransfers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedTransfers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedTransfers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueuedTransfers) > 0 {
+		for iNdEx := len(m.QueuedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedDestinationDomains) > 0 {
+		dAtA27 := make([]byte, len(m.PausedDestinationDomains)*10)
+		var j26 int
+		for _, num := range m.PausedDestinationDomains {
+			for num >= 1<<7 {
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j26++
+			}
+			dAtA27[j26] = uint8(num)
+			j26++
+		}
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintQuery(dAtA, i, uint64(j26))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		n += 1 + sovQuery(uint64(m.DestinationDomain))
+	}
+	l = len(m.MintRecipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FallbackRecipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestinationCaller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExpirationHeight))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovQuery(uint64(m.AddressVersion))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Exists {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DestinationDomainStats) > 0 {
+		for k, v := range m.DestinationDomainStats {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + l + sovQuery(uint64(l))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *DomainStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accounts != 0 {
+		n += 1 + sovQuery(uint64(m.Accounts))
+	}
+	if m.Transfers != 0 {
+		n += 1 + sovQuery(uint64(m.Transfers))
+	}
+	l = m.TotalFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RateLimitCapacity != nil {
+		l = m.RateLimitCapacity.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Outcomes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalTransferredAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStatsByDestinationDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		n += 1 + sovQuery(uint64(m.DestinationDomain))
+	}
+	return n
+}
+
+func (m *QueryStatsByDestinationDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accounts != 0 {
+		n += 1 + sovQuery(uint64(m.Accounts))
+	}
+	if m.Transfers != 0 {
+		n += 1 + sovQuery(uint64(m.Transfers))
+	}
+	l = m.TotalFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RateLimitCapacity != nil {
+		l = m.RateLimitCapacity.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Outcomes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalTransferredAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStatsHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		n += 1 + sovQuery(uint64(m.DestinationDomain))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStatsHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDomains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDomainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Domains) > 0 {
+		for _, e := range m.Domains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		n += 1 + sovQuery(uint64(m.DestinationDomain))
+	}
+	return n
+}
+
+func (m *QueryDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Domain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFailedTransfers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedTransfers) > 0 {
+		for _, e := range m.FailedTransfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FailedTransfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTransferHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Account.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DestinationDomain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MintRecipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FallbackRecipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
-	if m.DestinationDomain != 0 {
-		n += 1 + sovQuery(uint64(m.DestinationDomain))
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAwaitingTransfers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAwaitingTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AwaitingTransfers) > 0 {
+		for _, e := range m.AwaitingTransfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedTransfers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedTransfers) > 0 {
+		for _, e := range m.QueuedTransfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if len(m.PausedDestinationDomains) > 0 {
+		l = 0
+		for _, e := range m.PausedDestinationDomains {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCaller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exists = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomainStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DestinationDomainStats == nil {
+				m.DestinationDomainStats = make(map[uint32]DomainStats)
+			}
+			var mapkey uint32
+			mapvalue := &DomainStats{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DomainStats{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DestinationDomainStats[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			m.Accounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Accounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			m.Transfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transfers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimitCapacity == nil {
+				m.RateLimitCapacity = &RateLimitCapacity{}
+			}
+			if err := m.RateLimitCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outcomes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferredAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTransferredAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsByDestinationDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsByDestinationDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsByDestinationDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsByDestinationDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsByDestinationDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsByDestinationDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			m.Accounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Accounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			m.Transfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transfers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimitCapacity == nil {
+				m.RateLimitCapacity = &RateLimitCapacity{}
+			}
+			if err := m.RateLimitCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outcomes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferredAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTransferredAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, StatsBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomains) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomains: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomains: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domains = append(m.Domains, DomainConfig{})
+			if err := m.Domains[len(m.Domains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedTransfers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedTransfers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedTransfers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedTransfersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedTransfersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedTransfers = append(m.FailedTransfers, FailedTransfer{})
+			if err := m.FailedTransfers[len(m.FailedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FailedTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, TransferRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryStatsByDestinationDomainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Accounts != 0 {
-		n += 1 + sovQuery(uint64(m.Accounts))
-	}
-	if m.Transfers != 0 {
-		n += 1 + sovQuery(uint64(m.Transfers))
-	}
-	if m.TotalTransferred != 0 {
-		n += 1 + sovQuery(uint64(m.TotalTransferred))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryAddress) Unmarshal(dAtA []byte) error {
+func (m *QueryAccounts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1020,17 +6872,17 @@ func (m *QueryAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccounts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
 			}
-			m.DestinationDomain = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1040,11 +6892,24 @@ func (m *QueryAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestinationDomain |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
@@ -1111,9 +6976,9 @@ func (m *QueryAddress) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1123,23 +6988,27 @@ func (m *QueryAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationCaller = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1162,7 +7031,7 @@ func (m *QueryAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1185,17 +7054,17 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1205,29 +7074,31 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, Account{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1237,12 +7108,28 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Exists = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1264,7 +7151,93 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStats) Unmarshal(dAtA []byte) error {
+func (m *QueryAwaitingTransfers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAwaitingTransfers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAwaitingTransfers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAwaitingTransfersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1283,16 +7256,86 @@ func (m *QueryStats) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAwaitingTransfersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAwaitingTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwaitingTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AwaitingTransfers = append(m.AwaitingTransfers, AwaitingTransfer{})
+			if err := m.AwaitingTransfers[len(m.AwaitingTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1314,7 +7357,7 @@ func (m *QueryStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQueuedTransfers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1337,15 +7380,15 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueuedTransfers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueuedTransfers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomainStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1372,91 +7415,12 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DestinationDomainStats == nil {
-				m.DestinationDomainStats = make(map[uint32]DomainStats)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			var mapkey uint32
-			mapvalue := &DomainStats{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &DomainStats{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DestinationDomainStats[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1479,7 +7443,7 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DomainStats) Unmarshal(dAtA []byte) error {
+func (m *QueryQueuedTransfersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1502,17 +7466,17 @@ func (m *DomainStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DomainStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueuedTransfersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DomainStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueuedTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedTransfers", wireType)
 			}
-			m.Accounts = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1522,16 +7486,31 @@ func (m *DomainStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Accounts |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedTransfers = append(m.QueuedTransfers, QueuedTransfer{})
+			if err := m.QueuedTransfers[len(m.QueuedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Transfers = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1541,30 +7520,28 @@ func (m *DomainStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Transfers |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferred", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.TotalTransferred = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalTransferred |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1586,7 +7563,7 @@ func (m *DomainStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatsByDestinationDomain) Unmarshal(dAtA []byte) error {
+func (m *QueryPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1609,31 +7586,12 @@ func (m *QueryStatsByDestinationDomain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsByDestinationDomain: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsByDestinationDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
-			}
-			m.DestinationDomain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestinationDomain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1655,7 +7613,7 @@ func (m *QueryStatsByDestinationDomain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatsByDestinationDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1678,17 +7636,17 @@ func (m *QueryStatsByDestinationDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsByDestinationDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsByDestinationDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			m.Accounts = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1698,48 +7656,87 @@ func (m *QueryStatsByDestinationDomainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Accounts |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Paused = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
-			}
-			m.Transfers = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.PausedDestinationDomains = append(m.PausedDestinationDomains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Transfers |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
 				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransferred", wireType)
-			}
-			m.TotalTransferred = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalTransferred |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PausedDestinationDomains) == 0 {
+					m.PausedDestinationDomains = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedDestinationDomains = append(m.PausedDestinationDomains, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedDestinationDomains", wireType)
 			}
 		default:
 			iNdEx = preIndex
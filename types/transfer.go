@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// NewFailedTransfer returns a new FailedTransfer instance.
+func NewFailedTransfer(address string, amount math.Int, err error, height int64, attempts uint64, nextRetryHeight int64) FailedTransfer {
+	return FailedTransfer{
+		Address:         address,
+		Amount:          amount,
+		Error:           err.Error(),
+		Height:          height,
+		Attempts:        attempts,
+		NextRetryHeight: nextRetryHeight,
+
+		FirstFailureHeight: height,
+	}
+}
+
+// Validate returns an error if the failed transfer is not valid.
+func (ft FailedTransfer) Validate() error {
+	if _, _, err := bech32.DecodeAndConvert(ft.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+
+	if ft.Amount.IsNil() || !ft.Amount.IsPositive() {
+		return errors.New("amount must be positive")
+	}
+
+	if ft.Attempts == 0 {
+		return errors.New("attempts must be positive")
+	}
+
+	if ft.NextRetryHeight < ft.Height {
+		return errors.New("next retry height cannot be lower than the height")
+	}
+
+	if ft.FirstFailureHeight > ft.Height {
+		return errors.New("first failure height cannot be greater than the height")
+	}
+
+	return nil
+}
+
+// Validate returns an error if the transfer record is not valid.
+func (tr TransferRecord) Validate() error {
+	if _, _, err := bech32.DecodeAndConvert(tr.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+
+	if tr.Amount.IsNil() || !tr.Amount.IsPositive() {
+		return errors.New("amount must be positive")
+	}
+
+	if _, found := TransferOutcome_name[int32(tr.Outcome)]; !found || tr.Outcome == TransferOutcomeUnspecified {
+		return fmt.Errorf("invalid outcome: %s", tr.Outcome)
+	}
+
+	return nil
+}
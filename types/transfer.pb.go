@@ -0,0 +1,2414 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/autocctp/v1/transfer.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferOutcome defines the outcome of a transfer from an AutoCCTP account.
+type TransferOutcome int32
+
+const (
+	TransferOutcomeUnspecified TransferOutcome = 0
+	// The funds have been transferred via CCTP to the mint recipient.
+	TransferOutcomeExecuted TransferOutcome = 1
+	// The CCTP transfer failed.
+	TransferOutcomeFailed TransferOutcome = 2
+	// The funds have been sent to the fallback recipient.
+	TransferOutcomeFallback TransferOutcome = 3
+)
+
+var TransferOutcome_name = map[int32]string{
+	0: "TRANSFER_OUTCOME_UNSPECIFIED",
+	1: "TRANSFER_OUTCOME_EXECUTED",
+	2: "TRANSFER_OUTCOME_FAILED",
+	3: "TRANSFER_OUTCOME_FALLBACK",
+}
+
+var TransferOutcome_value = map[string]int32{
+	"TRANSFER_OUTCOME_UNSPECIFIED": 0,
+	"TRANSFER_OUTCOME_EXECUTED":    1,
+	"TRANSFER_OUTCOME_FAILED":      2,
+	"TRANSFER_OUTCOME_FALLBACK":    3,
+}
+
+func (x TransferOutcome) String() string {
+	return proto.EnumName(TransferOutcome_name, int32(x))
+}
+
+func (TransferOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e2cf0bffa6b31ebf, []int{0}
+}
+
+// FailedTransfer records an automatic CCTP transfer that could not be executed and
+// that is scheduled to be retried.
+type FailedTransfer struct {
+	// The AutoCCTP account from which the transfer has been initiated.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The amount of the minting denom that could not be transferred.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// The error returned by the last execution attempt.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// The block height of the last execution attempt.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// The number of execution attempts.
+	Attempts uint64 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// The block height starting from which the transfer will be retried.
+	NextRetryHeight int64 `protobuf:"varint,6,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
+	// The block height of the first execution attempt.
+	FirstFailureHeight int64 `protobuf:"varint,7,opt,name=first_failure_height,json=firstFailureHeight,proto3" json:"first_failure_height,omitempty"`
+	// The block time of the first execution attempt.
+	FirstFailureTime time.Time `protobuf:"bytes,8,opt,name=first_failure_time,json=firstFailureTime,proto3,stdtime" json:"first_failure_time"`
+}
+
+func (m *FailedTransfer) Reset()         { *m = FailedTransfer{} }
+func (m *FailedTransfer) String() string { return proto.CompactTextString(m) }
+func (*FailedTransfer) ProtoMessage()    {}
+func (*FailedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2cf0bffa6b31ebf, []int{0}
+}
+func (m *FailedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedTransfer.Merge(m, src)
+}
+func (m *FailedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedTransfer proto.InternalMessageInfo
+
+func (m *FailedTransfer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FailedTransfer) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedTransfer) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FailedTransfer) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *FailedTransfer) GetNextRetryHeight() int64 {
+	if m != nil {
+		return m.NextRetryHeight
+	}
+	return 0
+}
+
+func (m *FailedTransfer) GetFirstFailureHeight() int64 {
+	if m != nil {
+		return m.FirstFailureHeight
+	}
+	return 0
+}
+
+func (m *FailedTransfer) GetFirstFailureTime() time.Time {
+	if m != nil {
+		return m.FirstFailureTime
+	}
+	return time.Time{}
+}
+
+// FailedForward defines the forwarding of the denoms other than the minting denom to the
+// fallback recipient of an AutoCCTP account that failed and is retried.
+type FailedForward struct {
+	// The AutoCCTP account from which the denoms are forwarded.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The error returned by the last forwarding attempt.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The number of forwarding attempts.
+	Attempts uint64 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// The block height starting from which the forwarding will be retried.
+	NextRetryHeight int64 `protobuf:"varint,4,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
+}
+
+func (m *FailedForward) Reset()         { *m = FailedForward{} }
+func (m *FailedForward) String() string { return proto.CompactTextString(m) }
+func (*FailedForward) ProtoMessage()    {}
+func (*FailedForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2cf0bffa6b31ebf, []int{1}
+}
+func (m *FailedForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedForward.Merge(m, src)
+}
+func (m *FailedForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedForward proto.InternalMessageInfo
+
+func (m *FailedForward) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FailedForward) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedForward) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *FailedForward) GetNextRetryHeight() int64 {
+	if m != nil {
+		return m.NextRetryHeight
+	}
+	return 0
+}
+
+// OwedFee records the transfer fee owed by an AutoCCTP account, computed once for every
+// deposit and collected once the deposited funds are entirely transferred.
+type OwedFee struct {
+	// The AutoCCTP account owing the fee.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The amount of the minting denom owed as fee.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// The part of the balance of the account, including the owed fee, on which the fee has
+	// already been computed.
+	AssessedAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=assessed_amount,json=assessedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"assessed_amount"`
+}
+
+func (m *OwedFee) Reset()         { *m = OwedFee{} }
+func (m *OwedFee) String() string { return proto.CompactTextString(m) }
+func (*OwedFee) ProtoMessage()    {}
+func (*OwedFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2cf0bffa6b31ebf, []int{2}
+}
+func (m *OwedFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwedFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwedFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwedFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwedFee.Merge(m, src)
+}
+func (m *OwedFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwedFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwedFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwedFee proto.InternalMessageInfo
+
+func (m *OwedFee) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// TransferRecord is an entry of the transfer history of an AutoCCTP account.
+type TransferRecord struct {
+	// The AutoCCTP account from which the transfer has been initiated.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The unique identifier of the record.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The block height of the transfer.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// The block time of the transfer.
+	Time   time.Time             `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom  string                `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The nonce of the CCTP message, if executed.
+	Nonce   uint64          `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Outcome TransferOutcome `protobuf:"varint,8,opt,name=outcome,proto3,enum=noble.autocctp.v1.TransferOutcome" json:"outcome,omitempty"`
+	// The error returned, if failed.
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *TransferRecord) Reset()         { *m = TransferRecord{} }
+func (m *TransferRecord) String() string { return proto.CompactTextString(m) }
+func (*TransferRecord) ProtoMessage()    {}
+func (*TransferRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2cf0bffa6b31ebf, []int{3}
+}
+func (m *TransferRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRecord.Merge(m, src)
+}
+func (m *TransferRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRecord proto.InternalMessageInfo
+
+func (m *TransferRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *TransferRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferRecord) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TransferRecord) GetOutcome() TransferOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return TransferOutcomeUnspecified
+}
+
+func (m *TransferRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// AwaitingTransfer describes an AutoCCTP account holding enough funds to be cleared
+// that is waiting for the automatic transfer to be executed.
+type AwaitingTransfer struct {
+	// The AutoCCTP account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The receiving chain identifier according to Circle's CCTP.
+	DestinationDomain uint32 `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// The balance of the minting denom held by the account.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Denom  string                `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// A flag indicating whether a previous transfer failed and is scheduled to be retried.
+	Failed bool `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (m *AwaitingTransfer) Reset()         { *m = AwaitingTransfer{} }
+func (m *AwaitingTransfer) String() string { return proto.CompactTextString(m) }
+func (*AwaitingTransfer) ProtoMessage()    {}
+func (*AwaitingTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2cf0bffa6b31ebf, []int{4}
+}
+func (m *AwaitingTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AwaitingTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AwaitingTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AwaitingTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AwaitingTransfer.Merge(m, src)
+}
+func (m *AwaitingTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AwaitingTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AwaitingTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AwaitingTransfer proto.InternalMessageInfo
+
+func (m *AwaitingTransfer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AwaitingTransfer) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *AwaitingTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AwaitingTransfer) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+// QueuedTransfer describes an automatic transfer queued because it exceeded the maximum
+// number of transfers processed at the end of a block.
+type QueuedTransfer struct {
+	// The AutoCCTP account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The receiving chain identifier according to Circle's CCTP.
+	DestinationDomain uint32 `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// The height at which the transfer was queued.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// The identifier of the queued transfer, setting its position in the queue.
+	Id uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueuedTransfer) Reset()         { *m = QueuedTransfer{} }
+func (m *QueuedTransfer) String() string { return proto.CompactTextString(m) }
+func (*QueuedTransfer) ProtoMessage()    {}
+func (*QueuedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2cf0bffa6b31ebf, []int{5}
+}
+func (m *QueuedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedTransfer.Merge(m, src)
+}
+func (m *QueuedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedTransfer proto.InternalMessageInfo
+
+func (m *QueuedTransfer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueuedTransfer) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *QueuedTransfer) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueuedTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("noble.autocctp.v1.TransferOutcome", TransferOutcome_name, TransferOutcome_value)
+	proto.RegisterType((*FailedTransfer)(nil), "noble.autocctp.v1.FailedTransfer")
+	proto.RegisterType((*FailedForward)(nil), "noble.autocctp.v1.FailedForward")
+	proto.RegisterType((*OwedFee)(nil), "noble.autocctp.v1.OwedFee")
+	proto.RegisterType((*TransferRecord)(nil), "noble.autocctp.v1.TransferRecord")
+	proto.RegisterType((*AwaitingTransfer)(nil), "noble.autocctp.v1.AwaitingTransfer")
+	proto.RegisterType((*QueuedTransfer)(nil), "noble.autocctp.v1.QueuedTransfer")
+}
+
+func init() { proto.RegisterFile("noble/autocctp/v1/transfer.proto", fileDescriptor_e2cf0bffa6b31ebf) }
+
+var fileDescriptor_e2cf0bffa6b31ebf = []byte{
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x41, 0x6f, 0xdc, 0x44,
+	0x18, 0x5d, 0xaf, 0x9d, 0x4d, 0x32, 0xa8, 0x9b, 0x8d, 0x95, 0xb6, 0x1b, 0x83, 0x1c, 0x6b, 0x4f,
+	0x51, 0x44, 0xec, 0x36, 0x48, 0x08, 0x21, 0x0e, 0x6c, 0x12, 0x5b, 0x5d, 0x11, 0x1a, 0x98, 0x6c,
+	0x24, 0xe0, 0x62, 0x4d, 0xec, 0xd9, 0xcd, 0xa8, 0xeb, 0x99, 0x95, 0x67, 0x9c, 0xb4, 0xff, 0x00,
+	0xf6, 0xd4, 0x3b, 0xca, 0x01, 0x71, 0xe1, 0xc8, 0xa1, 0x3f, 0xa2, 0xc7, 0xaa, 0x27, 0xc4, 0xa1,
+	0xa0, 0xcd, 0x81, 0x13, 0x12, 0x3f, 0x01, 0x79, 0xc6, 0x0e, 0xee, 0x26, 0x48, 0x34, 0x41, 0xea,
+	0x25, 0xca, 0x37, 0xdf, 0x7b, 0x6f, 0xd6, 0xef, 0x7b, 0xdf, 0x00, 0x87, 0xb2, 0xa3, 0x11, 0xf6,
+	0x50, 0x26, 0x58, 0x14, 0x89, 0xb1, 0x77, 0x72, 0xdf, 0x13, 0x29, 0xa2, 0x7c, 0x80, 0x53, 0x77,
+	0x9c, 0x32, 0xc1, 0xcc, 0x65, 0x89, 0x70, 0x4b, 0x84, 0x7b, 0x72, 0xdf, 0x5a, 0x46, 0x09, 0xa1,
+	0xcc, 0x93, 0x7f, 0x15, 0xca, 0x5a, 0x8d, 0x18, 0x4f, 0x18, 0x0f, 0x65, 0xe5, 0xa9, 0xa2, 0x68,
+	0xad, 0x0c, 0xd9, 0x90, 0xa9, 0xf3, 0xfc, 0xbf, 0xe2, 0x74, 0x6d, 0xc8, 0xd8, 0x70, 0x84, 0x3d,
+	0x59, 0x1d, 0x65, 0x03, 0x4f, 0x90, 0x04, 0x73, 0x81, 0x92, 0xb1, 0x02, 0x74, 0x26, 0x3a, 0x68,
+	0x06, 0x88, 0x8c, 0x70, 0xdc, 0x2f, 0x7e, 0x90, 0xb9, 0x05, 0xe6, 0x51, 0x1c, 0xa7, 0x98, 0xf3,
+	0xb6, 0xe6, 0x68, 0xeb, 0x8b, 0xdb, 0xed, 0x97, 0xcf, 0x36, 0x57, 0x8a, 0xcb, 0xba, 0xaa, 0x73,
+	0x20, 0x52, 0x42, 0x87, 0xb0, 0x04, 0x9a, 0x0f, 0x40, 0x03, 0x25, 0x2c, 0xa3, 0xa2, 0x5d, 0x97,
+	0x94, 0x7b, 0xcf, 0x5f, 0xad, 0xd5, 0x7e, 0x7d, 0xb5, 0x76, 0x5b, 0xd1, 0x78, 0xfc, 0xc8, 0x25,
+	0xcc, 0x4b, 0x90, 0x38, 0x76, 0x7b, 0x54, 0xbc, 0x7c, 0xb6, 0x09, 0x0a, 0xbd, 0x1e, 0x15, 0x3f,
+	0xfd, 0xf1, 0xf3, 0x86, 0x06, 0x0b, 0xbe, 0xb9, 0x02, 0xe6, 0x70, 0x9a, 0xb2, 0xb4, 0xad, 0xe7,
+	0x42, 0x50, 0x15, 0xe6, 0x1d, 0xd0, 0x38, 0xc6, 0x64, 0x78, 0x2c, 0xda, 0x86, 0xa3, 0xad, 0xeb,
+	0xb0, 0xa8, 0x4c, 0x0b, 0x2c, 0x20, 0x21, 0x70, 0x32, 0x16, 0xbc, 0x3d, 0xe7, 0x68, 0xeb, 0x06,
+	0xbc, 0xa8, 0xcd, 0x0d, 0xb0, 0x4c, 0xf1, 0x63, 0x11, 0xa6, 0x58, 0xa4, 0x4f, 0xc2, 0x82, 0xde,
+	0x90, 0xf4, 0xa5, 0xbc, 0x01, 0xf3, 0xf3, 0x07, 0x4a, 0xe7, 0x1e, 0x58, 0x19, 0x90, 0x94, 0x8b,
+	0x70, 0x80, 0xc8, 0x28, 0x4b, 0x71, 0x09, 0x9f, 0x97, 0x70, 0x53, 0xf6, 0x02, 0xd5, 0x2a, 0x18,
+	0x10, 0x98, 0xaf, 0x33, 0x72, 0x67, 0xdb, 0x0b, 0x8e, 0xb6, 0xfe, 0xce, 0x96, 0xe5, 0x2a, 0xdb,
+	0xdd, 0xd2, 0x76, 0xb7, 0x5f, 0xda, 0xbe, 0xbd, 0x90, 0x3b, 0xf3, 0xf4, 0xb7, 0x35, 0x0d, 0xb6,
+	0xaa, 0xaa, 0x39, 0xa0, 0xf3, 0x83, 0x06, 0x6e, 0xa9, 0x61, 0x04, 0x2c, 0x3d, 0x45, 0x69, 0x7c,
+	0xad, 0x59, 0x5c, 0x38, 0x58, 0xaf, 0x3a, 0x58, 0x75, 0x4a, 0xff, 0x2f, 0x4e, 0x19, 0x57, 0x3a,
+	0xd5, 0x99, 0x6a, 0x60, 0x7e, 0xff, 0x14, 0xc7, 0x01, 0xc6, 0x6f, 0x39, 0x29, 0x5f, 0x83, 0x25,
+	0xc4, 0x39, 0xe6, 0x1c, 0xc7, 0x61, 0x21, 0xa9, 0x5f, 0x53, 0xb2, 0x59, 0x0a, 0x75, 0xa5, 0x4e,
+	0xe7, 0xaf, 0x3a, 0x68, 0x96, 0xfb, 0x00, 0x71, 0xc4, 0xae, 0x39, 0x89, 0x26, 0xa8, 0x93, 0x58,
+	0x7e, 0xa7, 0x01, 0xeb, 0x24, 0xae, 0xa4, 0x58, 0x7f, 0x2d, 0xc5, 0x1f, 0x01, 0x43, 0xa6, 0xc7,
+	0x78, 0x83, 0xf4, 0x48, 0x46, 0xc5, 0xcd, 0xb9, 0x9b, 0xef, 0x5d, 0x8c, 0x29, 0x4b, 0xe4, 0x86,
+	0x2c, 0x42, 0x55, 0xe4, 0xa7, 0x94, 0xd1, 0x08, 0xcb, 0x45, 0x30, 0xa0, 0x2a, 0xcc, 0x4f, 0xc0,
+	0x3c, 0xcb, 0x44, 0xc4, 0x8a, 0xc0, 0x37, 0xb7, 0x3a, 0xee, 0xa5, 0xe7, 0xcb, 0x2d, 0xfd, 0xdb,
+	0x57, 0x48, 0x58, 0x52, 0xfe, 0xc9, 0xe7, 0x62, 0x25, 0x9f, 0x9d, 0x3f, 0x35, 0xd0, 0xea, 0x9e,
+	0x22, 0x22, 0x08, 0x1d, 0xde, 0xe8, 0x29, 0xda, 0x04, 0x66, 0x8c, 0xb9, 0x20, 0x14, 0x09, 0xc2,
+	0x68, 0x18, 0xb3, 0x04, 0x11, 0x2a, 0x87, 0x70, 0x0b, 0x2e, 0x57, 0x3a, 0xbb, 0xb2, 0x51, 0x71,
+	0x50, 0xff, 0xbf, 0x1c, 0x34, 0xaa, 0x0e, 0xde, 0x01, 0x8d, 0x81, 0x5c, 0x69, 0x39, 0xa1, 0x05,
+	0x58, 0x54, 0x9d, 0xef, 0x35, 0xd0, 0xfc, 0x32, 0xc3, 0xd9, 0x0d, 0x1f, 0xde, 0x37, 0xfc, 0xda,
+	0x7f, 0x4b, 0xa0, 0x4a, 0xaa, 0x51, 0x26, 0x75, 0xe3, 0xbb, 0x3a, 0x58, 0x9a, 0x19, 0xa0, 0xf9,
+	0x29, 0x78, 0xaf, 0x0f, 0xbb, 0x0f, 0x0f, 0x02, 0x1f, 0x86, 0xfb, 0x87, 0xfd, 0x9d, 0xfd, 0xcf,
+	0xfd, 0xf0, 0xf0, 0xe1, 0xc1, 0x17, 0xfe, 0x4e, 0x2f, 0xe8, 0xf9, 0xbb, 0xad, 0x9a, 0x65, 0x4f,
+	0xce, 0x1c, 0x6b, 0x86, 0x76, 0x48, 0xf9, 0x18, 0x47, 0x64, 0x40, 0x70, 0x6c, 0x7e, 0x0c, 0x56,
+	0x2f, 0x29, 0xf8, 0x5f, 0xf9, 0x3b, 0x87, 0x7d, 0x7f, 0xb7, 0xa5, 0x59, 0xef, 0x4e, 0xce, 0x9c,
+	0xbb, 0x33, 0x74, 0xff, 0x31, 0x8e, 0x32, 0x81, 0x63, 0xf3, 0x43, 0x70, 0xf7, 0x12, 0x37, 0xe8,
+	0xf6, 0xf6, 0xfc, 0xdd, 0x56, 0xdd, 0x5a, 0x9d, 0x9c, 0x39, 0xb7, 0x67, 0x98, 0xea, 0x21, 0xbd,
+	0xf2, 0xce, 0xa0, 0xbb, 0xb7, 0xb7, 0xdd, 0xdd, 0xf9, 0xac, 0xa5, 0x5f, 0x79, 0x67, 0x80, 0x46,
+	0xa3, 0x23, 0x14, 0x3d, 0xb2, 0x8c, 0x6f, 0x7f, 0xb4, 0x6b, 0xdb, 0xef, 0x3f, 0x9f, 0xda, 0xda,
+	0x8b, 0xa9, 0xad, 0xfd, 0x3e, 0xb5, 0xb5, 0xa7, 0xe7, 0x76, 0xed, 0xc5, 0xb9, 0x5d, 0xfb, 0xe5,
+	0xdc, 0xae, 0x7d, 0x63, 0x5e, 0xe4, 0x3d, 0xc6, 0x27, 0x9e, 0x78, 0x32, 0xc6, 0xfc, 0xa8, 0x21,
+	0xb7, 0xf6, 0x83, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x90, 0xa5, 0x34, 0x03, 0xf3, 0x07, 0x00,
+	0x00,
+}
+
+func (m *FailedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FirstFailureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FirstFailureTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTransfer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.FirstFailureHeight != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.FirstFailureHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextRetryHeight != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.NextRetryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRetryHeight != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.NextRetryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwedFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwedFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwedFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AssessedAmount.Size()
+		i -= size
+		if _, err := m.AssessedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTransfer(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AwaitingTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AwaitingTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AwaitingTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FailedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTransfer(uint64(m.Height))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovTransfer(uint64(m.Attempts))
+	}
+	if m.NextRetryHeight != 0 {
+		n += 1 + sovTransfer(uint64(m.NextRetryHeight))
+	}
+	if m.FirstFailureHeight != 0 {
+		n += 1 + sovTransfer(uint64(m.FirstFailureHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FirstFailureTime)
+	n += 1 + l + sovTransfer(uint64(l))
+	return n
+}
+
+func (m *FailedForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovTransfer(uint64(m.Attempts))
+	}
+	if m.NextRetryHeight != 0 {
+		n += 1 + sovTransfer(uint64(m.NextRetryHeight))
+	}
+	return n
+}
+
+func (m *OwedFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	l = m.AssessedAmount.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	return n
+}
+
+func (m *TransferRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTransfer(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTransfer(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTransfer(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTransfer(uint64(m.Nonce))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovTransfer(uint64(m.Outcome))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *AwaitingTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTransfer(uint64(m.DestinationDomain))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Failed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueuedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTransfer(uint64(m.DestinationDomain))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTransfer(uint64(m.Height))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTransfer(uint64(m.Id))
+	}
+	return n
+}
+
+func sovTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransfer(x uint64) (n int) {
+	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FailedTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
+			}
+			m.NextRetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstFailureHeight", wireType)
+			}
+			m.FirstFailureHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstFailureHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstFailureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FirstFailureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
+			}
+			m.NextRetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwedFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwedFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwedFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssessedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AssessedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= TransferOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AwaitingTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AwaitingTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AwaitingTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransfer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransfer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransfer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransfer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransfer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransfer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransfer = fmt.Errorf("proto: unexpected end of group")
+)
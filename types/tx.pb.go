@@ -12,18 +12,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +44,15 @@ type MsgRegisterAccount struct {
 	// transfer in case the CCTP transfer fails.
 	FallbackRecipient string `protobuf:"bytes,4,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
 	DestinationCaller []byte `protobuf:"bytes,5,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	// An optional block height from which the account expires. Zero means no expiration.
+	ExpirationHeight uint64 `protobuf:"varint,6,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// An optional time from which the account expires.
+	ExpirationTime *time.Time `protobuf:"bytes,7,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+	// The version of the scheme used to derive the account address.
+	AddressVersion uint32 `protobuf:"varint,8,opt,name=address_version,json=addressVersion,proto3" json:"address_version,omitempty"`
+	// An optional salt used to derive a different address for the same properties. Only
+	// supported from the address version 1.
+	Salt []byte `protobuf:"bytes,9,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
 func (m *MsgRegisterAccount) Reset()         { *m = MsgRegisterAccount{} }
@@ -131,6 +144,15 @@ type MsgRegisterAccountSignerlessly struct {
 	// transfer in case the CCTP transfer fails.
 	FallbackRecipient string `protobuf:"bytes,4,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
 	DestinationCaller []byte `protobuf:"bytes,5,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	// An optional block height from which the account expires. Zero means no expiration.
+	ExpirationHeight uint64 `protobuf:"varint,6,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// An optional time from which the account expires.
+	ExpirationTime *time.Time `protobuf:"bytes,7,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+	// The version of the scheme used to derive the account address.
+	AddressVersion uint32 `protobuf:"varint,8,opt,name=address_version,json=addressVersion,proto3" json:"address_version,omitempty"`
+	// An optional salt used to derive a different address for the same properties. Only
+	// supported from the address version 1.
+	Salt []byte `protobuf:"bytes,9,opt,name=salt,proto3" json:"salt,omitempty"`
 }
 
 func (m *MsgRegisterAccountSignerlessly) Reset()         { *m = MsgRegisterAccountSignerlessly{} }
@@ -291,579 +313,3974 @@ func (m *MsgClearAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClearAccountResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterAccount)(nil), "noble.autocctp.v1.MsgRegisterAccount")
-	proto.RegisterType((*MsgRegisterAccountResponse)(nil), "noble.autocctp.v1.MsgRegisterAccountResponse")
-	proto.RegisterType((*MsgRegisterAccountSignerlessly)(nil), "noble.autocctp.v1.MsgRegisterAccountSignerlessly")
-	proto.RegisterType((*MsgRegisterAccountSignerlesslyResponse)(nil), "noble.autocctp.v1.MsgRegisterAccountSignerlesslyResponse")
-	proto.RegisterType((*MsgClearAccount)(nil), "noble.autocctp.v1.MsgClearAccount")
-	proto.RegisterType((*MsgClearAccountResponse)(nil), "noble.autocctp.v1.MsgClearAccountResponse")
+// MsgUpdateParams is the message used by the authority to update the module parameters.
+type MsgUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func init() { proto.RegisterFile("noble/autocctp/v1/tx.proto", fileDescriptor_7d25acbeb4cbf6b7) }
-
-var fileDescriptor_7d25acbeb4cbf6b7 = []byte{
-	// 539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x54, 0x4f, 0x6b, 0xd4, 0x4e,
-	0x18, 0xce, 0xa4, 0xbf, 0xf6, 0x57, 0x87, 0xd6, 0xb2, 0x43, 0xa1, 0x69, 0x0a, 0xe9, 0x12, 0xa8,
-	0x2c, 0xc1, 0x4d, 0xdc, 0x16, 0x04, 0x7b, 0xeb, 0x56, 0xf0, 0xb4, 0x20, 0xe9, 0x4d, 0xc4, 0x25,
-	0x9b, 0x8c, 0xc3, 0x60, 0x32, 0x13, 0x32, 0xd3, 0xc5, 0xde, 0xc4, 0x93, 0xe8, 0xc5, 0x8f, 0xd0,
-	0x8f, 0xb0, 0x07, 0x3f, 0x44, 0x0f, 0x1e, 0x8a, 0x27, 0x8f, 0xb2, 0x2b, 0xac, 0x1f, 0x43, 0x36,
-	0x7f, 0xd6, 0x98, 0xb5, 0xbb, 0x2b, 0x3d, 0x7a, 0x09, 0x99, 0xf7, 0x79, 0xdf, 0x67, 0x9e, 0x79,
-	0x1e, 0x66, 0xa0, 0xce, 0x78, 0x2f, 0xc4, 0x8e, 0x77, 0x2e, 0xb9, 0xef, 0xcb, 0xd8, 0xe9, 0xb7,
-	0x1c, 0xf9, 0xda, 0x8e, 0x13, 0x2e, 0x39, 0xaa, 0xa5, 0x98, 0x5d, 0x60, 0x76, 0xbf, 0xa5, 0xd7,
-	0xbc, 0x88, 0x32, 0xee, 0xa4, 0xdf, 0xac, 0x4b, 0xdf, 0xf1, 0xb9, 0x88, 0xb8, 0x70, 0x22, 0x41,
-	0x26, 0xd3, 0x91, 0x20, 0x39, 0xb0, 0x9b, 0x01, 0xdd, 0x74, 0xe5, 0x64, 0x8b, 0x1c, 0xda, 0x26,
-	0x9c, 0xf0, 0xac, 0x3e, 0xf9, 0xcb, 0xaa, 0xe6, 0x67, 0x15, 0xa2, 0x8e, 0x20, 0x2e, 0x26, 0x54,
-	0x48, 0x9c, 0x9c, 0xf8, 0x3e, 0x3f, 0x67, 0x12, 0x3d, 0x80, 0x6b, 0x82, 0x12, 0x86, 0x13, 0x0d,
-	0xd4, 0x41, 0xe3, 0x4e, 0x5b, 0xfb, 0xf2, 0xa9, 0xb9, 0x9d, 0xd3, 0x9d, 0x04, 0x41, 0x82, 0x85,
-	0x38, 0x93, 0x09, 0x65, 0xc4, 0xcd, 0xfb, 0x50, 0x13, 0xa2, 0x00, 0x0b, 0x49, 0x99, 0x27, 0x29,
-	0x67, 0xdd, 0x80, 0x47, 0x1e, 0x65, 0x9a, 0x5a, 0x07, 0x8d, 0x4d, 0xb7, 0x56, 0x42, 0x1e, 0xa7,
-	0x00, 0x3a, 0x80, 0x77, 0x23, 0xca, 0x64, 0x37, 0xc1, 0x3e, 0x8d, 0x29, 0x66, 0x52, 0x5b, 0xa9,
-	0x83, 0xc6, 0x86, 0xbb, 0x39, 0xa9, 0xba, 0x45, 0x11, 0x3d, 0x81, 0xe8, 0xa5, 0x17, 0x86, 0x3d,
-	0xcf, 0x7f, 0x55, 0x6a, 0xfd, 0x6f, 0x81, 0xa6, 0x5a, 0x31, 0xf3, 0x8b, 0xa8, 0x22, 0xcf, 0xf7,
-	0xc2, 0x10, 0x27, 0xda, 0x6a, 0xba, 0x67, 0x59, 0xde, 0x69, 0x0a, 0x1c, 0x3f, 0x7c, 0x77, 0xb9,
-	0xaf, 0xfc, 0xb8, 0xdc, 0x57, 0xde, 0x8e, 0x07, 0x56, 0x7e, 0xc4, 0xf7, 0xe3, 0x81, 0x65, 0x54,
-	0xa2, 0xab, 0xf8, 0x66, 0x3e, 0x85, 0xfa, 0xac, 0x9b, 0x2e, 0x16, 0x31, 0x67, 0x02, 0xa3, 0x43,
-	0xf8, 0xbf, 0x97, 0x09, 0x5d, 0x68, 0x6b, 0xd1, 0x68, 0x7e, 0x57, 0xa1, 0x31, 0x4b, 0x79, 0x96,
-	0x2a, 0x0a, 0xb1, 0x10, 0xe1, 0xc5, 0x3f, 0x1f, 0x56, 0xfb, 0x86, 0xb0, 0xac, 0xf9, 0x61, 0x95,
-	0x3d, 0x34, 0x9f, 0xc3, 0x7b, 0xf3, 0x5d, 0xbe, 0x55, 0x88, 0x57, 0x00, 0x6e, 0x75, 0x04, 0x39,
-	0x0d, 0xb1, 0x77, 0x8b, 0x2b, 0x56, 0xda, 0x59, 0x5d, 0x72, 0x67, 0xa4, 0xc3, 0xf5, 0xc2, 0xdf,
-	0x34, 0xb4, 0x75, 0x77, 0xba, 0x3e, 0x3e, 0xba, 0xc1, 0xb7, 0xbd, 0x8a, 0x6f, 0x65, 0xd9, 0xe6,
-	0x2e, 0xdc, 0xa9, 0x9c, 0xa4, 0x70, 0xe6, 0x70, 0xa4, 0xc2, 0x95, 0x8e, 0x20, 0x88, 0xc0, 0xad,
-	0xea, 0x7b, 0x72, 0x60, 0xcf, 0xbc, 0x6b, 0xf6, 0xac, 0xdf, 0x7a, 0x73, 0xa9, 0xb6, 0x69, 0x14,
-	0x1f, 0x00, 0xdc, 0x9b, 0x77, 0x31, 0x5a, 0x4b, 0xd1, 0x95, 0x47, 0xf4, 0x47, 0x7f, 0x3d, 0x32,
-	0x55, 0xf3, 0x02, 0x6e, 0xfc, 0x16, 0xb0, 0xf9, 0x67, 0xaa, 0x72, 0x8f, 0x6e, 0x2d, 0xee, 0x29,
-	0xf8, 0xf5, 0xd5, 0x37, 0xe3, 0x81, 0x05, 0xda, 0xf7, 0xaf, 0x86, 0x06, 0xb8, 0x1e, 0x1a, 0xe0,
-	0xdb, 0xd0, 0x00, 0x1f, 0x47, 0x86, 0x72, 0x3d, 0x32, 0x94, 0xaf, 0x23, 0x43, 0x79, 0x86, 0xa6,
-	0x2c, 0x01, 0xee, 0x3b, 0xf2, 0x22, 0xc6, 0xa2, 0xb7, 0x96, 0x3e, 0xf3, 0x47, 0x3f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x37, 0x12, 0x29, 0xd5, 0x74, 0x06, 0x00, 0x00,
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{6}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error)
-	RegisterAccountSignerlessly(ctx context.Context, in *MsgRegisterAccountSignerlessly, opts ...grpc.CallOption) (*MsgRegisterAccountSignerlesslyResponse, error)
-	ClearAccount(ctx context.Context, in *MsgClearAccount, opts ...grpc.CallOption) (*MsgClearAccountResponse, error)
+type MsgUpdateParamsResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{7}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error) {
-	out := new(MsgRegisterAccountResponse)
-	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/RegisterAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RegisterAccountSignerlessly(ctx context.Context, in *MsgRegisterAccountSignerlessly, opts ...grpc.CallOption) (*MsgRegisterAccountSignerlesslyResponse, error) {
-	out := new(MsgRegisterAccountSignerlesslyResponse)
-	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/RegisterAccountSignerlessly", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgAddDomain is the message used by the authority to add a new entry to the
+// destination domains registry.
+type MsgAddDomain struct {
+	Authority string       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Domain    DomainConfig `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
 }
 
-func (c *msgClient) ClearAccount(ctx context.Context, in *MsgClearAccount, opts ...grpc.CallOption) (*MsgClearAccountResponse, error) {
-	out := new(MsgClearAccountResponse)
-	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/ClearAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddDomain) Reset()         { *m = MsgAddDomain{} }
+func (m *MsgAddDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgAddDomain) ProtoMessage()    {}
+func (*MsgAddDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{8}
+}
+func (m *MsgAddDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	RegisterAccount(context.Context, *MsgRegisterAccount) (*MsgRegisterAccountResponse, error)
-	RegisterAccountSignerlessly(context.Context, *MsgRegisterAccountSignerlessly) (*MsgRegisterAccountSignerlesslyResponse, error)
-	ClearAccount(context.Context, *MsgClearAccount) (*MsgClearAccountResponse, error)
+func (m *MsgAddDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddDomain.Merge(m, src)
+}
+func (m *MsgAddDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddDomain.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgAddDomain proto.InternalMessageInfo
+
+type MsgAddDomainResponse struct {
 }
 
-func (*UnimplementedMsgServer) RegisterAccount(ctx context.Context, req *MsgRegisterAccount) (*MsgRegisterAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccount not implemented")
+func (m *MsgAddDomainResponse) Reset()         { *m = MsgAddDomainResponse{} }
+func (m *MsgAddDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddDomainResponse) ProtoMessage()    {}
+func (*MsgAddDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{9}
 }
-func (*UnimplementedMsgServer) RegisterAccountSignerlessly(ctx context.Context, req *MsgRegisterAccountSignerlessly) (*MsgRegisterAccountSignerlesslyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccountSignerlessly not implemented")
+func (m *MsgAddDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ClearAccount(ctx context.Context, req *MsgClearAccount) (*MsgClearAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearAccount not implemented")
+func (m *MsgAddDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddDomainResponse.Merge(m, src)
+}
+func (m *MsgAddDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddDomainResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgAddDomainResponse proto.InternalMessageInfo
+
+// MsgUpdateDomain is the message used by the authority to update an existing entry of
+// the destination domains registry.
+type MsgUpdateDomain struct {
+	Authority string       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Domain    DomainConfig `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
 }
 
-func _Msg_RegisterAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.autocctp.v1.Msg/RegisterAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterAccount(ctx, req.(*MsgRegisterAccount))
+func (m *MsgUpdateDomain) Reset()         { *m = MsgUpdateDomain{} }
+func (m *MsgUpdateDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDomain) ProtoMessage()    {}
+func (*MsgUpdateDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{10}
+}
+func (m *MsgUpdateDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDomain.Merge(m, src)
+}
+func (m *MsgUpdateDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDomain.DiscardUnknown(m)
 }
 
-func _Msg_RegisterAccountSignerlessly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterAccountSignerlessly)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterAccountSignerlessly(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.autocctp.v1.Msg/RegisterAccountSignerlessly",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterAccountSignerlessly(ctx, req.(*MsgRegisterAccountSignerlessly))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgUpdateDomain proto.InternalMessageInfo
+
+type MsgUpdateDomainResponse struct {
 }
 
-func _Msg_ClearAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClearAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClearAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.autocctp.v1.Msg/ClearAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClearAccount(ctx, req.(*MsgClearAccount))
+func (m *MsgUpdateDomainResponse) Reset()         { *m = MsgUpdateDomainResponse{} }
+func (m *MsgUpdateDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDomainResponse) ProtoMessage()    {}
+func (*MsgUpdateDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{11}
+}
+func (m *MsgUpdateDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDomainResponse.Merge(m, src)
+}
+func (m *MsgUpdateDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDomainResponse.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.autocctp.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterAccount",
-			Handler:    _Msg_RegisterAccount_Handler,
-		},
-		{
-			MethodName: "RegisterAccountSignerlessly",
-			Handler:    _Msg_RegisterAccountSignerlessly_Handler,
-		},
-		{
-			MethodName: "ClearAccount",
-			Handler:    _Msg_ClearAccount_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/autocctp/v1/tx.proto",
+var xxx_messageInfo_MsgUpdateDomainResponse proto.InternalMessageInfo
+
+// MsgDisableDomain is the message used by the authority to disable an existing entry of
+// the destination domains registry.
+type MsgDisableDomain struct {
+	Authority         string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	DestinationDomain uint32 `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
 }
 
-func (m *MsgRegisterAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDisableDomain) Reset()         { *m = MsgDisableDomain{} }
+func (m *MsgDisableDomain) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableDomain) ProtoMessage()    {}
+func (*MsgDisableDomain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{12}
+}
+func (m *MsgDisableDomain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableDomain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableDomain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDisableDomain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableDomain.Merge(m, src)
+}
+func (m *MsgDisableDomain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableDomain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableDomain.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDisableDomain proto.InternalMessageInfo
+
+type MsgDisableDomainResponse struct {
 }
 
-func (m *MsgRegisterAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DestinationCaller) > 0 {
-		i -= len(m.DestinationCaller)
-		copy(dAtA[i:], m.DestinationCaller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationCaller)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.FallbackRecipient) > 0 {
-		i -= len(m.FallbackRecipient)
-		copy(dAtA[i:], m.FallbackRecipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FallbackRecipient)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MintRecipient) > 0 {
-		i -= len(m.MintRecipient)
-		copy(dAtA[i:], m.MintRecipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MintRecipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DestinationDomain != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgDisableDomainResponse) Reset()         { *m = MsgDisableDomainResponse{} }
+func (m *MsgDisableDomainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableDomainResponse) ProtoMessage()    {}
+func (*MsgDisableDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{13}
 }
-
-func (m *MsgRegisterAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDisableDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDisableDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableDomainResponse.Merge(m, src)
+}
+func (m *MsgDisableDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableDomainResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgDisableDomainResponse proto.InternalMessageInfo
+
+// MsgSetFallbackPolicy is the message used by the fallback recipient to set, or remove,
+// the fallback policy of an AutoCCTP account.
+type MsgSetFallbackPolicy struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The policy to set. If nil, the fallback policy of the account is removed.
+	FallbackPolicy *FallbackPolicy `protobuf:"bytes,3,opt,name=fallback_policy,json=fallbackPolicy,proto3" json:"fallback_policy,omitempty"`
 }
 
-func (m *MsgRegisterAccountSignerlessly) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFallbackPolicy) Reset()         { *m = MsgSetFallbackPolicy{} }
+func (m *MsgSetFallbackPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFallbackPolicy) ProtoMessage()    {}
+func (*MsgSetFallbackPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{14}
+}
+func (m *MsgSetFallbackPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFallbackPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFallbackPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterAccountSignerlessly) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetFallbackPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFallbackPolicy.Merge(m, src)
+}
+func (m *MsgSetFallbackPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFallbackPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFallbackPolicy.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterAccountSignerlessly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DestinationCaller) > 0 {
-		i -= len(m.DestinationCaller)
-		copy(dAtA[i:], m.DestinationCaller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationCaller)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.FallbackRecipient) > 0 {
-		i -= len(m.FallbackRecipient)
-		copy(dAtA[i:], m.FallbackRecipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FallbackRecipient)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MintRecipient) > 0 {
-		i -= len(m.MintRecipient)
-		copy(dAtA[i:], m.MintRecipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MintRecipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DestinationDomain != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetFallbackPolicy proto.InternalMessageInfo
+
+type MsgSetFallbackPolicyResponse struct {
 }
 
-func (m *MsgRegisterAccountSignerlesslyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFallbackPolicyResponse) Reset()         { *m = MsgSetFallbackPolicyResponse{} }
+func (m *MsgSetFallbackPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFallbackPolicyResponse) ProtoMessage()    {}
+func (*MsgSetFallbackPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{15}
+}
+func (m *MsgSetFallbackPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFallbackPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFallbackPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterAccountSignerlesslyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetFallbackPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFallbackPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetFallbackPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFallbackPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFallbackPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterAccountSignerlesslyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetFallbackPolicyResponse proto.InternalMessageInfo
+
+// MsgSetPaused is the message used by the authority to pause, or unpause, the execution
+// of the automatic transfers. While paused, AutoCCTP accounts keep accepting deposits and
+// the transfers are executed once unpaused.
+type MsgSetPaused struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Paused    bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// The destination domains to pause or unpause. If empty, the global pause state is updated.
+	DestinationDomains []uint32 `protobuf:"varint,3,rep,packed,name=destination_domains,json=destinationDomains,proto3" json:"destination_domains,omitempty"`
 }
 
-func (m *MsgClearAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetPaused) Reset()         { *m = MsgSetPaused{} }
+func (m *MsgSetPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPaused) ProtoMessage()    {}
+func (*MsgSetPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{16}
+}
+func (m *MsgSetPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPaused.Merge(m, src)
+}
+func (m *MsgSetPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPaused.DiscardUnknown(m)
 }
 
-func (m *MsgClearAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetPaused proto.InternalMessageInfo
+
+type MsgSetPausedResponse struct {
 }
 
-func (m *MsgClearAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Fallback {
-		i--
-		if m.Fallback {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgSetPausedResponse) Reset()         { *m = MsgSetPausedResponse{} }
+func (m *MsgSetPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPausedResponse) ProtoMessage()    {}
+func (*MsgSetPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{17}
+}
+func (m *MsgSetPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgClearAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPausedResponse.Merge(m, src)
+}
+func (m *MsgSetPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPausedResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClearAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetPausedResponse proto.InternalMessageInfo
+
+// MsgSetForwardOtherDenoms is the message used by the fallback recipient to allow, or
+// disallow, an AutoCCTP account to receive denoms other than the minting denom, which are
+// forwarded to the fallback recipient.
+type MsgSetForwardOtherDenoms struct {
+	Signer             string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Address            string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ForwardOtherDenoms bool   `protobuf:"varint,3,opt,name=forward_other_denoms,json=forwardOtherDenoms,proto3" json:"forward_other_denoms,omitempty"`
 }
 
-func (m *MsgClearAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetForwardOtherDenoms) Reset()         { *m = MsgSetForwardOtherDenoms{} }
+func (m *MsgSetForwardOtherDenoms) String() string { return proto.CompactTextString(m) }
+func (*MsgSetForwardOtherDenoms) ProtoMessage()    {}
+func (*MsgSetForwardOtherDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{18}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSetForwardOtherDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgRegisterAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DestinationDomain != 0 {
-		n += 1 + sovTx(uint64(m.DestinationDomain))
-	}
-	l = len(m.MintRecipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetForwardOtherDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetForwardOtherDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.FallbackRecipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetForwardOtherDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetForwardOtherDenoms.Merge(m, src)
+}
+func (m *MsgSetForwardOtherDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetForwardOtherDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetForwardOtherDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetForwardOtherDenoms proto.InternalMessageInfo
+
+type MsgSetForwardOtherDenomsResponse struct {
+}
+
+func (m *MsgSetForwardOtherDenomsResponse) Reset()         { *m = MsgSetForwardOtherDenomsResponse{} }
+func (m *MsgSetForwardOtherDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetForwardOtherDenomsResponse) ProtoMessage()    {}
+func (*MsgSetForwardOtherDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{19}
+}
+func (m *MsgSetForwardOtherDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetForwardOtherDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetForwardOtherDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.DestinationCaller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetForwardOtherDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetForwardOtherDenomsResponse.Merge(m, src)
+}
+func (m *MsgSetForwardOtherDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetForwardOtherDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetForwardOtherDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetForwardOtherDenomsResponse proto.InternalMessageInfo
+
+// MsgDeregisterAccount is the message used by the fallback recipient to deregister an
+// AutoCCTP account, sending its balance to the fallback recipient.
+type MsgDeregisterAccount struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgDeregisterAccount) Reset()         { *m = MsgDeregisterAccount{} }
+func (m *MsgDeregisterAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterAccount) ProtoMessage()    {}
+func (*MsgDeregisterAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{20}
+}
+func (m *MsgDeregisterAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgDeregisterAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterAccount.Merge(m, src)
+}
+func (m *MsgDeregisterAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterAccount.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgDeregisterAccount proto.InternalMessageInfo
+
+type MsgDeregisterAccountResponse struct {
+}
+
+func (m *MsgDeregisterAccountResponse) Reset()         { *m = MsgDeregisterAccountResponse{} }
+func (m *MsgDeregisterAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterAccountResponse) ProtoMessage()    {}
+func (*MsgDeregisterAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d25acbeb4cbf6b7, []int{21}
+}
+func (m *MsgDeregisterAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgDeregisterAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterAccountResponse.Merge(m, src)
+}
+func (m *MsgDeregisterAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterAccountResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterAccount)(nil), "noble.autocctp.v1.MsgRegisterAccount")
+	proto.RegisterType((*MsgRegisterAccountResponse)(nil), "noble.autocctp.v1.MsgRegisterAccountResponse")
+	proto.RegisterType((*MsgRegisterAccountSignerlessly)(nil), "noble.autocctp.v1.MsgRegisterAccountSignerlessly")
+	proto.RegisterType((*MsgRegisterAccountSignerlesslyResponse)(nil), "noble.autocctp.v1.MsgRegisterAccountSignerlesslyResponse")
+	proto.RegisterType((*MsgClearAccount)(nil), "noble.autocctp.v1.MsgClearAccount")
+	proto.RegisterType((*MsgClearAccountResponse)(nil), "noble.autocctp.v1.MsgClearAccountResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "noble.autocctp.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "noble.autocctp.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddDomain)(nil), "noble.autocctp.v1.MsgAddDomain")
+	proto.RegisterType((*MsgAddDomainResponse)(nil), "noble.autocctp.v1.MsgAddDomainResponse")
+	proto.RegisterType((*MsgUpdateDomain)(nil), "noble.autocctp.v1.MsgUpdateDomain")
+	proto.RegisterType((*MsgUpdateDomainResponse)(nil), "noble.autocctp.v1.MsgUpdateDomainResponse")
+	proto.RegisterType((*MsgDisableDomain)(nil), "noble.autocctp.v1.MsgDisableDomain")
+	proto.RegisterType((*MsgDisableDomainResponse)(nil), "noble.autocctp.v1.MsgDisableDomainResponse")
+	proto.RegisterType((*MsgSetFallbackPolicy)(nil), "noble.autocctp.v1.MsgSetFallbackPolicy")
+	proto.RegisterType((*MsgSetFallbackPolicyResponse)(nil), "noble.autocctp.v1.MsgSetFallbackPolicyResponse")
+	proto.RegisterType((*MsgSetPaused)(nil), "noble.autocctp.v1.MsgSetPaused")
+	proto.RegisterType((*MsgSetPausedResponse)(nil), "noble.autocctp.v1.MsgSetPausedResponse")
+	proto.RegisterType((*MsgSetForwardOtherDenoms)(nil), "noble.autocctp.v1.MsgSetForwardOtherDenoms")
+	proto.RegisterType((*MsgSetForwardOtherDenomsResponse)(nil), "noble.autocctp.v1.MsgSetForwardOtherDenomsResponse")
+	proto.RegisterType((*MsgDeregisterAccount)(nil), "noble.autocctp.v1.MsgDeregisterAccount")
+	proto.RegisterType((*MsgDeregisterAccountResponse)(nil), "noble.autocctp.v1.MsgDeregisterAccountResponse")
+}
+
+func init() { proto.RegisterFile("noble/autocctp/v1/tx.proto", fileDescriptor_7d25acbeb4cbf6b7) }
+
+var fileDescriptor_7d25acbeb4cbf6b7 = []byte{
+	// 1166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x26, 0x6e, 0x1a, 0x4f, 0xbe, 0xea, 0x21, 0xb4, 0x9b, 0x6d, 0x65, 0x9b, 0x85, 0x12,
+	0xcb, 0x21, 0xde, 0xc6, 0x91, 0x0a, 0x44, 0xbd, 0xc4, 0x89, 0xf8, 0x92, 0x22, 0xa2, 0x0d, 0xe5,
+	0x80, 0x10, 0xd6, 0xc6, 0x3b, 0xde, 0xac, 0xd8, 0xdd, 0xb1, 0x76, 0x26, 0xa1, 0xb9, 0x41, 0x4f,
+	0x08, 0x84, 0xd4, 0x2b, 0xb7, 0x1e, 0x39, 0x46, 0x08, 0x71, 0xe5, 0x02, 0x52, 0x8e, 0x15, 0x27,
+	0x4e, 0x80, 0x92, 0x43, 0x38, 0xf2, 0x27, 0x20, 0xcf, 0x8e, 0xd7, 0xb3, 0x5f, 0xb1, 0x93, 0x48,
+	0x15, 0x48, 0x5c, 0x2c, 0xcf, 0xbc, 0xdf, 0xfb, 0xf8, 0xbd, 0xf7, 0xe6, 0xcd, 0x2c, 0x50, 0x3c,
+	0xbc, 0xeb, 0x20, 0xcd, 0xd8, 0xa7, 0xb8, 0xdd, 0xa6, 0x5d, 0xed, 0x60, 0x45, 0xa3, 0x8f, 0xea,
+	0x5d, 0x1f, 0x53, 0x0c, 0x8b, 0x4c, 0x56, 0xef, 0xcb, 0xea, 0x07, 0x2b, 0x4a, 0xd1, 0x70, 0x6d,
+	0x0f, 0x6b, 0xec, 0x37, 0x40, 0x29, 0xb7, 0xda, 0x98, 0xb8, 0x98, 0x68, 0x2e, 0xb1, 0x7a, 0xda,
+	0x2e, 0xb1, 0xb8, 0x60, 0x21, 0x10, 0xb4, 0xd8, 0x4a, 0x0b, 0x16, 0x5c, 0x34, 0x6f, 0x61, 0x0b,
+	0x07, 0xfb, 0xbd, 0x7f, 0x7c, 0xb7, 0x6c, 0x61, 0x6c, 0x39, 0x48, 0x63, 0xab, 0xdd, 0xfd, 0x8e,
+	0x46, 0x6d, 0x17, 0x11, 0x6a, 0xb8, 0xdd, 0x3e, 0x20, 0x19, 0xac, 0xd1, 0x6e, 0xe3, 0x7d, 0x8f,
+	0x72, 0x40, 0x29, 0x09, 0x30, 0xb1, 0x6b, 0xd8, 0x5e, 0xb6, 0xbc, 0x6b, 0xf8, 0x86, 0xcb, 0xe3,
+	0x52, 0xbf, 0xc8, 0x03, 0xb8, 0x45, 0x2c, 0x1d, 0x59, 0x36, 0xa1, 0xc8, 0x5f, 0x0f, 0x8c, 0xc3,
+	0x7b, 0x60, 0x82, 0xd8, 0x96, 0x87, 0x7c, 0x59, 0xaa, 0x48, 0xd5, 0x42, 0x53, 0xfe, 0xf5, 0x87,
+	0xe5, 0x79, 0x4e, 0x68, 0xdd, 0x34, 0x7d, 0x44, 0xc8, 0x0e, 0xf5, 0x6d, 0xcf, 0xd2, 0x39, 0x0e,
+	0x2e, 0x03, 0x68, 0x22, 0x42, 0x6d, 0xcf, 0xa0, 0x36, 0xf6, 0x5a, 0x41, 0x10, 0xf2, 0x58, 0x45,
+	0xaa, 0xce, 0xe8, 0x45, 0x41, 0xb2, 0xc9, 0x04, 0xf0, 0x2e, 0x98, 0x75, 0x6d, 0x8f, 0xb6, 0x7c,
+	0xd4, 0xb6, 0xbb, 0x36, 0xf2, 0xa8, 0x3c, 0x5e, 0x91, 0xaa, 0xd3, 0xfa, 0x4c, 0x6f, 0x57, 0xef,
+	0x6f, 0xc2, 0xb7, 0x01, 0xec, 0x18, 0x8e, 0xb3, 0x6b, 0xb4, 0x3f, 0x15, 0xa0, 0xf9, 0x21, 0x31,
+	0x15, 0xfb, 0x3a, 0x03, 0x43, 0xb1, 0xf0, 0xda, 0x86, 0xe3, 0x20, 0x5f, 0xbe, 0xc6, 0x7c, 0x8a,
+	0xe1, 0x6d, 0x30, 0x01, 0x5c, 0x02, 0x45, 0xf4, 0xa8, 0x6b, 0xfb, 0x01, 0x7a, 0x0f, 0xd9, 0xd6,
+	0x1e, 0x95, 0x27, 0x2a, 0x52, 0x35, 0xaf, 0xdf, 0x18, 0x08, 0xde, 0x61, 0xfb, 0xf0, 0x5d, 0x30,
+	0x27, 0x80, 0x7b, 0x25, 0x94, 0xaf, 0x57, 0xa4, 0xea, 0x54, 0x43, 0xa9, 0x07, 0xf5, 0xad, 0xf7,
+	0xeb, 0x5b, 0xff, 0xa0, 0x5f, 0xdf, 0x66, 0xfe, 0xc9, 0x1f, 0x65, 0x49, 0x9f, 0x1d, 0x28, 0xf6,
+	0x44, 0x70, 0x11, 0xcc, 0x19, 0x01, 0x95, 0xd6, 0x01, 0xf2, 0x89, 0x8d, 0x3d, 0x79, 0x92, 0xa5,
+	0x70, 0x96, 0x6f, 0x7f, 0x18, 0xec, 0x42, 0x08, 0xf2, 0xc4, 0x70, 0xa8, 0x5c, 0x60, 0x0c, 0xd8,
+	0xff, 0xb5, 0xfb, 0x5f, 0x3e, 0x2d, 0xe7, 0xfe, 0x7a, 0x5a, 0xce, 0x3d, 0x3e, 0x3b, 0xaa, 0xf1,
+	0xba, 0x7c, 0x75, 0x76, 0x54, 0x8b, 0xf7, 0x40, 0xac, 0xd8, 0xea, 0x36, 0x50, 0x92, 0x2d, 0xa0,
+	0x23, 0xd2, 0xc5, 0x1e, 0x41, 0xb0, 0x01, 0xae, 0x73, 0xdf, 0x43, 0x7b, 0xa1, 0x0f, 0x54, 0xbf,
+	0xcd, 0x83, 0x52, 0xd2, 0xe4, 0x0e, 0x8b, 0xc8, 0x41, 0x84, 0x38, 0x87, 0xff, 0x77, 0xd8, 0x7f,
+	0xb1, 0xc3, 0x9a, 0x19, 0x1d, 0x56, 0x3b, 0xbf, 0xc3, 0xc4, 0xc2, 0xab, 0x1f, 0x83, 0x57, 0xcf,
+	0x6f, 0x8d, 0x2b, 0x75, 0xde, 0xb1, 0x04, 0xe6, 0xb6, 0x88, 0xb5, 0xe1, 0x20, 0xe3, 0x0a, 0xc3,
+	0x4c, 0xf0, 0x3c, 0x36, 0xa2, 0x67, 0xa8, 0x80, 0xc9, 0x7e, 0x53, 0xb0, 0x4e, 0x9b, 0xd4, 0xc3,
+	0xf5, 0xda, 0x6a, 0x46, 0xde, 0x6e, 0xc7, 0xf2, 0x26, 0x86, 0xad, 0x2e, 0x80, 0x5b, 0x31, 0x26,
+	0xfd, 0xcc, 0xa8, 0x3f, 0x05, 0x2c, 0x1f, 0x76, 0x4d, 0x83, 0xa2, 0x6d, 0x36, 0xcf, 0xe1, 0x7d,
+	0x50, 0x30, 0xf6, 0xe9, 0x1e, 0xf6, 0x6d, 0x7a, 0x38, 0x94, 0xe8, 0x00, 0x0a, 0x1f, 0x80, 0x89,
+	0xe0, 0x46, 0x60, 0x54, 0xa7, 0x1a, 0x0b, 0xf5, 0xc4, 0x25, 0x58, 0x0f, 0x5c, 0x34, 0x0b, 0xc7,
+	0xbf, 0x97, 0x73, 0xdf, 0x9d, 0x1d, 0xd5, 0x24, 0x9d, 0xeb, 0x44, 0x67, 0xce, 0xc0, 0x6a, 0x1a,
+	0x39, 0x31, 0x5a, 0x4e, 0x4e, 0xdc, 0x12, 0xc9, 0x4d, 0x6f, 0x11, 0x6b, 0xdd, 0x34, 0xf9, 0x49,
+	0xbe, 0x2c, 0xb3, 0x26, 0x98, 0x10, 0x86, 0xc4, 0x54, 0xa3, 0x9c, 0xc2, 0x2c, 0x70, 0xb1, 0x81,
+	0xbd, 0x8e, 0x6d, 0x45, 0xf8, 0x05, 0x9a, 0xd1, 0xca, 0x45, 0xf9, 0xc9, 0x31, 0x7e, 0x61, 0xc0,
+	0xea, 0x4d, 0x30, 0x2f, 0x12, 0x08, 0x99, 0xfd, 0x22, 0x96, 0xed, 0x5f, 0x40, 0xee, 0xc2, 0xc5,
+	0xe3, 0xfc, 0xc4, 0xe2, 0xc5, 0x28, 0x7e, 0x2f, 0x81, 0x1b, 0x5b, 0xc4, 0xda, 0xb4, 0x89, 0xb1,
+	0xeb, 0x5c, 0x95, 0xe3, 0xc5, 0x26, 0xfe, 0xda, 0xeb, 0xd9, 0x74, 0xee, 0xc4, 0xe8, 0x44, 0xe2,
+	0x53, 0x15, 0x20, 0xc7, 0x63, 0x0e, 0x09, 0x3d, 0x1e, 0x63, 0xc5, 0xdc, 0x41, 0xf4, 0x2d, 0x7e,
+	0x9a, 0xb7, 0xb1, 0x63, 0xb7, 0x0f, 0x9f, 0xd3, 0x54, 0x79, 0x0f, 0xcc, 0x85, 0xd7, 0x53, 0x97,
+	0x39, 0x66, 0xc3, 0x65, 0xaa, 0xf1, 0x52, 0x4a, 0xbd, 0xa3, 0x11, 0xea, 0xb3, 0x9d, 0xc8, 0x7a,
+	0xed, 0x8d, 0x8c, 0x29, 0x54, 0x89, 0x25, 0x27, 0xc1, 0x55, 0x2d, 0x81, 0x3b, 0x69, 0x39, 0x08,
+	0x93, 0xf4, 0x73, 0x70, 0x64, 0x77, 0x10, 0xdd, 0x36, 0xf6, 0x09, 0x32, 0x2f, 0x5d, 0xf1, 0x9b,
+	0xbd, 0x61, 0xd4, 0xb3, 0xc0, 0x32, 0x34, 0xa9, 0xf3, 0x15, 0xd4, 0xc0, 0x0b, 0xc9, 0x4e, 0x20,
+	0xf2, 0x78, 0x65, 0xbc, 0x3a, 0xa3, 0xc3, 0x44, 0x2b, 0x90, 0x8b, 0x9c, 0xdb, 0x30, 0x6a, 0x7e,
+	0x6e, 0xc3, 0x75, 0x48, 0xef, 0x6f, 0x89, 0x35, 0x48, 0x8f, 0x3f, 0xf6, 0x3f, 0x33, 0x7c, 0xf3,
+	0x7d, 0xba, 0x87, 0xfc, 0x4d, 0xe4, 0x61, 0x97, 0x3c, 0xa7, 0x3e, 0xb8, 0x07, 0xe6, 0x3b, 0x81,
+	0xef, 0x16, 0xee, 0x39, 0x6f, 0x99, 0xcc, 0x3b, 0xbf, 0x69, 0x60, 0x27, 0x11, 0xd7, 0xda, 0x83,
+	0x8c, 0x6a, 0xbf, 0x92, 0x52, 0xed, 0x84, 0xb6, 0xaa, 0x82, 0x4a, 0x16, 0xe3, 0x30, 0x2d, 0x3f,
+	0x4a, 0x2c, 0x5f, 0x9b, 0xc8, 0xbf, 0xf2, 0xd7, 0xc3, 0x25, 0x52, 0x32, 0x72, 0x3b, 0x27, 0xe2,
+	0xe3, 0xed, 0x9c, 0xd8, 0xef, 0x13, 0x6b, 0x7c, 0x53, 0x00, 0xe3, 0x5b, 0xc4, 0x82, 0x16, 0x98,
+	0x8b, 0x7f, 0x18, 0xdd, 0x4d, 0x39, 0x76, 0xc9, 0xe7, 0x8c, 0xb2, 0x3c, 0x12, 0x2c, 0x7c, 0xe9,
+	0x7c, 0x2d, 0x81, 0xdb, 0xe7, 0x3d, 0x96, 0x57, 0x46, 0x32, 0x27, 0xaa, 0x28, 0x6f, 0x5e, 0x58,
+	0x25, 0x8c, 0xe6, 0x13, 0x30, 0x1d, 0x79, 0x3f, 0xa9, 0xe9, 0xa6, 0x44, 0x8c, 0x52, 0x1b, 0x8e,
+	0x11, 0xed, 0x47, 0x5e, 0x2e, 0x19, 0xf6, 0x45, 0x4c, 0x96, 0xfd, 0xb4, 0x07, 0x04, 0x7c, 0x08,
+	0x0a, 0x83, 0xc7, 0x43, 0x39, 0x5d, 0x31, 0x04, 0x28, 0x8b, 0x43, 0x00, 0xc9, 0xb0, 0xb9, 0xe5,
+	0x73, 0xc3, 0xe6, 0xc6, 0x6b, 0xc3, 0x31, 0xa1, 0x7d, 0x03, 0xcc, 0x44, 0xaf, 0xcd, 0x97, 0xd3,
+	0x95, 0x23, 0x20, 0x65, 0x69, 0x04, 0x50, 0xe8, 0xc2, 0x05, 0xc5, 0xe4, 0x45, 0x96, 0x91, 0x80,
+	0x04, 0x50, 0xd1, 0x46, 0x04, 0x8a, 0x85, 0x18, 0x5c, 0x09, 0xe5, 0x4c, 0xed, 0x00, 0xa0, 0x2c,
+	0x0e, 0x01, 0x84, 0x66, 0x0f, 0xc1, 0x8b, 0xe9, 0xa3, 0x78, 0x29, 0x3b, 0xc0, 0x04, 0x58, 0x59,
+	0xbd, 0x00, 0x58, 0x4c, 0x60, 0x72, 0xdc, 0x65, 0x04, 0x9e, 0x00, 0x66, 0x25, 0x30, 0x73, 0x10,
+	0x29, 0xd7, 0x3e, 0xef, 0xbd, 0xd7, 0x9a, 0xaf, 0x1d, 0x9f, 0x94, 0xa4, 0x67, 0x27, 0x25, 0xe9,
+	0xcf, 0x93, 0x92, 0xf4, 0xe4, 0xb4, 0x94, 0x7b, 0x76, 0x5a, 0xca, 0xfd, 0x76, 0x5a, 0xca, 0x7d,
+	0x04, 0x43, 0x53, 0x26, 0x3a, 0xd0, 0xe8, 0x61, 0x17, 0x91, 0xdd, 0x09, 0xf6, 0x2d, 0xb8, 0xfa,
+	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xab, 0xf5, 0x06, 0x14, 0xe9, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error)
+	RegisterAccountSignerlessly(ctx context.Context, in *MsgRegisterAccountSignerlessly, opts ...grpc.CallOption) (*MsgRegisterAccountSignerlesslyResponse, error)
+	ClearAccount(ctx context.Context, in *MsgClearAccount, opts ...grpc.CallOption) (*MsgClearAccountResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	AddDomain(ctx context.Context, in *MsgAddDomain, opts ...grpc.CallOption) (*MsgAddDomainResponse, error)
+	UpdateDomain(ctx context.Context, in *MsgUpdateDomain, opts ...grpc.CallOption) (*MsgUpdateDomainResponse, error)
+	DisableDomain(ctx context.Context, in *MsgDisableDomain, opts ...grpc.CallOption) (*MsgDisableDomainResponse, error)
+	SetFallbackPolicy(ctx context.Context, in *MsgSetFallbackPolicy, opts ...grpc.CallOption) (*MsgSetFallbackPolicyResponse, error)
+	SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error)
+	SetForwardOtherDenoms(ctx context.Context, in *MsgSetForwardOtherDenoms, opts ...grpc.CallOption) (*MsgSetForwardOtherDenomsResponse, error)
+	DeregisterAccount(ctx context.Context, in *MsgDeregisterAccount, opts ...grpc.CallOption) (*MsgDeregisterAccountResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error) {
+	out := new(MsgRegisterAccountResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/RegisterAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRegisterAccountSignerlessly) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RegisterAccountSignerlessly(ctx context.Context, in *MsgRegisterAccountSignerlessly, opts ...grpc.CallOption) (*MsgRegisterAccountSignerlesslyResponse, error) {
+	out := new(MsgRegisterAccountSignerlesslyResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/RegisterAccountSignerlessly", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ClearAccount(ctx context.Context, in *MsgClearAccount, opts ...grpc.CallOption) (*MsgClearAccountResponse, error) {
+	out := new(MsgClearAccountResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/ClearAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.DestinationDomain != 0 {
-		n += 1 + sovTx(uint64(m.DestinationDomain))
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.MintRecipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) AddDomain(ctx context.Context, in *MsgAddDomain, opts ...grpc.CallOption) (*MsgAddDomainResponse, error) {
+	out := new(MsgAddDomainResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/AddDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.FallbackRecipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateDomain(ctx context.Context, in *MsgUpdateDomain, opts ...grpc.CallOption) (*MsgUpdateDomainResponse, error) {
+	out := new(MsgUpdateDomainResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/UpdateDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.DestinationCaller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DisableDomain(ctx context.Context, in *MsgDisableDomain, opts ...grpc.CallOption) (*MsgDisableDomainResponse, error) {
+	out := new(MsgDisableDomainResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/DisableDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRegisterAccountSignerlesslyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetFallbackPolicy(ctx context.Context, in *MsgSetFallbackPolicy, opts ...grpc.CallOption) (*MsgSetFallbackPolicyResponse, error) {
+	out := new(MsgSetFallbackPolicyResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/SetFallbackPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error) {
+	out := new(MsgSetPausedResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/SetPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgClearAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetForwardOtherDenoms(ctx context.Context, in *MsgSetForwardOtherDenoms, opts ...grpc.CallOption) (*MsgSetForwardOtherDenomsResponse, error) {
+	out := new(MsgSetForwardOtherDenomsResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/SetForwardOtherDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DeregisterAccount(ctx context.Context, in *MsgDeregisterAccount, opts ...grpc.CallOption) (*MsgDeregisterAccountResponse, error) {
+	out := new(MsgDeregisterAccountResponse)
+	err := c.cc.Invoke(ctx, "/noble.autocctp.v1.Msg/DeregisterAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Address)
-	if l > 0 {
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RegisterAccount(context.Context, *MsgRegisterAccount) (*MsgRegisterAccountResponse, error)
+	RegisterAccountSignerlessly(context.Context, *MsgRegisterAccountSignerlessly) (*MsgRegisterAccountSignerlesslyResponse, error)
+	ClearAccount(context.Context, *MsgClearAccount) (*MsgClearAccountResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	AddDomain(context.Context, *MsgAddDomain) (*MsgAddDomainResponse, error)
+	UpdateDomain(context.Context, *MsgUpdateDomain) (*MsgUpdateDomainResponse, error)
+	DisableDomain(context.Context, *MsgDisableDomain) (*MsgDisableDomainResponse, error)
+	SetFallbackPolicy(context.Context, *MsgSetFallbackPolicy) (*MsgSetFallbackPolicyResponse, error)
+	SetPaused(context.Context, *MsgSetPaused) (*MsgSetPausedResponse, error)
+	SetForwardOtherDenoms(context.Context, *MsgSetForwardOtherDenoms) (*MsgSetForwardOtherDenomsResponse, error)
+	DeregisterAccount(context.Context, *MsgDeregisterAccount) (*MsgDeregisterAccountResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterAccount(ctx context.Context, req *MsgRegisterAccount) (*MsgRegisterAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccount not implemented")
+}
+func (*UnimplementedMsgServer) RegisterAccountSignerlessly(ctx context.Context, req *MsgRegisterAccountSignerlessly) (*MsgRegisterAccountSignerlesslyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccountSignerlessly not implemented")
+}
+func (*UnimplementedMsgServer) ClearAccount(ctx context.Context, req *MsgClearAccount) (*MsgClearAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearAccount not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) AddDomain(ctx context.Context, req *MsgAddDomain) (*MsgAddDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDomain not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDomain(ctx context.Context, req *MsgUpdateDomain) (*MsgUpdateDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDomain not implemented")
+}
+func (*UnimplementedMsgServer) DisableDomain(ctx context.Context, req *MsgDisableDomain) (*MsgDisableDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableDomain not implemented")
+}
+func (*UnimplementedMsgServer) SetFallbackPolicy(ctx context.Context, req *MsgSetFallbackPolicy) (*MsgSetFallbackPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFallbackPolicy not implemented")
+}
+func (*UnimplementedMsgServer) SetPaused(ctx context.Context, req *MsgSetPaused) (*MsgSetPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaused not implemented")
+}
+func (*UnimplementedMsgServer) SetForwardOtherDenoms(ctx context.Context, req *MsgSetForwardOtherDenoms) (*MsgSetForwardOtherDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetForwardOtherDenoms not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterAccount(ctx context.Context, req *MsgDeregisterAccount) (*MsgDeregisterAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterAccount not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Msg/RegisterAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAccount(ctx, req.(*MsgRegisterAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterAccountSignerlessly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAccountSignerlessly)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAccountSignerlessly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Msg/RegisterAccountSignerlessly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAccountSignerlessly(ctx, req.(*MsgRegisterAccountSignerlessly))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClearAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClearAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClearAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Msg/ClearAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClearAccount(ctx, req.(*MsgClearAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Msg/AddDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddDomain(ctx, req.(*MsgAddDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Msg/UpdateDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDomain(ctx, req.(*MsgUpdateDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableDomain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Msg/DisableDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableDomain(ctx, req.(*MsgDisableDomain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFallbackPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFallbackPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFallbackPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Msg/SetFallbackPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFallbackPolicy(ctx, req.(*MsgSetFallbackPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Msg/SetPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPaused(ctx, req.(*MsgSetPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetForwardOtherDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetForwardOtherDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetForwardOtherDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Msg/SetForwardOtherDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetForwardOtherDenoms(ctx, req.(*MsgSetForwardOtherDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.autocctp.v1.Msg/DeregisterAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterAccount(ctx, req.(*MsgDeregisterAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.autocctp.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterAccount",
+			Handler:    _Msg_RegisterAccount_Handler,
+		},
+		{
+			MethodName: "RegisterAccountSignerlessly",
+			Handler:    _Msg_RegisterAccountSignerlessly_Handler,
+		},
+		{
+			MethodName: "ClearAccount",
+			Handler:    _Msg_ClearAccount_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddDomain",
+			Handler:    _Msg_AddDomain_Handler,
+		},
+		{
+			MethodName: "UpdateDomain",
+			Handler:    _Msg_UpdateDomain_Handler,
+		},
+		{
+			MethodName: "DisableDomain",
+			Handler:    _Msg_DisableDomain_Handler,
+		},
+		{
+			MethodName: "SetFallbackPolicy",
+			Handler:    _Msg_SetFallbackPolicy_Handler,
+		},
+		{
+			MethodName: "SetPaused",
+			Handler:    _Msg_SetPaused_Handler,
+		},
+		{
+			MethodName: "SetForwardOtherDenoms",
+			Handler:    _Msg_SetForwardOtherDenoms_Handler,
+		},
+		{
+			MethodName: "DeregisterAccount",
+			Handler:    _Msg_DeregisterAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/autocctp/v1/tx.proto",
+}
+
+func (m *MsgRegisterAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.AddressVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DestinationCaller) > 0 {
+		i -= len(m.DestinationCaller)
+		copy(dAtA[i:], m.DestinationCaller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationCaller)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FallbackRecipient) > 0 {
+		i -= len(m.FallbackRecipient)
+		copy(dAtA[i:], m.FallbackRecipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FallbackRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MintRecipient) > 0 {
+		i -= len(m.MintRecipient)
+		copy(dAtA[i:], m.MintRecipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MintRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAccountSignerlessly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccountSignerlessly) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccountSignerlessly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.AddressVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AddressVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpirationTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DestinationCaller) > 0 {
+		i -= len(m.DestinationCaller)
+		copy(dAtA[i:], m.DestinationCaller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationCaller)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FallbackRecipient) > 0 {
+		i -= len(m.FallbackRecipient)
+		copy(dAtA[i:], m.FallbackRecipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FallbackRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MintRecipient) > 0 {
+		i -= len(m.MintRecipient)
+		copy(dAtA[i:], m.MintRecipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MintRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAccountSignerlesslyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccountSignerlesslyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccountSignerlesslyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fallback {
+		i--
+		if m.Fallback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClearAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClearAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClearAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Domain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableDomain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableDomain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableDomain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFallbackPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFallbackPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFallbackPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FallbackPolicy != nil {
+		{
+			size, err := m.FallbackPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFallbackPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFallbackPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFallbackPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestinationDomains) > 0 {
+		dAtA8 := make([]byte, len(m.DestinationDomains)*10)
+		var j7 int
+		for _, num := range m.DestinationDomains {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetForwardOtherDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetForwardOtherDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetForwardOtherDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardOtherDenoms {
+		i--
+		if m.ForwardOtherDenoms {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetForwardOtherDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetForwardOtherDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetForwardOtherDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTx(uint64(m.DestinationDomain))
+	}
+	l = len(m.MintRecipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FallbackRecipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationCaller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationHeight))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovTx(uint64(m.AddressVersion))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterAccountSignerlessly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTx(uint64(m.DestinationDomain))
+	}
+	l = len(m.MintRecipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FallbackRecipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationCaller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationHeight))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AddressVersion != 0 {
+		n += 1 + sovTx(uint64(m.AddressVersion))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterAccountSignerlesslyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClearAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Fallback {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgClearAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Domain.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Domain.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableDomain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTx(uint64(m.DestinationDomain))
+	}
+	return n
+}
+
+func (m *MsgDisableDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFallbackPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FallbackPolicy != nil {
+		l = m.FallbackPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFallbackPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.DestinationDomains) > 0 {
+		l = 0
+		for _, e := range m.DestinationDomains {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgSetPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetForwardOtherDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ForwardOtherDenoms {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetForwardOtherDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Fallback {
-		n += 2
+	return n
+}
+
+func (m *MsgDeregisterAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintRecipient == nil {
+				m.MintRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationCaller == nil {
+				m.DestinationCaller = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAccountSignerlessly) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccountSignerlessly: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccountSignerlessly: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintRecipient == nil {
+				m.MintRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationCaller == nil {
+				m.DestinationCaller = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressVersion", wireType)
+			}
+			m.AddressVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAccountSignerlesslyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccountSignerlesslyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccountSignerlesslyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fallback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClearAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClearAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClearAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Domain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *MsgDisableDomain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableDomain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableDomain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgClearAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgDisableDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFallbackPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -886,10 +4303,10 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFallbackPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFallbackPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -925,10 +4342,10 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.DestinationDomain = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -938,16 +4355,29 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestinationDomain |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackPolicy", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -957,29 +4387,131 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FallbackPolicy == nil {
+				m.FallbackPolicy = &FallbackPolicy{}
+			}
+			if err := m.FallbackPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFallbackPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFallbackPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFallbackPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.MintRecipient == nil {
-				m.MintRecipient = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1007,13 +4539,13 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1023,26 +4555,88 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
-			if m.DestinationCaller == nil {
-				m.DestinationCaller = []byte{}
+			m.Paused = bool(v != 0)
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DestinationDomains = append(m.DestinationDomains, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DestinationDomains) == 0 {
+					m.DestinationDomains = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DestinationDomains = append(m.DestinationDomains, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomains", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1064,7 +4658,7 @@ func (m *MsgRegisterAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1087,44 +4681,12 @@ func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1146,7 +4708,7 @@ func (m *MsgRegisterAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterAccountSignerlessly) Unmarshal(dAtA []byte) error {
+func (m *MsgSetForwardOtherDenoms) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1169,10 +4731,10 @@ func (m *MsgRegisterAccountSignerlessly) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAccountSignerlessly: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetForwardOtherDenoms: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAccountSignerlessly: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetForwardOtherDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1208,61 +4770,8 @@ func (m *MsgRegisterAccountSignerlessly) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
-			}
-			m.DestinationDomain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DestinationDomain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.MintRecipient == nil {
-				m.MintRecipient = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1290,13 +4799,13 @@ func (m *MsgRegisterAccountSignerlessly) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardOtherDenoms", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1306,26 +4815,12 @@ func (m *MsgRegisterAccountSignerlessly) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
-			if m.DestinationCaller == nil {
-				m.DestinationCaller = []byte{}
-			}
-			iNdEx = postIndex
+			m.ForwardOtherDenoms = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1347,7 +4842,7 @@ func (m *MsgRegisterAccountSignerlessly) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterAccountSignerlesslyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetForwardOtherDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1370,44 +4865,12 @@ func (m *MsgRegisterAccountSignerlesslyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAccountSignerlesslyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetForwardOtherDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAccountSignerlesslyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetForwardOtherDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1429,7 +4892,7 @@ func (m *MsgRegisterAccountSignerlesslyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1452,10 +4915,10 @@ func (m *MsgClearAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1522,26 +4985,6 @@ func (m *MsgClearAccount) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Fallback = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1563,7 +5006,7 @@ func (m *MsgClearAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1586,10 +5029,10 @@ func (m *MsgClearAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
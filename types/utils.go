@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 
@@ -74,6 +75,32 @@ func ValidateDestinationCaller(address []byte) error {
 	return nil
 }
 
+// ValidateAddressDerivation returns an error if the address version is not supported, or if
+// the salt is not allowed by the address version.
+func ValidateAddressDerivation(addressVersion uint32, salt []byte) error {
+	if addressVersion > LatestAddressVersion {
+		return fmt.Errorf("address version %d is not supported", addressVersion)
+	}
+	if addressVersion == AddressVersionV0 && len(salt) != 0 {
+		return fmt.Errorf("salt is not supported by address version %d", addressVersion)
+	}
+	if len(salt) > MaxSaltLength {
+		return fmt.Errorf("salt cannot be longer than %d bytes", MaxSaltLength)
+	}
+
+	return nil
+}
+
+// ValidateExpirationSupport returns an error if an expiration is set while the address version
+// does not include it in the address derivation.
+func ValidateExpirationSupport(addressVersion uint32, expirationHeight uint64, expirationTime time.Time) error {
+	if addressVersion == AddressVersionV0 && (expirationHeight != 0 || !expirationTime.IsZero()) {
+		return fmt.Errorf("expiration is not supported by address version %d", addressVersion)
+	}
+
+	return nil
+}
+
 func ParseDestinationDomain(destinationDomain string) (uint32, error) {
 	dD, err := strconv.ParseUint(destinationDomain, cctptypes.BaseTen, cctptypes.DomainBitLen)
 	if err != nil {
@@ -83,17 +110,20 @@ func ParseDestinationDomain(destinationDomain string) (uint32, error) {
 	return uint32(dD), nil
 }
 
-// ValidateDestinationDomain returns a Domain type or an error if the domain is Noble or invalid.
-func ValidateDestinationDomain(destinationDomain uint32) (Domain, error) {
-	domain, supported := supportedDomains[destinationDomain]
-	if !supported {
-		return 0, fmt.Errorf("destination domain %d is not supported", destinationDomain)
+// ValidateDestinationDomain returns an error if the destination domain is Noble, or if its
+// registry entry is missing or disabled.
+func ValidateDestinationDomain(destinationDomain uint32, domain *DomainConfig) error {
+	if Domain(destinationDomain) == NOBLE {
+		return errors.New("destination domain cannot be source domain")
 	}
-	if domain == NOBLE {
-		return 0, errors.New("destination domain cannot be source domain")
+	if domain == nil {
+		return fmt.Errorf("destination domain %d is not supported", destinationDomain)
+	}
+	if !domain.Enabled {
+		return fmt.Errorf("destination domain %d is disabled", destinationDomain)
 	}
 
-	return domain, nil
+	return nil
 }
 
 // isHex returns true if str begins with '0x' or '0X', and false otherwise.
@@ -115,19 +145,21 @@ func LeftPadBytes(bz []byte) ([]byte, error) {
 	return res, nil
 }
 
+// ValidateAndParseAccountFields validates the account fields against the registry entry of
+// the destination domain and returns the associated account properties.
 func ValidateAndParseAccountFields(
 	destinationDomain uint32,
+	domain *DomainConfig,
 	mintRecipient, fallbackRecipient, destinationCaller string,
 ) (*AccountProperties, error) {
-	domain, err := ValidateDestinationDomain(destinationDomain)
-	if err != nil {
+	if err := ValidateDestinationDomain(destinationDomain, domain); err != nil {
 		return nil, err
 	}
 
 	if len(mintRecipient) == 0 {
 		return nil, errors.New("invalid mint recipient: cannot be empty")
 	}
-	recipient, err := domain.parseAddress(mintRecipient)
+	recipient, err := domain.ParseAddress(mintRecipient)
 	if err != nil {
 		return nil, fmt.Errorf("invalid mint recipient %s: %w", mintRecipient, err)
 	}
@@ -138,7 +170,10 @@ func ValidateAndParseAccountFields(
 
 	caller := []byte{}
 	if len(destinationCaller) != 0 {
-		caller, err = domain.parseAddress(destinationCaller)
+		if !domain.DestinationCallerAllowed {
+			return nil, fmt.Errorf("invalid destination caller %s: not allowed for destination domain %d", destinationCaller, destinationDomain)
+		}
+		caller, err = domain.ParseAddress(destinationCaller)
 		if err != nil {
 			return nil, fmt.Errorf("invalid destination caller %s: %w", destinationCaller, err)
 		}
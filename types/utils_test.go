@@ -23,6 +23,7 @@ package types_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 	"github.com/stretchr/testify/require"
@@ -138,6 +139,64 @@ func TestValidateDestinationCaller(t *testing.T) {
 	}
 }
 
+func TestValidateAddressDerivation(t *testing.T) {
+	testCases := []struct {
+		name           string
+		addressVersion uint32
+		salt           []byte
+		errContains    string
+	}{
+		{
+			name:           "valid when the version is 0 without salt",
+			addressVersion: types.AddressVersionV0,
+		},
+		{
+			name:           "fail when the version is 0 with salt",
+			addressVersion: types.AddressVersionV0,
+			salt:           []byte("salt"),
+			errContains:    "salt is not supported",
+		},
+		{
+			name:           "valid when the version is 1 with salt",
+			addressVersion: types.AddressVersionV1,
+			salt:           bytes.Repeat([]byte{1}, types.MaxSaltLength),
+		},
+		{
+			name:           "fail when the salt is too long",
+			addressVersion: types.AddressVersionV1,
+			salt:           bytes.Repeat([]byte{1}, types.MaxSaltLength+1),
+			errContains:    "salt cannot be longer",
+		},
+		{
+			name:           "fail when the version is not supported",
+			addressVersion: types.LatestAddressVersion + 1,
+			errContains:    "is not supported",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			err := types.ValidateAddressDerivation(tC.addressVersion, tC.salt)
+
+			if tC.errContains == "" {
+				require.NoError(t, err, "expected no error validating the address derivation")
+			} else {
+				require.Error(t, err, "expected an error validating the address derivation")
+				require.ErrorContains(t, err, tC.errContains, "expected a different error")
+			}
+		})
+	}
+}
+
+func TestValidateExpirationSupport(t *testing.T) {
+	expirationTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, types.ValidateExpirationSupport(types.AddressVersionV0, 0, time.Time{}), "expected no error without expiration")
+	require.NoError(t, types.ValidateExpirationSupport(types.AddressVersionV1, 10, expirationTime), "expected no error with version 1")
+	require.ErrorContains(t, types.ValidateExpirationSupport(types.AddressVersionV0, 10, time.Time{}), "expiration is not supported", "expected an error with the expiration height")
+	require.ErrorContains(t, types.ValidateExpirationSupport(types.AddressVersionV0, 0, expirationTime), "expiration is not supported", "expected an error with the expiration time")
+}
+
 func TestValidateExistingAccount(t *testing.T) {
 	// ARRANGE: Create a new account
 	addr := sdk.AccAddress(testutil.AddressBytes())
@@ -199,6 +258,19 @@ func TestValidateAndParseDomainFields(t *testing.T) {
 	// ARRANGE
 	testutil.SetSDKConfig()
 
+	domains := make(map[uint32]types.DomainConfig)
+	for _, domain := range types.DefaultDomains() {
+		domains[domain.DestinationDomain] = domain
+	}
+	disabledDomain := types.NewDomainConfig(types.UNICHAIN+1, "Disabled", types.AddressEncodingHex)
+	disabledDomain.Enabled = false
+	domains[disabledDomain.DestinationDomain] = disabledDomain
+	noCallerDomain := types.NewDomainConfig(types.UNICHAIN+2, "No Caller", types.AddressEncodingHex)
+	noCallerDomain.DestinationCallerAllowed = false
+	domains[noCallerDomain.DestinationDomain] = noCallerDomain
+	bech32Domain := types.NewDomainConfig(types.UNICHAIN+3, "Bech32", types.AddressEncodingBech32)
+	domains[bech32Domain.DestinationDomain] = bech32Domain
+
 	testCases := []struct {
 		name              string
 		destinationDomain uint32
@@ -210,9 +282,14 @@ func TestValidateAndParseDomainFields(t *testing.T) {
 	}{
 		{
 			name:              "fail when the destination domain is not supported",
-			destinationDomain: 11,
+			destinationDomain: 100,
 			errContains:       "not supported",
 		},
+		{
+			name:              "fail when the destination domain is disabled",
+			destinationDomain: disabledDomain.DestinationDomain,
+			errContains:       "is disabled",
+		},
 		{
 			name:              "fail when the destination domain is noble",
 			destinationDomain: 4,
@@ -260,6 +337,41 @@ func TestValidateAndParseDomainFields(t *testing.T) {
 			destinationCaller: "2WjnnBcYf4ff9xyDoH8yevnKF3yhH98DCcdy6PSmjNDa",
 			errContains:       "invalid destination caller",
 		},
+		{
+			name:              "fail when destination caller is not allowed for the destination domain",
+			destinationDomain: noCallerDomain.DestinationDomain,
+			mintRecipient:     "0xaB537dC791355d986A4f7a9a53f3D8810fd870D1",
+			fallbackRecipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+			destinationCaller: "0xaB537dC791355d986A4f7a9a53f3D8810fd870D1",
+			errContains:       "not allowed for destination domain",
+		},
+		{
+			name:              "fail when mint recipient is not a bech32 address",
+			destinationDomain: bech32Domain.DestinationDomain,
+			mintRecipient:     "0xaB537dC791355d986A4f7a9a53f3D8810fd870D1",
+			fallbackRecipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+			errContains:       "address not valid bech32",
+		},
+		{
+			name:              "success when mint recipient is a bech32 address",
+			destinationDomain: bech32Domain.DestinationDomain,
+			mintRecipient:     "osmo13cdtym9q8f8e9kmj2ugkwzf9yyldtjeww6veks",
+			fallbackRecipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+			errContains:       "",
+			postChecks: func(aP *types.AccountProperties) {
+				require.Equal(t, 32, len(aP.MintRecipient), "expected mint recipient 32 bytes")
+			},
+		},
+		{
+			name:              "success when mint recipient is a solana address",
+			destinationDomain: uint32(types.SOLANA),
+			mintRecipient:     "2WjnnBcYf4ff9xyDoH8yevnKF3yhH98DCcdy6PSmjNDa",
+			fallbackRecipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+			errContains:       "",
+			postChecks: func(aP *types.AccountProperties) {
+				require.Equal(t, 32, len(aP.MintRecipient), "expected mint recipient 32 bytes")
+			},
+		},
 		{
 			name:              "success when mint recipient is an ethereum address",
 			destinationDomain: 0,
@@ -298,8 +410,14 @@ func TestValidateAndParseDomainFields(t *testing.T) {
 
 	for _, tC := range testCases {
 		t.Run(tC.name, func(t *testing.T) {
+			var domain *types.DomainConfig
+			if d, found := domains[tC.destinationDomain]; found {
+				domain = &d
+			}
+
 			accountProperties, err := types.ValidateAndParseAccountFields(
 				tC.destinationDomain,
+				domain,
 				tC.mintRecipient,
 				tC.fallbackRecipient,
 				tC.destinationCaller,